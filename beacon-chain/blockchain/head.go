@@ -117,25 +117,44 @@ func (s *Service) saveHead(ctx context.Context, headRoot [32]byte) error {
 
 	// A chain re-org occurred, so we fire an event notifying the rest of the services.
 	headSlot := s.HeadSlot()
-	if bytesutil.ToBytes32(newHeadBlock.Block.ParentRoot) != bytesutil.ToBytes32(r) {
-		log.WithFields(logrus.Fields{
-			"newSlot": fmt.Sprintf("%d", newHeadBlock.Block.Slot),
-			"oldSlot": fmt.Sprintf("%d", headSlot),
-		}).Debug("Chain reorg occurred")
-		s.stateNotifier.StateFeed().Send(&feed.Event{
-			Type: statefeed.Reorg,
-			Data: &statefeed.ReorgData{
-				NewSlot: newHeadBlock.Block.Slot,
-				OldSlot: headSlot,
-			},
-		})
-
-		reorgCount.Inc()
+	oldHeadRoot := bytesutil.ToBytes32(r)
+	if bytesutil.ToBytes32(newHeadBlock.Block.ParentRoot) != oldHeadRoot {
+		if depth, isReorg := s.reorgDepth(ctx, oldHeadRoot, headRoot); isReorg {
+			log.WithFields(logrus.Fields{
+				"newSlot": fmt.Sprintf("%d", newHeadBlock.Block.Slot),
+				"oldSlot": fmt.Sprintf("%d", headSlot),
+				"depth":   depth,
+			}).Debug("Chain reorg occurred")
+			s.stateNotifier.StateFeed().Send(&feed.Event{
+				Type: statefeed.Reorg,
+				Data: &statefeed.ReorgData{
+					NewSlot:          newHeadBlock.Block.Slot,
+					OldSlot:          headSlot,
+					OldHeadBlockRoot: oldHeadRoot,
+					NewHeadBlockRoot: headRoot,
+					OldHeadStateRoot: s.headBlockStateRoot(),
+					NewHeadStateRoot: bytesutil.ToBytes32(newHeadBlock.Block.StateRoot),
+					Depth:            depth,
+				},
+			})
+
+			reorgCount.Inc()
+		}
 	}
 
 	// Cache the new head info.
 	s.setHead(headRoot, newHeadBlock, newHeadState)
 
+	s.stateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.NewHead,
+		Data: &statefeed.NewHeadData{
+			Slot:            newHeadBlock.Block.Slot,
+			BlockRoot:       headRoot,
+			StateRoot:       bytesutil.ToBytes32(newHeadBlock.Block.StateRoot),
+			EpochTransition: helpers.SlotToEpoch(newHeadBlock.Block.Slot) > helpers.SlotToEpoch(headSlot),
+		},
+	})
+
 	// Save the new head root to DB.
 	if err := s.beaconDB.SaveHeadBlockRoot(ctx, headRoot); err != nil {
 		return errors.Wrap(err, "could not save head root in DB")
@@ -144,6 +163,49 @@ func (s *Service) saveHead(ctx context.Context, headRoot [32]byte) error {
 	return nil
 }
 
+// reorgDepth reports whether switching from the old head to the new head is a reorg, i.e. the old
+// head is not an ancestor of the new head, along with the number of slots between the old head and
+// the common ancestor of both heads. If fork choice cannot tell, a reorg of unknown depth is reported.
+func (s *Service) reorgDepth(ctx context.Context, oldHeadRoot, newHeadRoot [32]byte) (uint64, bool) {
+	oldHead := s.forkChoiceStore.Node(oldHeadRoot)
+	if oldHead == nil {
+		log.Debug("Could not find old head in fork choice store")
+		return 0, true
+	}
+	ancestor, err := s.commonAncestor(ctx, oldHead, newHeadRoot)
+	if err != nil {
+		log.WithError(err).Debug("Could not determine common ancestor of old and new head")
+		return 0, true
+	}
+	if ancestor.Root() == oldHeadRoot {
+		return 0, false
+	}
+	return oldHead.Slot() - ancestor.Slot(), true
+}
+
+// commonAncestor walks back from the old head in the fork choice store until it reaches a block
+// which is also an ancestor of the new head.
+func (s *Service) commonAncestor(ctx context.Context, oldHead *protoarray.Node, newHeadRoot [32]byte) (*protoarray.Node, error) {
+	nodes := s.forkChoiceStore.Nodes()
+	node := oldHead
+	for {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		ancestor, err := s.forkChoiceStore.AncestorRoot(ctx, newHeadRoot, node.Slot())
+		if err != nil {
+			return nil, err
+		}
+		if bytesutil.ToBytes32(ancestor) == node.Root() {
+			return node, nil
+		}
+		if node.Parent() >= uint64(len(nodes)) {
+			return nil, errors.New("old and new head have no common ancestor in fork choice store")
+		}
+		node = nodes[node.Parent()]
+	}
+}
+
 // This gets called to update canonical root mapping. It does not save head block
 // root in DB. With the inception of initial-sync-cache-state flag, it uses finalized
 // check point as anchors to resume sync therefore head is no longer needed to be saved on per slot basis.
@@ -218,6 +280,17 @@ func (s *Service) headBlock() *ethpb.SignedBeaconBlock {
 	return stateTrie.CopySignedBeaconBlock(s.head.block)
 }
 
+// This returns the state root of the head block, or the zero hash if the head block is unknown.
+func (s *Service) headBlockStateRoot() [32]byte {
+	s.headLock.RLock()
+	defer s.headLock.RUnlock()
+
+	if s.head == nil || s.head.block == nil || s.head.block.Block == nil {
+		return params.BeaconConfig().ZeroHash
+	}
+	return bytesutil.ToBytes32(s.head.block.Block.StateRoot)
+}
+
 // This returns the head state.
 // It does a full copy on head state for immutability.
 // This is a lock free version.
@@ -263,7 +336,9 @@ func (s *Service) cacheJustifiedStateBalances(ctx context.Context, justifiedRoot
 		if err != nil {
 			return err
 		}
-	} else {
+	}
+	// A node started from a checkpoint has no genesis state, its justified state is in the state generator.
+	if justifiedState == nil {
 		justifiedState, err = s.stateGen.StateByRoot(ctx, justifiedRoot)
 		if err != nil {
 			return err
@@ -6,8 +6,11 @@ import (
 	"testing"
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
@@ -94,6 +97,90 @@ func TestSaveHead_Different_Reorg(t *testing.T) {
 	require.LogsContain(t, hook, "Chain reorg occurred")
 }
 
+func TestSaveHead_ReorgEvent(t *testing.T) {
+	ctx := context.Background()
+	db, sc := testDB.SetupDB(t)
+	service := setupBeaconChain(t, db, sc)
+
+	// Fork choice tree: g <- a <- b (old head) and a <- c (new head).
+	require.NoError(t, service.forkChoiceStore.ProcessBlock(ctx, 0, [32]byte{'g'}, [32]byte{}, [32]byte{}, 0, 0))
+	require.NoError(t, service.forkChoiceStore.ProcessBlock(ctx, 1, [32]byte{'a'}, [32]byte{'g'}, [32]byte{}, 0, 0))
+	require.NoError(t, service.forkChoiceStore.ProcessBlock(ctx, 3, [32]byte{'b'}, [32]byte{'a'}, [32]byte{}, 0, 0))
+	oldHeadBlock := testutil.NewBeaconBlock()
+	oldHeadBlock.Block.Slot = 3
+	oldHeadBlock.Block.StateRoot = bytesutil.PadTo([]byte{'s'}, 32)
+	service.head = &head{slot: 3, root: [32]byte{'b'}, block: oldHeadBlock, state: testutil.NewBeaconState()}
+
+	newHeadSignedBlock := testutil.NewBeaconBlock()
+	newHeadSignedBlock.Block.Slot = 4
+	newHeadSignedBlock.Block.ParentRoot = bytesutil.PadTo([]byte{'a'}, 32)
+	require.NoError(t, service.beaconDB.SaveBlock(ctx, newHeadSignedBlock))
+	newRoot, err := newHeadSignedBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, service.forkChoiceStore.ProcessBlock(ctx, 4, newRoot, [32]byte{'a'}, [32]byte{}, 0, 0))
+	headState := testutil.NewBeaconState()
+	require.NoError(t, headState.SetSlot(4))
+	require.NoError(t, service.beaconDB.SaveStateSummary(ctx, &pb.StateSummary{Slot: 4, Root: newRoot[:]}))
+	require.NoError(t, service.beaconDB.SaveState(ctx, headState, newRoot))
+
+	events := make(chan *feed.Event, 2)
+	sub := service.stateNotifier.StateFeed().Subscribe(events)
+	defer sub.Unsubscribe()
+	require.NoError(t, service.saveHead(ctx, newRoot))
+
+	ev := <-events
+	require.Equal(t, feed.EventType(statefeed.Reorg), ev.Type)
+	data, ok := ev.Data.(*statefeed.ReorgData)
+	require.Equal(t, true, ok)
+	assert.Equal(t, uint64(4), data.NewSlot)
+	assert.Equal(t, uint64(3), data.OldSlot)
+	assert.Equal(t, uint64(2), data.Depth)
+	assert.Equal(t, [32]byte{'b'}, data.OldHeadBlockRoot)
+	assert.Equal(t, newRoot, data.NewHeadBlockRoot)
+	assert.Equal(t, [32]byte{'s'}, data.OldHeadStateRoot)
+
+	ev = <-events
+	require.Equal(t, feed.EventType(statefeed.NewHead), ev.Type)
+	headData, ok := ev.Data.(*statefeed.NewHeadData)
+	require.Equal(t, true, ok)
+	assert.Equal(t, uint64(4), headData.Slot)
+	assert.Equal(t, newRoot, headData.BlockRoot)
+}
+
+func TestSaveHead_NoReorgWhenOldHeadIsAncestor(t *testing.T) {
+	ctx := context.Background()
+	hook := logTest.NewGlobal()
+	db, sc := testDB.SetupDB(t)
+	service := setupBeaconChain(t, db, sc)
+
+	// Fork choice tree: g <- a (old head) <- b <- new head.
+	require.NoError(t, service.forkChoiceStore.ProcessBlock(ctx, 0, [32]byte{'g'}, [32]byte{}, [32]byte{}, 0, 0))
+	require.NoError(t, service.forkChoiceStore.ProcessBlock(ctx, 1, [32]byte{'a'}, [32]byte{'g'}, [32]byte{}, 0, 0))
+	require.NoError(t, service.forkChoiceStore.ProcessBlock(ctx, 2, [32]byte{'b'}, [32]byte{'a'}, [32]byte{}, 0, 0))
+	service.head = &head{slot: 1, root: [32]byte{'a'}}
+
+	newHeadSignedBlock := testutil.NewBeaconBlock()
+	newHeadSignedBlock.Block.Slot = 3
+	newHeadSignedBlock.Block.ParentRoot = bytesutil.PadTo([]byte{'b'}, 32)
+	require.NoError(t, service.beaconDB.SaveBlock(ctx, newHeadSignedBlock))
+	newRoot, err := newHeadSignedBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, service.forkChoiceStore.ProcessBlock(ctx, 3, newRoot, [32]byte{'b'}, [32]byte{}, 0, 0))
+	headState := testutil.NewBeaconState()
+	require.NoError(t, headState.SetSlot(3))
+	require.NoError(t, service.beaconDB.SaveStateSummary(ctx, &pb.StateSummary{Slot: 3, Root: newRoot[:]}))
+	require.NoError(t, service.beaconDB.SaveState(ctx, headState, newRoot))
+
+	events := make(chan *feed.Event, 2)
+	sub := service.stateNotifier.StateFeed().Subscribe(events)
+	defer sub.Unsubscribe()
+	require.NoError(t, service.saveHead(ctx, newRoot))
+
+	ev := <-events
+	assert.Equal(t, feed.EventType(statefeed.NewHead), ev.Type)
+	require.LogsDoNotContain(t, hook, "Chain reorg occurred")
+}
+
 func TestCacheJustifiedStateBalances_CanCache(t *testing.T) {
 	db, sc := testDB.SetupDB(t)
 	service := setupBeaconChain(t, db, sc)
@@ -286,6 +286,35 @@ func TestChainService_InitializeChainInfo(t *testing.T) {
 	assert.Equal(t, genesisRoot, c.genesisRoot, "Genesis block root incorrect")
 }
 
+func TestChainService_InitializeChainInfo_FromCheckpoint(t *testing.T) {
+	db, sc := testDB.SetupDB(t)
+	ctx := context.Background()
+
+	originSlot := params.BeaconConfig().SlotsPerEpoch * 3
+	originState := testutil.NewBeaconState()
+	require.NoError(t, originState.SetSlot(originSlot))
+	require.NoError(t, originState.SetGenesisValidatorRoot(bytesutil.PadTo([]byte("genesis"), 32)))
+	stateRoot, err := originState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	originBlock := testutil.NewBeaconBlock()
+	originBlock.Block.Slot = originSlot
+	originBlock.Block.StateRoot = stateRoot[:]
+	originRoot, err := originBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveOrigin(ctx, originState, originBlock))
+
+	c := &Service{beaconDB: db, stateGen: stategen.New(db, sc)}
+	require.NoError(t, c.initializeChainInfo(ctx))
+	assert.Equal(t, originRoot, c.genesisRoot, "Origin block root not used as chain root")
+	assert.Equal(t, originSlot, c.HeadSlot(), "Head slot incorrect")
+	r, err := c.HeadRoot(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, originRoot[:], r, "Head root incorrect")
+
+	c.justifiedCheckpt = &ethpb.Checkpoint{Epoch: 3, Root: originRoot[:]}
+	require.NoError(t, c.cacheJustifiedStateBalances(ctx, originRoot))
+}
+
 func TestChainService_InitializeChainInfo_SetHeadAtGenesis(t *testing.T) {
 	db, sc := testDB.SetupDB(t)
 	ctx := context.Background()
@@ -14,9 +14,12 @@ const (
 	Initialized
 	// Synced is sent when the beacon node has completed syncing and is ready to participate in the network.
 	Synced
-	// Reorg is an event sent when the new head state's slot after a block
-	// transition is lower than its previous head state slot value.
+	// Reorg is an event sent when the new head is not a descendant of the previous head.
 	Reorg
+	// NewHead is sent after the chain head has been updated.
+	NewHead
+	// FinalizedCheckpoint is sent after the finalized checkpoint has been updated.
+	FinalizedCheckpoint
 )
 
 // BlockProcessedData is the data sent with BlockProcessed events.
@@ -55,4 +58,37 @@ type ReorgData struct {
 	NewSlot uint64
 	// OldSlot is the slot of the head state before the reorg.
 	OldSlot uint64
+	// OldHeadBlockRoot is the root of the head block before the reorg.
+	OldHeadBlockRoot [32]byte
+	// NewHeadBlockRoot is the root of the head block after the reorg.
+	NewHeadBlockRoot [32]byte
+	// OldHeadStateRoot is the state root of the head block before the reorg.
+	OldHeadStateRoot [32]byte
+	// NewHeadStateRoot is the state root of the head block after the reorg.
+	NewHeadStateRoot [32]byte
+	// Depth is the number of slots between the old head and the common ancestor
+	// of the old and new heads.
+	Depth uint64
+}
+
+// NewHeadData is the data sent with NewHead events.
+type NewHeadData struct {
+	// Slot is the slot of the new head block.
+	Slot uint64
+	// BlockRoot is the root of the new head block.
+	BlockRoot [32]byte
+	// StateRoot is the state root of the new head block.
+	StateRoot [32]byte
+	// EpochTransition is true if the new head is in a later epoch than the previous head.
+	EpochTransition bool
+}
+
+// FinalizedCheckpointData is the data sent with FinalizedCheckpoint events.
+type FinalizedCheckpointData struct {
+	// Epoch is the epoch of the new finalized checkpoint.
+	Epoch uint64
+	// BlockRoot is the root of the finalized block.
+	BlockRoot [32]byte
+	// StateRoot is the state root of the finalized block.
+	StateRoot [32]byte
 }
@@ -0,0 +1,163 @@
+package db
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// Commands for verifying and maintaining the beacon node database while the node is stopped.
+var Commands = &cli.Command{
+	Name:     "db",
+	Category: "db",
+	Usage:    "defines commands for verifying and maintaining the beacon node database while the node is stopped",
+	Subcommands: []*cli.Command{
+		{
+			Name: "verify",
+			Usage: "walks the blocks, states and state summaries of the database and checks them against " +
+				"each other and the slot, parent root, archived state and finalized block roots indices",
+			Flags:  cmd.WrapFlags([]cli.Flag{cmd.DataDirFlag}),
+			Before: loadFlagsFromConfig,
+			Action: verifyCli,
+		},
+		{
+			Name:   "compact",
+			Usage:  "rewrites the database into a fresh file, releasing the disk space of its free pages",
+			Flags:  cmd.WrapFlags([]cli.Flag{cmd.DataDirFlag, flags.DBCompactOutputFlag}),
+			Before: loadFlagsFromConfig,
+			Action: compactCli,
+		},
+		{
+			Name:   "inspect",
+			Usage:  "prints the key count and size of every bucket of the database",
+			Flags:  cmd.WrapFlags([]cli.Flag{cmd.DataDirFlag}),
+			Before: loadFlagsFromConfig,
+			Action: inspectCli,
+		},
+		{
+			Name: "restore",
+			Usage: "restores the database from a backup file written by the backup webhook, after checking " +
+				"that the backup is of the configured network",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.RestoreSourceFileFlag,
+				cmd.RestoreTargetDirFlag,
+			}),
+			Before: loadFlagsFromConfig,
+			Action: restoreCli,
+		},
+	},
+}
+
+func loadFlagsFromConfig(cliCtx *cli.Context) error {
+	return cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags)
+}
+
+// openDB opens the existing database in the data directory for reading only, failing if the node
+// is running.
+func openDB(cliCtx *cli.Context) (*kv.Store, error) {
+	dirPath := filepath.Join(cliCtx.String(cmd.DataDirFlag.Name), DirName)
+	hasDir, err := fileutil.HasDir(dirPath)
+	if err != nil {
+		return nil, err
+	}
+	if !hasDir {
+		return nil, errors.Errorf("no database at %s", dirPath)
+	}
+	return kv.NewReadOnlyKVStore(dirPath)
+}
+
+func verifyCli(cliCtx *cli.Context) error {
+	log := logrus.WithField("prefix", "db")
+	store, err := openDB(cliCtx)
+	if err != nil {
+		return errors.Wrap(err, "could not open database")
+	}
+	defer func() {
+		if err := store.Close(); err != nil {
+			log.WithError(err).Error("Could not close database")
+		}
+	}()
+
+	log.WithField("path", store.DatabasePath()).Info("Verifying database")
+	issues, err := store.VerifyIntegrity(cliCtx.Context)
+	if err != nil {
+		return errors.Wrap(err, "could not verify database")
+	}
+	for _, issue := range issues {
+		log.Warn(issue.String())
+	}
+	if len(issues) > 0 {
+		return errors.Errorf("found %d integrity issues", len(issues))
+	}
+	log.Info("No integrity issues found")
+	return nil
+}
+
+func compactCli(cliCtx *cli.Context) error {
+	dirPath := filepath.Join(cliCtx.String(cmd.DataDirFlag.Name), DirName)
+	hasDir, err := fileutil.HasDir(dirPath)
+	if err != nil {
+		return err
+	}
+	if !hasDir {
+		return errors.Errorf("no database at %s", dirPath)
+	}
+	output := cliCtx.String(flags.DBCompactOutputFlag.Name)
+	if output == "" {
+		return Compact(cliCtx.Context, dirPath)
+	}
+	output, err = fileutil.ExpandPath(output)
+	if err != nil {
+		return err
+	}
+	if err := kv.CompactTo(cliCtx.Context, dirPath, output); err != nil {
+		return errors.Wrap(err, "could not compact database")
+	}
+	logrus.WithField("prefix", "db").WithField("path", output).Info("Wrote compacted database")
+	return nil
+}
+
+func inspectCli(cliCtx *cli.Context) error {
+	store, err := openDB(cliCtx)
+	if err != nil {
+		return errors.Wrap(err, "could not open database")
+	}
+	defer func() {
+		if err := store.Close(); err != nil {
+			logrus.WithError(err).Error("Could not close database")
+		}
+	}()
+	stats, err := store.Stats(cliCtx.Context)
+	if err != nil {
+		return errors.Wrap(err, "could not inspect database")
+	}
+
+	fmt.Printf("%-40s %12s %16s %16s\n", "BUCKET", "KEYS", "IN USE (BYTES)", "ALLOCATED (BYTES)")
+	for _, b := range stats.Buckets {
+		fmt.Printf("%-40s %12d %16d %16d\n", b.Name, b.Keys, b.InuseSize, b.AllocSize)
+	}
+	fmt.Printf("\nFile size: %d bytes, free pages: %d bytes\n", stats.FileSize, stats.FreeSize)
+	return nil
+}
+
+func restoreCli(cliCtx *cli.Context) error {
+	sourceFile, err := fileutil.ExpandPath(cliCtx.String(cmd.RestoreSourceFileFlag.Name))
+	if err != nil {
+		return err
+	}
+	targetDir, err := fileutil.ExpandPath(cliCtx.String(cmd.RestoreTargetDirFlag.Name))
+	if err != nil {
+		return err
+	}
+	if err := kv.Restore(cliCtx.Context, sourceFile, filepath.Join(targetDir, DirName)); err != nil {
+		return errors.Wrap(err, "could not restore database")
+	}
+	return nil
+}
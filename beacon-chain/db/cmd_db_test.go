@@ -0,0 +1,99 @@
+package db
+
+import (
+	"context"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/urfave/cli/v2"
+)
+
+func setupCliCtx(t *testing.T, dataDir, output string) *cli.Context {
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.DataDirFlag.Name, dataDir, "")
+	set.String(flags.DBCompactOutputFlag.Name, output, "")
+	require.NoError(t, set.Set(cmd.DataDirFlag.Name, dataDir))
+	require.NoError(t, set.Set(flags.DBCompactOutputFlag.Name, output))
+	cliCtx := cli.NewContext(&cli.App{}, set, nil)
+	cliCtx.Context = context.Background()
+	return cliCtx
+}
+
+func TestDBCommands(t *testing.T) {
+	ctx := context.Background()
+	dataDir := t.TempDir()
+	store, err := kv.NewKVStore(filepath.Join(dataDir, DirName), cache.NewStateSummaryCache())
+	require.NoError(t, err)
+	require.NoError(t, store.RunMigrations(ctx))
+	blk := testutil.NewBeaconBlock()
+	require.NoError(t, store.SaveBlock(ctx, blk))
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, store.SaveGenesisBlockRoot(ctx, root))
+	require.NoError(t, store.Close())
+
+	require.NoError(t, verifyCli(setupCliCtx(t, dataDir, "")))
+	require.NoError(t, inspectCli(setupCliCtx(t, dataDir, "")))
+	store, err = openDB(setupCliCtx(t, dataDir, ""))
+	require.NoError(t, err)
+	assert.ErrorContains(t, "read-only", store.SaveGenesisBlockRoot(ctx, root))
+	require.NoError(t, store.Close())
+
+	output := filepath.Join(t.TempDir(), "compacted.db")
+	require.NoError(t, compactCli(setupCliCtx(t, dataDir, output)))
+	_, err = os.Stat(output)
+	require.NoError(t, err)
+	assert.ErrorContains(t, "already exists", compactCli(setupCliCtx(t, dataDir, output)))
+
+	require.NoError(t, compactCli(setupCliCtx(t, dataDir, "")))
+	require.NoError(t, verifyCli(setupCliCtx(t, dataDir, "")))
+}
+
+func TestDBCommands_NoDatabase(t *testing.T) {
+	dataDir := t.TempDir()
+	assert.ErrorContains(t, "no database", verifyCli(setupCliCtx(t, dataDir, "")))
+	assert.ErrorContains(t, "no database", inspectCli(setupCliCtx(t, dataDir, "")))
+	assert.ErrorContains(t, "no database", compactCli(setupCliCtx(t, dataDir, "")))
+}
+
+func TestDBCommands_Restore(t *testing.T) {
+	ctx := context.Background()
+	store, err := kv.NewKVStore(filepath.Join(t.TempDir(), DirName), cache.NewStateSummaryCache())
+	require.NoError(t, err)
+	require.NoError(t, store.RunMigrations(ctx))
+	blk := testutil.NewBeaconBlock()
+	require.NoError(t, store.SaveBlock(ctx, blk))
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, store.SaveGenesisBlockRoot(ctx, root))
+	require.NoError(t, store.SaveState(ctx, testutil.NewBeaconState(), root))
+	require.NoError(t, store.SaveHeadBlockRoot(ctx, root))
+	backupDir := filepath.Join(t.TempDir(), "backups")
+	require.NoError(t, store.Backup(ctx, backupDir))
+	require.NoError(t, store.Close())
+
+	restoreCtx := func(sourceFile, targetDir string) *cli.Context {
+		set := flag.NewFlagSet("test", 0)
+		set.String(cmd.RestoreSourceFileFlag.Name, sourceFile, "")
+		set.String(cmd.RestoreTargetDirFlag.Name, targetDir, "")
+		require.NoError(t, set.Set(cmd.RestoreSourceFileFlag.Name, sourceFile))
+		require.NoError(t, set.Set(cmd.RestoreTargetDirFlag.Name, targetDir))
+		cliCtx := cli.NewContext(&cli.App{}, set, nil)
+		cliCtx.Context = ctx
+		return cliCtx
+	}
+	dataDir := t.TempDir()
+	assert.ErrorContains(t, "no backup", restoreCli(restoreCtx(filepath.Join(backupDir, "missing.backup"), dataDir)))
+	backupFile := filepath.Join(backupDir, "prysm_beacondb_at_slot_0000000.backup")
+	require.NoError(t, restoreCli(restoreCtx(backupFile, dataDir)))
+	require.NoError(t, verifyCli(setupCliCtx(t, dataDir, "")))
+}
@@ -3,11 +3,22 @@
 package db
 
 import (
+	"context"
+
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
 )
 
+// DirName is the name of the directory within the data directory which holds the beacon node database.
+const DirName = "beaconchaindata"
+
 // NewDB initializes a new DB.
 func NewDB(dirPath string, stateSummaryCache *cache.StateSummaryCache) (Database, error) {
 	return kv.NewKVStore(dirPath, stateSummaryCache)
 }
+
+// Compact rewrites the database in the given directory to release its free pages. It must be run
+// while the database is not opened.
+func Compact(ctx context.Context, dirPath string) error {
+	return kv.Compact(ctx, dirPath)
+}
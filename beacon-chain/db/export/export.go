@@ -0,0 +1,282 @@
+// Package export defines an implementation of the Database interface which
+// exports the objects saved by the beacon node to external sinks for data analysis.
+// Exported objects are queued in the database until every sink has delivered them,
+// so that delivery survives restarts of the node.
+package export
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+var _ iface.Database = (*Exporter)(nil)
+var log = logrus.WithField("prefix", "exporter")
+
+const (
+	// batchSize is the maximum number of messages handed to a sink at once.
+	batchSize = 64
+	// retryInterval is the time to wait before retrying a failed delivery.
+	retryInterval = 5 * time.Second
+)
+
+// Config specifies what an Exporter exports and where to.
+type Config struct {
+	Sinks []Sink
+	// Topics are the object types to export. Every type is exported when empty.
+	Topics []string
+}
+
+// Exporter wraps a database interface and exports certain objects to the configured sinks.
+type Exporter struct {
+	db          iface.Database
+	sinks       []Sink
+	topics      map[string]bool
+	notify      []chan struct{}
+	ctx         context.Context
+	cancel      context.CancelFunc
+	wg          sync.WaitGroup
+	cursorsLock sync.Mutex
+	cursors     map[string]uint64
+}
+
+// Wrap the db with an exporter. If no sink is configured, this does not wrap
+// the database, but returns the underlying database itself.
+func Wrap(db iface.Database, cfg *Config) (iface.Database, error) {
+	if len(cfg.Sinks) == 0 {
+		log.Debug("No export sinks configured, database was not wrapped with exporter")
+		return db, nil
+	}
+	topics := make(map[string]bool)
+	for _, topic := range cfg.Topics {
+		if _, ok := topicObjects[topic]; !ok {
+			return nil, errors.Errorf("unknown export topic %q", topic)
+		}
+		topics[topic] = true
+	}
+	if len(topics) == 0 {
+		for _, topic := range Topics() {
+			topics[topic] = true
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	e := &Exporter{
+		db:      db,
+		sinks:   cfg.Sinks,
+		topics:  topics,
+		notify:  make([]chan struct{}, len(cfg.Sinks)),
+		ctx:     ctx,
+		cancel:  cancel,
+		cursors: make(map[string]uint64, len(cfg.Sinks)),
+	}
+	for _, sink := range cfg.Sinks {
+		if _, ok := e.cursors[sink.Name()]; ok {
+			cancel()
+			return nil, errors.Errorf("duplicate export sink %s", sink.Name())
+		}
+		cursor, err := db.ExportCursor(ctx, sink.Name())
+		if err != nil {
+			cancel()
+			return nil, errors.Wrapf(err, "could not read export cursor of sink %s", sink.Name())
+		}
+		e.cursors[sink.Name()] = cursor
+	}
+	for i, sink := range cfg.Sinks {
+		e.notify[i] = make(chan struct{}, 1)
+		e.wg.Add(1)
+		go e.deliver(sink, e.notify[i])
+	}
+	return e, nil
+}
+
+// Close stops the delivery to the sinks and closes them, as well as the underlying db.
+func (e *Exporter) Close() error {
+	e.cancel()
+	e.wg.Wait()
+	for _, sink := range e.sinks {
+		if err := sink.Close(); err != nil {
+			log.WithError(err).WithField("sink", sink.Name()).Error("Could not close export sink")
+		}
+	}
+	return e.db.Close()
+}
+
+// SaveBlock queues the block for export once it is saved.
+func (e *Exporter) SaveBlock(ctx context.Context, block *eth.SignedBeaconBlock) error {
+	if err := e.db.SaveBlock(ctx, block); err != nil {
+		return err
+	}
+	return e.enqueue(ctx, BlockTopic, block)
+}
+
+// SaveBlocks queues the blocks for export once they are saved.
+func (e *Exporter) SaveBlocks(ctx context.Context, blocks []*eth.SignedBeaconBlock) error {
+	if err := e.db.SaveBlocks(ctx, blocks); err != nil {
+		return err
+	}
+	objs := make([]object, len(blocks))
+	for i, block := range blocks {
+		objs[i] = block
+	}
+	return e.enqueue(ctx, BlockTopic, objs...)
+}
+
+// SaveProposerSlashing queues the proposer slashing for export once it is saved.
+func (e *Exporter) SaveProposerSlashing(ctx context.Context, slashing *eth.ProposerSlashing) error {
+	if err := e.db.SaveProposerSlashing(ctx, slashing); err != nil {
+		return err
+	}
+	return e.enqueue(ctx, ProposerSlashingTopic, slashing)
+}
+
+// SaveAttesterSlashing queues the attester slashing for export once it is saved.
+func (e *Exporter) SaveAttesterSlashing(ctx context.Context, slashing *eth.AttesterSlashing) error {
+	if err := e.db.SaveAttesterSlashing(ctx, slashing); err != nil {
+		return err
+	}
+	return e.enqueue(ctx, AttesterSlashingTopic, slashing)
+}
+
+// SaveVoluntaryExit queues the voluntary exit for export once it is saved.
+func (e *Exporter) SaveVoluntaryExit(ctx context.Context, exit *eth.VoluntaryExit) error {
+	if err := e.db.SaveVoluntaryExit(ctx, exit); err != nil {
+		return err
+	}
+	return e.enqueue(ctx, VoluntaryExitTopic, exit)
+}
+
+// SaveJustifiedCheckpoint queues the justified checkpoint for export once it is saved.
+func (e *Exporter) SaveJustifiedCheckpoint(ctx context.Context, checkpoint *eth.Checkpoint) error {
+	if err := e.db.SaveJustifiedCheckpoint(ctx, checkpoint); err != nil {
+		return err
+	}
+	return e.enqueue(ctx, JustifiedCheckpointTopic, checkpoint)
+}
+
+// SaveFinalizedCheckpoint queues the finalized checkpoint for export once it is saved.
+func (e *Exporter) SaveFinalizedCheckpoint(ctx context.Context, checkpoint *eth.Checkpoint) error {
+	if err := e.db.SaveFinalizedCheckpoint(ctx, checkpoint); err != nil {
+		return err
+	}
+	return e.enqueue(ctx, FinalizedCheckpointTopic, checkpoint)
+}
+
+// enqueue appends the objects to the export queue, unless their topic is filtered out,
+// and wakes up the sinks.
+func (e *Exporter) enqueue(ctx context.Context, topic string, objs ...object) error {
+	if !e.topics[topic] || len(objs) == 0 {
+		return nil
+	}
+	ctx, span := trace.StartSpan(ctx, "export.enqueue")
+	defer span.End()
+
+	entries := make([][]byte, len(objs))
+	for i, obj := range objs {
+		entry, err := encodeEntry(topic, obj)
+		if err != nil {
+			return errors.Wrapf(err, "could not encode %s for export", topic)
+		}
+		entries[i] = entry
+	}
+	if err := e.db.AppendExportQueue(ctx, entries); err != nil {
+		return errors.Wrapf(err, "could not queue %s for export", topic)
+	}
+	for _, ch := range e.notify {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+	return nil
+}
+
+// deliver hands the queued messages to the sink in batches, starting from its cursor,
+// until the exporter is closed. Failed deliveries are retried after retryInterval.
+func (e *Exporter) deliver(sink Sink, notify <-chan struct{}) {
+	defer e.wg.Done()
+	logger := log.WithField("sink", sink.Name())
+	e.cursorsLock.Lock()
+	cursor := e.cursors[sink.Name()]
+	e.cursorsLock.Unlock()
+
+	for {
+		next, err := e.deliverBatch(sink, cursor)
+		if err != nil {
+			logger.WithError(err).Error("Could not export objects")
+			select {
+			case <-time.After(retryInterval):
+				continue
+			case <-e.ctx.Done():
+				return
+			}
+		}
+		if next != cursor {
+			cursor = next
+			continue
+		}
+		select {
+		case <-notify:
+		case <-e.ctx.Done():
+			return
+		}
+	}
+}
+
+// deliverBatch delivers up to batchSize messages from the cursor onwards, and returns the
+// advanced cursor. Entries which cannot be decoded are skipped, as they will never deliver.
+func (e *Exporter) deliverBatch(sink Sink, cursor uint64) (uint64, error) {
+	ctx, span := trace.StartSpan(e.ctx, "export.deliverBatch")
+	defer span.End()
+
+	seqs, entries, err := e.db.ExportQueue(ctx, cursor, batchSize)
+	if err != nil {
+		return cursor, errors.Wrap(err, "could not read export queue")
+	}
+	if len(entries) == 0 {
+		return cursor, nil
+	}
+	msgs := make([]*Message, 0, len(entries))
+	for i, entry := range entries {
+		msg, err := decodeEntry(seqs[i], entry)
+		if err != nil {
+			log.WithError(err).WithField("sequence", seqs[i]).Error("Skipping invalid export queue entry")
+			continue
+		}
+		msgs = append(msgs, msg)
+	}
+	if len(msgs) > 0 {
+		if err := sink.Export(ctx, msgs); err != nil {
+			return cursor, err
+		}
+	}
+	next := seqs[len(seqs)-1] + 1
+	if err := e.advance(ctx, sink.Name(), next); err != nil {
+		return cursor, err
+	}
+	return next, nil
+}
+
+// advance persists the cursor of the sink, and prunes the entries of the export queue
+// which have been delivered by every sink.
+func (e *Exporter) advance(ctx context.Context, sink string, cursor uint64) error {
+	if err := e.db.SaveExportCursor(ctx, sink, cursor); err != nil {
+		return errors.Wrap(err, "could not save export cursor")
+	}
+	e.cursorsLock.Lock()
+	e.cursors[sink] = cursor
+	lowest := cursor
+	for _, c := range e.cursors {
+		if c < lowest {
+			lowest = c
+		}
+	}
+	e.cursorsLock.Unlock()
+	return e.db.PruneExportQueue(ctx, lowest)
+}
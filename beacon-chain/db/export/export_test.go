@@ -0,0 +1,164 @@
+package export
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type testSink struct {
+	name string
+	err  error
+	lock sync.Mutex
+	msgs []*Message
+}
+
+func (s *testSink) Name() string {
+	return s.name
+}
+
+func (s *testSink) Export(_ context.Context, msgs []*Message) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.msgs = append(s.msgs, msgs...)
+	return nil
+}
+
+func (s *testSink) Close() error {
+	return nil
+}
+
+func (s *testSink) received() []*Message {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]*Message{}, s.msgs...)
+}
+
+func openDB(t *testing.T, dir string) iface.Database {
+	db, err := kv.NewKVStore(dir, cache.NewStateSummaryCache())
+	require.NoError(t, err)
+	return db
+}
+
+func waitFor(t *testing.T, condition func() bool) {
+	deadline := time.Now().Add(5 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal("Timed out waiting for condition")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestWrap_NoSinks(t *testing.T) {
+	db := openDB(t, t.TempDir())
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	wrapped, err := Wrap(db, &Config{})
+	require.NoError(t, err)
+	assert.Equal(t, db, wrapped)
+}
+
+func TestWrap_InvalidConfig(t *testing.T) {
+	db := openDB(t, t.TempDir())
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	_, err := Wrap(db, &Config{Sinks: []Sink{&testSink{name: "a"}}, Topics: []string{"foo"}})
+	assert.ErrorContains(t, "unknown export topic", err)
+	_, err = Wrap(db, &Config{Sinks: []Sink{&testSink{name: "a"}, &testSink{name: "a"}}})
+	assert.ErrorContains(t, "duplicate export sink", err)
+}
+
+func TestExporter_DeliversToAllSinks(t *testing.T) {
+	ctx := context.Background()
+	db := openDB(t, t.TempDir())
+	first, second := &testSink{name: "first"}, &testSink{name: "second"}
+	wrapped, err := Wrap(db, &Config{Sinks: []Sink{first, second}})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, wrapped.Close())
+	}()
+
+	block := testutil.NewBeaconBlock()
+	block.Block.Slot = 3
+	exit := &eth.VoluntaryExit{Epoch: 1, ValidatorIndex: 2}
+	require.NoError(t, wrapped.SaveBlock(ctx, block))
+	require.NoError(t, wrapped.SaveVoluntaryExit(ctx, exit))
+
+	for _, sink := range []*testSink{first, second} {
+		waitFor(t, func() bool { return len(sink.received()) == 2 })
+		msgs := sink.received()
+		assert.Equal(t, BlockTopic, msgs[0].Topic)
+		blockRoot, err := block.HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, blockRoot, msgs[0].Key)
+		assert.DeepEqual(t, block, msgs[0].Object)
+		assert.Equal(t, VoluntaryExitTopic, msgs[1].Topic)
+		assert.DeepEqual(t, exit, msgs[1].Object)
+	}
+
+	// Entries are pruned once every sink has delivered them.
+	waitFor(t, func() bool {
+		_, entries, err := db.ExportQueue(ctx, 0, batchSize)
+		require.NoError(t, err)
+		return len(entries) == 0
+	})
+	cursor, err := db.ExportCursor(ctx, "first")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), cursor)
+}
+
+func TestExporter_FiltersTopics(t *testing.T) {
+	ctx := context.Background()
+	db := openDB(t, t.TempDir())
+	sink := &testSink{name: "sink"}
+	wrapped, err := Wrap(db, &Config{Sinks: []Sink{sink}, Topics: []string{VoluntaryExitTopic}})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, wrapped.Close())
+	}()
+
+	require.NoError(t, wrapped.SaveBlock(ctx, testutil.NewBeaconBlock()))
+	require.NoError(t, wrapped.SaveVoluntaryExit(ctx, &eth.VoluntaryExit{Epoch: 1}))
+
+	waitFor(t, func() bool { return len(sink.received()) == 1 })
+	assert.Equal(t, VoluntaryExitTopic, sink.received()[0].Topic)
+}
+
+func TestExporter_ResumesAfterRestart(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	db := openDB(t, dir)
+	failing := &testSink{name: "sink", err: errors.New("unavailable")}
+	wrapped, err := Wrap(db, &Config{Sinks: []Sink{failing}})
+	require.NoError(t, err)
+	require.NoError(t, wrapped.SaveVoluntaryExit(ctx, &eth.VoluntaryExit{Epoch: 1}))
+	require.NoError(t, wrapped.SaveVoluntaryExit(ctx, &eth.VoluntaryExit{Epoch: 2}))
+	require.NoError(t, wrapped.Close())
+
+	db = openDB(t, dir)
+	sink := &testSink{name: "sink"}
+	wrapped, err = Wrap(db, &Config{Sinks: []Sink{sink}})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, wrapped.Close())
+	}()
+	waitFor(t, func() bool { return len(sink.received()) == 2 })
+	assert.Equal(t, uint64(1), sink.received()[0].Object.(*eth.VoluntaryExit).Epoch)
+	assert.Equal(t, uint64(2), sink.received()[1].Object.(*eth.VoluntaryExit).Epoch)
+}
@@ -0,0 +1,132 @@
+package export
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// rotatedFileTimeFormat is the suffix appended to the name of rotated export files.
+// It sorts lexicographically in chronological order.
+const rotatedFileTimeFormat = "20060102T150405.000000000"
+
+// FileSinkConfig configures a FileSink.
+type FileSinkConfig struct {
+	Path     string
+	Encoding Encoding
+	// MaxSize is the size in bytes after which the file is rotated. Zero disables rotation.
+	MaxSize int64
+	// MaxBackups is the number of rotated files to keep. Zero keeps all of them.
+	MaxBackups int
+}
+
+// FileSink appends exported objects to a file as newline-delimited JSON, rotating the
+// file once it grows past its maximum size.
+type FileSink struct {
+	cfg  *FileSinkConfig
+	file *os.File
+	size int64
+}
+
+// NewFileSink opens, or creates, the export file at the configured path.
+func NewFileSink(cfg *FileSinkConfig) (*FileSink, error) {
+	if cfg.Encoding != EncodingJSON && cfg.Encoding != EncodingSSZ {
+		return nil, errors.Errorf("unknown encoding %q", cfg.Encoding)
+	}
+	dir := filepath.Dir(cfg.Path)
+	exists, err := fileutil.HasDir(dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not check export directory")
+	}
+	if !exists {
+		if err := fileutil.MkdirAll(dir); err != nil {
+			return nil, errors.Wrap(err, "could not create export directory")
+		}
+	}
+	s := &FileSink{cfg: cfg}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Name of the sink.
+func (s *FileSink) Name() string {
+	return "file"
+}
+
+// Export appends the messages to the file and syncs it to disk.
+func (s *FileSink) Export(_ context.Context, msgs []*Message) error {
+	for _, msg := range msgs {
+		line, err := msg.MarshalLine(s.cfg.Encoding)
+		if err != nil {
+			return err
+		}
+		if s.cfg.MaxSize > 0 && s.size > 0 && s.size+int64(len(line)) > s.cfg.MaxSize {
+			if err := s.rotate(); err != nil {
+				return err
+			}
+		}
+		n, err := s.file.Write(line)
+		s.size += int64(n)
+		if err != nil {
+			return errors.Wrap(err, "could not write export file")
+		}
+	}
+	return s.file.Sync()
+}
+
+// Close the export file.
+func (s *FileSink) Close() error {
+	return s.file.Close()
+}
+
+func (s *FileSink) open() error {
+	f, err := os.OpenFile(s.cfg.Path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, params.BeaconIoConfig().ReadWritePermissions)
+	if err != nil {
+		return errors.Wrap(err, "could not open export file")
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return errors.Wrap(err, "could not stat export file")
+	}
+	s.file = f
+	s.size = info.Size()
+	return nil
+}
+
+// rotate renames the current file with a timestamp suffix, starts a new one and
+// removes the oldest rotated files beyond the configured number of backups.
+func (s *FileSink) rotate() error {
+	if err := s.file.Close(); err != nil {
+		return errors.Wrap(err, "could not close export file")
+	}
+	rotated := s.cfg.Path + "." + time.Now().UTC().Format(rotatedFileTimeFormat)
+	if err := os.Rename(s.cfg.Path, rotated); err != nil {
+		return errors.Wrap(err, "could not rotate export file")
+	}
+	if err := s.open(); err != nil {
+		return err
+	}
+	if s.cfg.MaxBackups <= 0 {
+		return nil
+	}
+	backups, err := filepath.Glob(s.cfg.Path + ".*")
+	if err != nil {
+		return err
+	}
+	sort.Strings(backups)
+	for len(backups) > s.cfg.MaxBackups {
+		if err := os.Remove(backups[0]); err != nil {
+			return errors.Wrap(err, "could not remove rotated export file")
+		}
+		backups = backups[1:]
+	}
+	return nil
+}
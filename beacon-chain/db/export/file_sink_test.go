@@ -0,0 +1,92 @@
+package export
+
+import (
+	"bufio"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func testMessages(t *testing.T, n int) []*Message {
+	msgs := make([]*Message, n)
+	for i := range msgs {
+		entry, err := encodeEntry(VoluntaryExitTopic, &eth.VoluntaryExit{Epoch: uint64(i)})
+		require.NoError(t, err)
+		msgs[i], err = decodeEntry(uint64(i+1), entry)
+		require.NoError(t, err)
+	}
+	return msgs
+}
+
+func countLines(t *testing.T, path string) int {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, f.Close())
+	}()
+	lines := 0
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		lines++
+	}
+	require.NoError(t, scanner.Err())
+	return lines
+}
+
+func TestFileSink_Export(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "export", "objects.ndjson")
+	s, err := NewFileSink(&FileSinkConfig{Path: path, Encoding: EncodingSSZ})
+	require.NoError(t, err)
+	require.NoError(t, s.Export(context.Background(), testMessages(t, 3)))
+	require.NoError(t, s.Close())
+
+	// Reopening the sink appends to the existing file.
+	s, err = NewFileSink(&FileSinkConfig{Path: path, Encoding: EncodingSSZ})
+	require.NoError(t, err)
+	require.NoError(t, s.Export(context.Background(), testMessages(t, 2)))
+	require.NoError(t, s.Close())
+	assert.Equal(t, 5, countLines(t, path))
+}
+
+func TestFileSink_Rotation(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "objects.ndjson")
+	msgs := testMessages(t, 10)
+	longest := 0
+	for _, msg := range msgs {
+		line, err := msg.MarshalLine(EncodingJSON)
+		require.NoError(t, err)
+		if len(line) > longest {
+			longest = len(line)
+		}
+	}
+
+	// Each file fits two lines.
+	s, err := NewFileSink(&FileSinkConfig{
+		Path:       path,
+		Encoding:   EncodingJSON,
+		MaxSize:    int64(2 * longest),
+		MaxBackups: 2,
+	})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, s.Close())
+	}()
+	require.NoError(t, s.Export(context.Background(), msgs))
+
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(files), "Expected the current file and two backups")
+	assert.Equal(t, 2, countLines(t, path))
+}
+
+func TestNewFileSink_UnknownEncoding(t *testing.T) {
+	_, err := NewFileSink(&FileSinkConfig{Path: filepath.Join(t.TempDir(), "f"), Encoding: "xml"})
+	assert.ErrorContains(t, "unknown encoding", err)
+}
@@ -0,0 +1,72 @@
+// +build kafka_enabled
+
+package export
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	"gopkg.in/confluentinc/confluent-kafka-go.v1/kafka"
+	_ "gopkg.in/confluentinc/confluent-kafka-go.v1/kafka/librdkafka" // Required for c++ kafka library.
+)
+
+// kafkaSink publishes each exported object to the Kafka topic named after its type, keyed
+// by its hash tree root, with the JSON encoding of the object as the value.
+type kafkaSink struct {
+	p *kafka.Producer
+}
+
+// NewKafkaSink creates a sink publishing to the given Kafka bootstrap servers.
+func NewKafkaSink(bootstrapServers string) (Sink, error) {
+	p, err := kafka.NewProducer(&kafka.ConfigMap{"bootstrap.servers": bootstrapServers})
+	if err != nil {
+		return nil, err
+	}
+	return &kafkaSink{p: p}, nil
+}
+
+// Name of the sink.
+func (s *kafkaSink) Name() string {
+	return "kafka"
+}
+
+// Export produces the messages and waits for all of them to be acknowledged.
+func (s *kafkaSink) Export(ctx context.Context, msgs []*Message) error {
+	deliveries := make(chan kafka.Event, len(msgs))
+	for _, msg := range msgs {
+		value := bytes.NewBuffer(nil)
+		if err := marshaler.Marshal(value, msg.Object); err != nil {
+			return errors.Wrap(err, "could not marshal object")
+		}
+		topic := msg.Topic
+		key := msg.Key
+		if err := s.p.Produce(&kafka.Message{
+			TopicPartition: kafka.TopicPartition{
+				Topic:     &topic,
+				Partition: kafka.PartitionAny,
+			},
+			Value: value.Bytes(),
+			Key:   key[:],
+		}, deliveries); err != nil {
+			return errors.Wrap(err, "could not produce message")
+		}
+	}
+	for range msgs {
+		select {
+		case ev := <-deliveries:
+			if m, ok := ev.(*kafka.Message); ok && m.TopicPartition.Error != nil {
+				return errors.Wrap(m.TopicPartition.Error, "could not deliver message")
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// Close the Kafka producer.
+func (s *kafkaSink) Close() error {
+	s.p.Close()
+	return nil
+}
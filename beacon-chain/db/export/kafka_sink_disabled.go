@@ -0,0 +1,12 @@
+// +build !kafka_enabled
+
+package export
+
+import (
+	"errors"
+)
+
+// NewKafkaSink is unavailable when the beacon node is built without Kafka support.
+func NewKafkaSink(string) (Sink, error) {
+	return nil, errors.New("beacon node was built without kafka support")
+}
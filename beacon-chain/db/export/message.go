@@ -0,0 +1,154 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	fssz "github.com/ferranbt/fastssz"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+)
+
+// Topics of the objects which can be exported, one per object type.
+const (
+	BlockTopic               = "beacon_block"
+	ProposerSlashingTopic    = "proposer_slashing"
+	AttesterSlashingTopic    = "attester_slashing"
+	VoluntaryExitTopic       = "voluntary_exit"
+	JustifiedCheckpointTopic = "justified_checkpoint"
+	FinalizedCheckpointTopic = "finalized_checkpoint"
+)
+
+// Encoding is the format objects are written in by sinks which support more than one.
+type Encoding string
+
+const (
+	// EncodingJSON writes objects in their protobuf JSON form.
+	EncodingJSON Encoding = "json"
+	// EncodingSSZ writes objects as hex encoded SSZ.
+	EncodingSSZ Encoding = "ssz"
+)
+
+var marshaler = &jsonpb.Marshaler{}
+
+// object is implemented by every exportable type.
+type object interface {
+	proto.Message
+	fssz.Marshaler
+	fssz.Unmarshaler
+	fssz.HashRoot
+}
+
+var topicObjects = map[string]func() object{
+	BlockTopic:               func() object { return &eth.SignedBeaconBlock{} },
+	ProposerSlashingTopic:    func() object { return &eth.ProposerSlashing{} },
+	AttesterSlashingTopic:    func() object { return &eth.AttesterSlashing{} },
+	VoluntaryExitTopic:       func() object { return &eth.VoluntaryExit{} },
+	JustifiedCheckpointTopic: func() object { return &eth.Checkpoint{} },
+	FinalizedCheckpointTopic: func() object { return &eth.Checkpoint{} },
+}
+
+// Topics returns the topics of all exportable object types.
+func Topics() []string {
+	return []string{
+		BlockTopic,
+		ProposerSlashingTopic,
+		AttesterSlashingTopic,
+		VoluntaryExitTopic,
+		JustifiedCheckpointTopic,
+		FinalizedCheckpointTopic,
+	}
+}
+
+// Message is a single exported object, as handed to the sinks.
+type Message struct {
+	// Sequence is the position of the message in the export queue. Messages may be delivered
+	// more than once, and consumers can use it to discard duplicates.
+	Sequence uint64
+	Topic    string
+	// Key is the hash tree root of the object.
+	Key    [32]byte
+	Object proto.Message
+	// SSZ is the SSZ encoding of the object.
+	SSZ []byte
+}
+
+type jsonMessage struct {
+	Sequence uint64          `json:"sequence"`
+	Topic    string          `json:"topic"`
+	Key      hexutil.Bytes   `json:"key"`
+	Data     json.RawMessage `json:"data"`
+}
+
+// MarshalLine encodes the message as a single line of JSON, terminated by a newline. The object
+// itself is embedded as JSON or, with the SSZ encoding, as a hex string of its SSZ encoding.
+func (m *Message) MarshalLine(encoding Encoding) ([]byte, error) {
+	var data []byte
+	switch encoding {
+	case EncodingJSON:
+		buf := bytes.NewBuffer(nil)
+		if err := marshaler.Marshal(buf, m.Object); err != nil {
+			return nil, errors.Wrap(err, "could not marshal object")
+		}
+		data = buf.Bytes()
+	case EncodingSSZ:
+		enc, err := json.Marshal(hexutil.Bytes(m.SSZ))
+		if err != nil {
+			return nil, err
+		}
+		data = enc
+	default:
+		return nil, errors.Errorf("unknown encoding %q", encoding)
+	}
+	line, err := json.Marshal(&jsonMessage{
+		Sequence: m.Sequence,
+		Topic:    m.Topic,
+		Key:      m.Key[:],
+		Data:     data,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return append(line, '\n'), nil
+}
+
+// encodeEntry encodes an object into an export queue entry, which is the length
+// prefixed topic followed by the SSZ encoding of the object.
+func encodeEntry(topic string, obj object) ([]byte, error) {
+	size := obj.SizeSSZ()
+	entry := make([]byte, 0, 1+len(topic)+size)
+	entry = append(entry, byte(len(topic)))
+	entry = append(entry, topic...)
+	return obj.MarshalSSZTo(entry)
+}
+
+// decodeEntry decodes an export queue entry into a message.
+func decodeEntry(seq uint64, entry []byte) (*Message, error) {
+	if len(entry) == 0 || len(entry) < 1+int(entry[0]) {
+		return nil, errors.New("export queue entry is too short")
+	}
+	topic := string(entry[1 : 1+entry[0]])
+	newObject, ok := topicObjects[topic]
+	if !ok {
+		return nil, errors.Errorf("unknown topic %q", topic)
+	}
+	enc := entry[1+entry[0]:]
+	obj := newObject()
+	if err := obj.UnmarshalSSZ(enc); err != nil {
+		return nil, errors.Wrapf(err, "could not unmarshal %s", topic)
+	}
+	key, err := obj.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not hash %s", topic)
+	}
+	return &Message{
+		Sequence: seq,
+		Topic:    topic,
+		Key:      key,
+		Object:   obj,
+		SSZ:      enc,
+	}, nil
+}
@@ -0,0 +1,74 @@
+package export
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestEncodeDecodeEntry(t *testing.T) {
+	cp := &eth.Checkpoint{Epoch: 4, Root: make([]byte, 32)}
+	entry, err := encodeEntry(FinalizedCheckpointTopic, cp)
+	require.NoError(t, err)
+
+	msg, err := decodeEntry(7, entry)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), msg.Sequence)
+	assert.Equal(t, FinalizedCheckpointTopic, msg.Topic)
+	assert.DeepEqual(t, cp, msg.Object)
+	root, err := cp.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, root, msg.Key)
+	enc, err := cp.MarshalSSZ()
+	require.NoError(t, err)
+	assert.DeepEqual(t, enc, msg.SSZ)
+}
+
+func TestDecodeEntry_Invalid(t *testing.T) {
+	_, err := decodeEntry(1, nil)
+	assert.ErrorContains(t, "too short", err)
+	_, err = decodeEntry(1, []byte{5, 'a'})
+	assert.ErrorContains(t, "too short", err)
+	_, err = decodeEntry(1, append([]byte{3}, "foo"...))
+	assert.ErrorContains(t, "unknown topic", err)
+	_, err = decodeEntry(1, append([]byte{byte(len(VoluntaryExitTopic))}, VoluntaryExitTopic...))
+	assert.ErrorContains(t, "could not unmarshal", err)
+}
+
+func TestMessage_MarshalLine(t *testing.T) {
+	exit := &eth.VoluntaryExit{Epoch: 1, ValidatorIndex: 2}
+	entry, err := encodeEntry(VoluntaryExitTopic, exit)
+	require.NoError(t, err)
+	msg, err := decodeEntry(3, entry)
+	require.NoError(t, err)
+
+	line, err := msg.MarshalLine(EncodingJSON)
+	require.NoError(t, err)
+	assert.Equal(t, byte('\n'), line[len(line)-1])
+	decoded := &struct {
+		Sequence uint64                 `json:"sequence"`
+		Topic    string                 `json:"topic"`
+		Key      hexutil.Bytes          `json:"key"`
+		Data     map[string]interface{} `json:"data"`
+	}{}
+	require.NoError(t, json.Unmarshal(line, decoded))
+	assert.Equal(t, uint64(3), decoded.Sequence)
+	assert.Equal(t, VoluntaryExitTopic, decoded.Topic)
+	assert.DeepEqual(t, hexutil.Bytes(msg.Key[:]), decoded.Key)
+	assert.Equal(t, "2", decoded.Data["validatorIndex"])
+
+	line, err = msg.MarshalLine(EncodingSSZ)
+	require.NoError(t, err)
+	sszDecoded := &struct {
+		Data hexutil.Bytes `json:"data"`
+	}{}
+	require.NoError(t, json.Unmarshal(line, sszDecoded))
+	assert.DeepEqual(t, hexutil.Bytes(msg.SSZ), sszDecoded.Data)
+
+	_, err = msg.MarshalLine("xml")
+	assert.ErrorContains(t, "unknown encoding", err)
+}
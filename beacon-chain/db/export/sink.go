@@ -0,0 +1,17 @@
+package export
+
+import (
+	"context"
+)
+
+// Sink delivers exported objects to a system outside of the beacon node.
+type Sink interface {
+	// Name identifies the sink's delivery cursor in the database, and must
+	// therefore be stable across restarts.
+	Name() string
+	// Export delivers the messages in order. They are only considered delivered once
+	// nil is returned and are retried otherwise, so sinks may see a message more than once.
+	Export(ctx context.Context, msgs []*Message) error
+	// Close releases the resources of the sink.
+	Close() error
+}
@@ -0,0 +1,73 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// webhookTimeout bounds the time a single webhook request may take.
+const webhookTimeout = 30 * time.Second
+
+// WebhookSink posts batches of exported objects to an HTTP endpoint as newline-delimited JSON.
+// A batch is considered delivered once the endpoint responds with a 2xx status code.
+type WebhookSink struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookSink creates a sink posting to the given URL.
+func NewWebhookSink(url string) *WebhookSink {
+	return &WebhookSink{
+		url:    url,
+		client: &http.Client{Timeout: webhookTimeout},
+	}
+}
+
+// Name of the sink.
+func (s *WebhookSink) Name() string {
+	return "webhook"
+}
+
+// Export posts the messages in a single request.
+func (s *WebhookSink) Export(ctx context.Context, msgs []*Message) error {
+	body := bytes.NewBuffer(nil)
+	for _, msg := range msgs {
+		line, err := msg.MarshalLine(EncodingJSON)
+		if err != nil {
+			return err
+		}
+		body.Write(line)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, body)
+	if err != nil {
+		return errors.Wrap(err, "could not create webhook request")
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "could not call webhook")
+	}
+	defer func() {
+		if _, err := io.Copy(ioutil.Discard, resp.Body); err != nil {
+			log.WithError(err).Debug("Could not drain webhook response")
+		}
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close webhook response")
+		}
+	}()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return errors.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Close is a no-op for webhook sinks.
+func (s *WebhookSink) Close() error {
+	return nil
+}
@@ -0,0 +1,42 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestWebhookSink_Export(t *testing.T) {
+	var body []byte
+	var contentType string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var err error
+		body, err = ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		contentType = r.Header.Get("Content-Type")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	s := NewWebhookSink(srv.URL)
+	require.NoError(t, s.Export(context.Background(), testMessages(t, 3)))
+	assert.Equal(t, "application/x-ndjson", contentType)
+	assert.Equal(t, 3, bytes.Count(body, []byte("\n")))
+}
+
+func TestWebhookSink_ExportFails(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	s := NewWebhookSink(srv.URL)
+	err := s.Export(context.Background(), testMessages(t, 1))
+	assert.ErrorContains(t, "webhook responded with status 503", err)
+}
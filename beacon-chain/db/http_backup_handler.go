@@ -8,8 +8,9 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-// BackupHandler for accepting requests to initiate a new database backup.
-func BackupHandler(db Database, outputDir string) func(http.ResponseWriter, *http.Request) {
+// BackupHandler for accepting requests to initiate a new database backup. After each backup, only
+// the given number of most recent backups are kept in the output directory, zero keeps all of them.
+func BackupHandler(db Database, outputDir string, retain int) func(http.ResponseWriter, *http.Request) {
 	log := logrus.WithField("prefix", "db")
 
 	return func(w http.ResponseWriter, _ *http.Request) {
@@ -20,6 +21,12 @@ func BackupHandler(db Database, outputDir string) func(http.ResponseWriter, *htt
 			w.WriteHeader(http.StatusInternalServerError)
 			return
 		}
+		if retain > 0 {
+			// The backup itself succeeded, a failure to remove old backups is only logged.
+			if err := db.PruneBackups(context.Background(), outputDir, retain); err != nil {
+				log.WithError(err).Error("Failed to remove old backups")
+			}
+		}
 		w.WriteHeader(http.StatusOK)
 		_, err := fmt.Fprint(w, "OK")
 		if err != nil {
@@ -26,6 +26,8 @@ type ReadOnlyDatabase interface {
 	IsFinalizedBlock(ctx context.Context, blockRoot [32]byte) bool
 	FinalizedChildBlock(ctx context.Context, blockRoot [32]byte) (*eth.SignedBeaconBlock, error)
 	HighestSlotBlocksBelow(ctx context.Context, slot uint64) ([]*eth.SignedBeaconBlock, error)
+	OriginBlockRoot(ctx context.Context) ([32]byte, error)
+	BackfillBlockRoot(ctx context.Context) ([32]byte, error)
 	// State related methods.
 	State(ctx context.Context, blockRoot [32]byte) (*state.BeaconState, error)
 	GenesisState(ctx context.Context) (*state.BeaconState, error)
@@ -52,6 +54,9 @@ type ReadOnlyDatabase interface {
 	DepositContractAddress(ctx context.Context) ([]byte, error)
 	// Powchain operations.
 	PowchainData(ctx context.Context) (*db.ETH1ChainData, error)
+	// Export queue operations.
+	ExportQueue(ctx context.Context, start uint64, limit int) ([]uint64, [][]byte, error)
+	ExportCursor(ctx context.Context, sink string) (uint64, error)
 }
 
 // NoHeadAccessDatabase defines a struct without access to chain head data.
@@ -62,6 +67,7 @@ type NoHeadAccessDatabase interface {
 	SaveBlock(ctx context.Context, block *eth.SignedBeaconBlock) error
 	SaveBlocks(ctx context.Context, blocks []*eth.SignedBeaconBlock) error
 	SaveGenesisBlockRoot(ctx context.Context, blockRoot [32]byte) error
+	SaveBackfillBlockRoot(ctx context.Context, blockRoot [32]byte) error
 	// State related methods.
 	SaveState(ctx context.Context, state *state.BeaconState, blockRoot [32]byte) error
 	SaveStates(ctx context.Context, states []*state.BeaconState, blockRoots [][32]byte) error
@@ -81,11 +87,16 @@ type NoHeadAccessDatabase interface {
 	SaveDepositContractAddress(ctx context.Context, addr common.Address) error
 	// Powchain operations.
 	SavePowchainData(ctx context.Context, data *db.ETH1ChainData) error
+	// Export queue operations.
+	AppendExportQueue(ctx context.Context, entries [][]byte) error
+	PruneExportQueue(ctx context.Context, before uint64) error
+	SaveExportCursor(ctx context.Context, sink string, cursor uint64) error
 
 	// Run any required database migrations.
 	RunMigrations(ctx context.Context) error
 
 	CleanUpDirtyStates(ctx context.Context, slotsPerArchivedPoint uint64) error
+	PruneHistoryBelow(ctx context.Context, slot uint64) (uint64, error)
 }
 
 // HeadAccessDatabase defines a struct with access to reading chain head data.
@@ -95,6 +106,8 @@ type HeadAccessDatabase interface {
 	// Block related methods.
 	HeadBlock(ctx context.Context) (*eth.SignedBeaconBlock, error)
 	SaveHeadBlockRoot(ctx context.Context, blockRoot [32]byte) error
+	// Checkpoint sync methods.
+	SaveOrigin(ctx context.Context, state *state.BeaconState, block *eth.SignedBeaconBlock) error
 }
 
 // Database interface with full access.
@@ -107,4 +120,5 @@ type Database interface {
 
 	// Backup and restore methods
 	Backup(ctx context.Context, outputDir string) error
+	PruneBackups(ctx context.Context, outputDir string, retain int) error
 }
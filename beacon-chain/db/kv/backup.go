@@ -3,7 +3,12 @@ package kv
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
+	"os"
 	"path"
+	"sort"
+	"strconv"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/shared/fileutil"
@@ -13,7 +18,11 @@ import (
 	"go.opencensus.io/trace"
 )
 
-const backupsDirectoryName = "backups"
+const (
+	backupsDirectoryName = "backups"
+	backupFilePrefix     = "prysm_beacondb_at_slot_"
+	backupFileSuffix     = ".backup"
+)
 
 // Backup the database to the datadir backup directory.
 // Example for backup at slot 345: $DATADIR/backups/prysm_beacondb_at_slot_0000345.backup
@@ -21,15 +30,9 @@ func (s *Store) Backup(ctx context.Context, outputDir string) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.Backup")
 	defer span.End()
 
-	var backupsDir string
-	var err error
-	if outputDir != "" {
-		backupsDir, err = fileutil.ExpandPath(outputDir)
-		if err != nil {
-			return err
-		}
-	} else {
-		backupsDir = path.Join(s.databasePath, backupsDirectoryName)
+	backupsDir, err := s.backupsDir(outputDir)
+	if err != nil {
+		return err
 	}
 	head, err := s.HeadBlock(ctx)
 	if err != nil {
@@ -42,7 +45,7 @@ func (s *Store) Backup(ctx context.Context, outputDir string) error {
 	if err := fileutil.MkdirAll(backupsDir); err != nil {
 		return err
 	}
-	backupPath := path.Join(backupsDir, fmt.Sprintf("prysm_beacondb_at_slot_%07d.backup", head.Block.Slot))
+	backupPath := path.Join(backupsDir, fmt.Sprintf("%s%07d%s", backupFilePrefix, head.Block.Slot, backupFileSuffix))
 	logrus.WithField("prefix", "db").WithField("backup", backupPath).Info("Writing backup database.")
 
 	copyDB, err := bolt.Open(
@@ -72,3 +75,61 @@ func (s *Store) Backup(ctx context.Context, outputDir string) error {
 		})
 	})
 }
+
+// PruneBackups removes the oldest backups from the backup directory, keeping the given number of
+// backups with the highest slots. Files in the directory which are not backups are left untouched.
+func (s *Store) PruneBackups(ctx context.Context, outputDir string, retain int) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PruneBackups")
+	defer span.End()
+
+	backupsDir, err := s.backupsDir(outputDir)
+	if err != nil {
+		return err
+	}
+	files, err := ioutil.ReadDir(backupsDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	type backup struct {
+		name string
+		slot uint64
+	}
+	backups := make([]backup, 0, len(files))
+	for _, f := range files {
+		name := f.Name()
+		if f.IsDir() || !strings.HasPrefix(name, backupFilePrefix) || !strings.HasSuffix(name, backupFileSuffix) {
+			continue
+		}
+		slot, err := strconv.ParseUint(strings.TrimSuffix(strings.TrimPrefix(name, backupFilePrefix), backupFileSuffix), 10, 64)
+		if err != nil {
+			continue
+		}
+		backups = append(backups, backup{name: name, slot: slot})
+	}
+	if len(backups) <= retain {
+		return nil
+	}
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].slot < backups[j].slot
+	})
+	for _, b := range backups[:len(backups)-retain] {
+		backupPath := path.Join(backupsDir, b.name)
+		if err := os.Remove(backupPath); err != nil {
+			return errors.Wrap(err, "could not remove backup")
+		}
+		logrus.WithField("prefix", "db").WithField("backup", backupPath).Debug("Removed old backup")
+	}
+	return nil
+}
+
+// backupsDir returns the configured backup directory, or the backups directory inside the data
+// directory if none is configured.
+func (s *Store) backupsDir(outputDir string) (string, error) {
+	if outputDir != "" {
+		return fileutil.ExpandPath(outputDir)
+	}
+	return path.Join(s.databasePath, backupsDirectoryName), nil
+}
@@ -6,7 +6,9 @@ import (
 	"path"
 	"testing"
 
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 )
 
@@ -30,3 +32,34 @@ func TestStore_Backup(t *testing.T) {
 	require.NoError(t, err)
 	require.NotEqual(t, 0, len(files), "No backups created")
 }
+
+func TestStore_PruneBackups(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	outputDir := path.Join(t.TempDir(), backupsDirectoryName)
+
+	for _, slot := range []uint64{5000, 10, 12345678, 300} {
+		head := testutil.NewBeaconBlock()
+		head.Block.Slot = slot
+		require.NoError(t, db.SaveBlock(ctx, head))
+		root, err := head.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, db.SaveStateSummary(ctx, &pb.StateSummary{Slot: slot, Root: root[:]}))
+		require.NoError(t, db.SaveHeadBlockRoot(ctx, root))
+		require.NoError(t, db.Backup(ctx, outputDir))
+	}
+	require.NoError(t, ioutil.WriteFile(path.Join(outputDir, "notes.txt"), []byte("keep"), 0600))
+
+	require.NoError(t, db.PruneBackups(ctx, outputDir, 2))
+	files, err := ioutil.ReadDir(outputDir)
+	require.NoError(t, err)
+	names := make([]string, len(files))
+	for i, f := range files {
+		names[i] = f.Name()
+	}
+	assert.DeepEqual(t, []string{
+		"notes.txt",
+		"prysm_beacondb_at_slot_0005000.backup",
+		"prysm_beacondb_at_slot_12345678.backup",
+	}, names)
+}
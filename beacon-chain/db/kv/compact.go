@@ -0,0 +1,171 @@
+package kv
+
+import (
+	"context"
+	"os"
+	"path"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/dbutil"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// compactTxMaxSize bounds the amount of data written to the compacted database in a single transaction.
+const compactTxMaxSize = 64 * 1024 * 1024
+
+// Compact rewrites the database in the given directory into a fresh file, which releases the pages
+// freed by deleted objects back to the file system, and replaces the database with it. BoltDB never
+// shrinks its file on its own. The database must not be opened by a running node while compacting.
+func Compact(ctx context.Context, dirPath string) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.Compact")
+	defer span.End()
+
+	srcPath := path.Join(dirPath, databaseFileName)
+	if !fileutil.FileExists(srcPath) {
+		return nil
+	}
+	dstPath := srcPath + ".compact"
+	if err := os.RemoveAll(dstPath); err != nil {
+		return errors.Wrap(err, "could not remove stale compacted database")
+	}
+	before, err := os.Stat(srcPath)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	if err := compactFile(ctx, srcPath, dstPath); err != nil {
+		if rmErr := os.RemoveAll(dstPath); rmErr != nil {
+			logrus.WithError(rmErr).Error("Could not remove partially compacted database")
+		}
+		return err
+	}
+	after, err := os.Stat(dstPath)
+	if err != nil {
+		return err
+	}
+	if err := os.Rename(dstPath, srcPath); err != nil {
+		return errors.Wrap(err, "could not replace database with compacted database")
+	}
+	logrus.WithField("prefix", "db").WithFields(logrus.Fields{
+		"sizeBefore": before.Size(),
+		"sizeAfter":  after.Size(),
+		"duration":   time.Since(start),
+	}).Info("Compacted database")
+	return nil
+}
+
+// CompactTo writes a compacted copy of the database in the given directory to a new file at the
+// destination path, leaving the database itself untouched.
+func CompactTo(ctx context.Context, dirPath, dstPath string) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.CompactTo")
+	defer span.End()
+
+	srcPath := path.Join(dirPath, databaseFileName)
+	if !fileutil.FileExists(srcPath) {
+		return errors.Errorf("no database at %s", srcPath)
+	}
+	if fileutil.FileExists(dstPath) {
+		return errors.Errorf("file %s already exists", dstPath)
+	}
+	if err := compactFile(ctx, srcPath, dstPath); err != nil {
+		if rmErr := os.RemoveAll(dstPath); rmErr != nil {
+			logrus.WithError(rmErr).Error("Could not remove partially compacted database")
+		}
+		return err
+	}
+	return nil
+}
+
+// compactFile copies every bucket of the source database into a new database file at the destination path.
+func compactFile(ctx context.Context, srcPath, dstPath string) error {
+	src, err := dbutil.OpenReadOnly(srcPath)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := src.Close(); err != nil {
+			logrus.WithError(err).Error("Failed to close source database")
+		}
+	}()
+	dst, err := bolt.Open(dstPath, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{Timeout: 1 * time.Second})
+	if err != nil {
+		return err
+	}
+
+	var size int64
+	dstTx, err := dst.Begin(true)
+	if err != nil {
+		if closeErr := dst.Close(); closeErr != nil {
+			logrus.WithError(closeErr).Error("Failed to close destination database")
+		}
+		return err
+	}
+	err = src.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			return copyBucket(ctx, b, [][]byte{name}, &dstTx, dst, &size)
+		})
+	})
+	if err == nil {
+		err = dstTx.Commit()
+	} else if rbErr := dstTx.Rollback(); rbErr != nil {
+		logrus.WithError(rbErr).Error("Could not roll back compaction transaction")
+	}
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// copyBucket copies the keys and nested buckets of a bucket to the bucket at the same path in the
+// destination transaction. The destination transaction is committed and replaced whenever it has
+// grown beyond compactTxMaxSize.
+func copyBucket(ctx context.Context, b *bolt.Bucket, bucketPath [][]byte, dstTx **bolt.Tx, dst *bolt.DB, size *int64) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if _, err := createBucketPath(*dstTx, bucketPath); err != nil {
+		return err
+	}
+	return b.ForEach(func(k, v []byte) error {
+		if v == nil {
+			return copyBucket(ctx, b.Bucket(k), append(bucketPath, k), dstTx, dst, size)
+		}
+		if *size+int64(len(k)+len(v)) > compactTxMaxSize {
+			if err := (*dstTx).Commit(); err != nil {
+				return err
+			}
+			tx, err := dst.Begin(true)
+			if err != nil {
+				return err
+			}
+			*dstTx = tx
+			*size = 0
+		}
+		bkt, err := createBucketPath(*dstTx, bucketPath)
+		if err != nil {
+			return err
+		}
+		// Keys are copied in order, so the pages can be filled completely.
+		bkt.FillPercent = 1.0
+		*size += int64(len(k) + len(v))
+		return bkt.Put(k, v)
+	})
+}
+
+func createBucketPath(tx *bolt.Tx, bucketPath [][]byte) (*bolt.Bucket, error) {
+	bkt, err := tx.CreateBucketIfNotExists(bucketPath[0])
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range bucketPath[1:] {
+		if bkt, err = bkt.CreateBucketIfNotExists(name); err != nil {
+			return nil, err
+		}
+	}
+	return bkt, nil
+}
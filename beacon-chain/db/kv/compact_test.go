@@ -0,0 +1,46 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestCompact(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	db, err := NewKVStore(dir, cache.NewStateSummaryCache())
+	require.NoError(t, err)
+	blks := makeBlocks(t, 0, 100, genesisBlockRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	require.NoError(t, db.deleteBlocks(ctx, rootsOf(t, blks[:50])))
+	require.NoError(t, db.Close())
+
+	require.NoError(t, Compact(ctx, dir))
+
+	db, err = NewKVStore(dir, cache.NewStateSummaryCache())
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	for i, root := range rootsOf(t, blks) {
+		assert.Equal(t, i >= 50, db.HasBlock(ctx, root), "Unexpected block at index %d", i)
+	}
+	retrieved, err := db.Block(ctx, rootsOf(t, blks[99:])[0])
+	require.NoError(t, err)
+	assert.DeepEqual(t, blks[99], retrieved)
+}
+
+func rootsOf(t *testing.T, blks []*ethpb.SignedBeaconBlock) [][32]byte {
+	roots := make([][32]byte, len(blks))
+	for i, blk := range blks {
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		roots[i] = root
+	}
+	return roots
+}
@@ -0,0 +1,103 @@
+package kv
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// AppendExportQueue adds the given encoded objects to the end of the export queue,
+// in order. Each entry is assigned the next sequence number of the queue.
+func (s *Store) AppendExportQueue(ctx context.Context, entries [][]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.AppendExportQueue")
+	defer span.End()
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(exportQueueBucket)
+		for _, entry := range entries {
+			seq, err := bkt.NextSequence()
+			if err != nil {
+				return err
+			}
+			if err := bkt.Put(bytesutil.Uint64ToBytesBigEndian(seq), entry); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	traceutil.AnnotateError(span, err)
+	return err
+}
+
+// ExportQueue returns up to limit entries of the export queue, starting at the given
+// sequence number, together with their sequence numbers.
+func (s *Store) ExportQueue(ctx context.Context, start uint64, limit int) ([]uint64, [][]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ExportQueue")
+	defer span.End()
+
+	seqs := make([]uint64, 0, limit)
+	entries := make([][]byte, 0, limit)
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(exportQueueBucket).Cursor()
+		for k, v := c.Seek(bytesutil.Uint64ToBytesBigEndian(start)); k != nil && len(entries) < limit; k, v = c.Next() {
+			entry := make([]byte, len(v))
+			copy(entry, v)
+			seqs = append(seqs, bytesutil.BytesToUint64BigEndian(k))
+			entries = append(entries, entry)
+		}
+		return nil
+	})
+	traceutil.AnnotateError(span, err)
+	return seqs, entries, err
+}
+
+// PruneExportQueue deletes every export queue entry with a sequence number lower than the given one.
+func (s *Store) PruneExportQueue(ctx context.Context, before uint64) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PruneExportQueue")
+	defer span.End()
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		c := tx.Bucket(exportQueueBucket).Cursor()
+		for k, _ := c.First(); k != nil && bytesutil.BytesToUint64BigEndian(k) < before; k, _ = c.Next() {
+			if err := c.Delete(); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	traceutil.AnnotateError(span, err)
+	return err
+}
+
+// ExportCursor returns the sequence number of the next export queue entry to be delivered
+// to the named export sink. It is zero for sinks which have not delivered anything yet.
+func (s *Store) ExportCursor(ctx context.Context, sink string) (uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.ExportCursor")
+	defer span.End()
+
+	var cursor uint64
+	err := s.db.View(func(tx *bolt.Tx) error {
+		enc := tx.Bucket(exportCursorsBucket).Get([]byte(sink))
+		if len(enc) != 0 {
+			cursor = bytesutil.BytesToUint64BigEndian(enc)
+		}
+		return nil
+	})
+	return cursor, err
+}
+
+// SaveExportCursor saves the sequence number of the next export queue entry to be delivered
+// to the named export sink.
+func (s *Store) SaveExportCursor(ctx context.Context, sink string, cursor uint64) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveExportCursor")
+	defer span.End()
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(exportCursorsBucket).Put([]byte(sink), bytesutil.Uint64ToBytesBigEndian(cursor))
+	})
+	traceutil.AnnotateError(span, err)
+	return err
+}
@@ -0,0 +1,57 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_ExportQueue(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	require.NoError(t, db.AppendExportQueue(ctx, [][]byte{{'a'}, {'b'}}))
+	require.NoError(t, db.AppendExportQueue(ctx, [][]byte{{'c'}}))
+
+	seqs, entries, err := db.ExportQueue(ctx, 0, 10)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint64{1, 2, 3}, seqs)
+	assert.DeepEqual(t, [][]byte{{'a'}, {'b'}, {'c'}}, entries)
+
+	seqs, entries, err = db.ExportQueue(ctx, 2, 1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint64{2}, seqs)
+	assert.DeepEqual(t, [][]byte{{'b'}}, entries)
+
+	require.NoError(t, db.PruneExportQueue(ctx, 3))
+	seqs, entries, err = db.ExportQueue(ctx, 0, 10)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint64{3}, seqs)
+	assert.DeepEqual(t, [][]byte{{'c'}}, entries)
+
+	// Sequence numbers keep increasing after pruning.
+	require.NoError(t, db.PruneExportQueue(ctx, 4))
+	require.NoError(t, db.AppendExportQueue(ctx, [][]byte{{'d'}}))
+	seqs, _, err = db.ExportQueue(ctx, 0, 10)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint64{4}, seqs)
+}
+
+func TestStore_ExportCursor(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	cursor, err := db.ExportCursor(ctx, "file")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), cursor)
+
+	require.NoError(t, db.SaveExportCursor(ctx, "file", 42))
+	cursor, err = db.ExportCursor(ctx, "file")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), cursor)
+	cursor, err = db.ExportCursor(ctx, "webhook")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), cursor)
+}
@@ -0,0 +1,53 @@
+package kv
+
+import (
+	"context"
+
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// DatabaseStats summarizes the size of the database file and of its buckets.
+type DatabaseStats struct {
+	// FileSize is the size of the database file in bytes.
+	FileSize int64
+	// FreeSize is the number of bytes in free pages, which are released by compacting the database.
+	FreeSize int
+	Buckets  []*BucketStats
+}
+
+// BucketStats summarizes the contents of a top level bucket.
+type BucketStats struct {
+	Name string
+	// Keys is the number of keys in the bucket.
+	Keys int
+	// InuseSize is the number of bytes used by the keys and values of the bucket.
+	InuseSize int
+	// AllocSize is the number of bytes in the pages allocated to the bucket.
+	AllocSize int
+}
+
+// Stats returns the size of the database file, its free space and the key count and size of every bucket.
+func (s *Store) Stats(ctx context.Context) (*DatabaseStats, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.Stats")
+	defer span.End()
+
+	stats := &DatabaseStats{FreeSize: s.db.Stats().FreeAlloc}
+	err := s.db.View(func(tx *bolt.Tx) error {
+		stats.FileSize = tx.Size()
+		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			bs := b.Stats()
+			stats.Buckets = append(stats.Buckets, &BucketStats{
+				Name:      string(name),
+				Keys:      bs.KeyN,
+				InuseSize: bs.BranchInuse + bs.LeafInuse,
+				AllocSize: bs.BranchAlloc + bs.LeafAlloc,
+			})
+			return nil
+		})
+	})
+	return stats, err
+}
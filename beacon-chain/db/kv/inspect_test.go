@@ -0,0 +1,30 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_Stats(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	blks := makeBlocks(t, 0, 10, genesisBlockRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+
+	stats, err := db.Stats(ctx)
+	require.NoError(t, err)
+	assert.NotEqual(t, int64(0), stats.FileSize)
+	found := false
+	for _, b := range stats.Buckets {
+		if b.Name != string(blocksBucket) {
+			continue
+		}
+		found = true
+		assert.Equal(t, len(blks), b.Keys)
+		assert.NotEqual(t, 0, b.InuseSize)
+	}
+	assert.Equal(t, true, found, "No stats for the blocks bucket")
+}
@@ -13,6 +13,7 @@ import (
 	prombolt "github.com/prysmaticlabs/prombbolt"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/shared/dbutil"
 	"github.com/prysmaticlabs/prysm/shared/fileutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	bolt "go.etcd.io/bbolt"
@@ -65,32 +66,11 @@ func NewKVStore(dirPath string, stateSummaryCache *cache.StateSummaryCache) (*St
 		return nil, err
 	}
 	boltDB.AllocSize = boltAllocSize
-	blockCache, err := ristretto.NewCache(&ristretto.Config{
-		NumCounters: 1000,           // number of keys to track frequency of (1000).
-		MaxCost:     BlockCacheSize, // maximum cost of cache (1000 Blocks).
-		BufferItems: 64,             // number of keys per Get buffer.
-	})
-	if err != nil {
-		return nil, err
-	}
-
-	validatorCache, err := ristretto.NewCache(&ristretto.Config{
-		NumCounters: NumOfVotes,     // number of keys to track frequency of (1M).
-		MaxCost:     VotesCacheSize, // maximum cost of cache (8MB).
-		BufferItems: 64,             // number of keys per Get buffer.
-	})
+	kv, err := newStore(boltDB, dirPath, stateSummaryCache)
 	if err != nil {
 		return nil, err
 	}
 
-	kv := &Store{
-		db:                  boltDB,
-		databasePath:        dirPath,
-		blockCache:          blockCache,
-		validatorIndexCache: validatorCache,
-		stateSummaryCache:   stateSummaryCache,
-	}
-
 	if err := kv.db.Update(func(tx *bolt.Tx) error {
 		return createBuckets(
 			tx,
@@ -104,6 +84,8 @@ func NewKVStore(dirPath string, stateSummaryCache *cache.StateSummaryCache) (*St
 			checkpointBucket,
 			powchainBucket,
 			stateSummaryBucket,
+			exportQueueBucket,
+			exportCursorsBucket,
 			// Indices buckets.
 			attestationHeadBlockRootBucket,
 			attestationSourceRootIndicesBucket,
@@ -128,6 +110,45 @@ func NewKVStore(dirPath string, stateSummaryCache *cache.StateSummaryCache) (*St
 	return kv, err
 }
 
+// NewReadOnlyKVStore opens the existing database in the given directory for reading only, without
+// creating missing buckets or running migrations. It fails if a node holds the database.
+func NewReadOnlyKVStore(dirPath string) (*Store, error) {
+	boltDB, err := dbutil.OpenReadOnly(path.Join(dirPath, databaseFileName))
+	if err != nil {
+		return nil, err
+	}
+	return newStore(boltDB, dirPath, cache.NewStateSummaryCache())
+}
+
+// newStore wraps the opened bolt database with the caches of the store.
+func newStore(boltDB *bolt.DB, dirPath string, stateSummaryCache *cache.StateSummaryCache) (*Store, error) {
+	blockCache, err := ristretto.NewCache(&ristretto.Config{
+		NumCounters: 1000,           // number of keys to track frequency of (1000).
+		MaxCost:     BlockCacheSize, // maximum cost of cache (1000 Blocks).
+		BufferItems: 64,             // number of keys per Get buffer.
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	validatorCache, err := ristretto.NewCache(&ristretto.Config{
+		NumCounters: NumOfVotes,     // number of keys to track frequency of (1M).
+		MaxCost:     VotesCacheSize, // maximum cost of cache (8MB).
+		BufferItems: 64,             // number of keys per Get buffer.
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Store{
+		db:                  boltDB,
+		databasePath:        dirPath,
+		blockCache:          blockCache,
+		validatorIndexCache: validatorCache,
+		stateSummaryCache:   stateSummaryCache,
+	}, nil
+}
+
 // ClearDB removes the previously stored database in the data directory.
 func (s *Store) ClearDB() error {
 	if _, err := os.Stat(s.databasePath); os.IsNotExist(err) {
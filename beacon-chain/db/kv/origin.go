@@ -0,0 +1,206 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/p2putils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// OriginBlockRoot returns the root of the block the database was seeded with through checkpoint sync.
+// A zero root is returned if the node was started from genesis.
+func (s *Store) OriginBlockRoot(ctx context.Context) ([32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.OriginBlockRoot")
+	defer span.End()
+	var root [32]byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		copy(root[:], tx.Bucket(blocksBucket).Get(originBlockRootKey))
+		return nil
+	})
+	return root, err
+}
+
+// BackfillBlockRoot returns the root of the lowest block backfilled below the checkpoint sync origin.
+// The blocks from this block up to the origin block are all in the database. A zero root is returned
+// if no block has been backfilled yet.
+func (s *Store) BackfillBlockRoot(ctx context.Context) ([32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.BackfillBlockRoot")
+	defer span.End()
+	var root [32]byte
+	err := s.db.View(func(tx *bolt.Tx) error {
+		copy(root[:], tx.Bucket(blocksBucket).Get(backfillBlockRootKey))
+		return nil
+	})
+	return root, err
+}
+
+// SaveBackfillBlockRoot records the progress of backfilling the history below the checkpoint sync origin.
+// The blocks between the previously backfilled block and the given block must have been saved beforehand,
+// they are indexed as finalized while walking down their parent roots, so they can be served to peers.
+func (s *Store) SaveBackfillBlockRoot(ctx context.Context, blockRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveBackfillBlockRoot")
+	defer span.End()
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(blocksBucket)
+		idx := tx.Bucket(finalizedBlockRootsIndexBucket)
+		child := bkt.Get(backfillBlockRootKey)
+		if child == nil {
+			child = bkt.Get(originBlockRootKey)
+		}
+		if child == nil {
+			return errors.New("no origin block root in db")
+		}
+		for !bytes.Equal(child, blockRoot[:]) {
+			childBlock := &ethpb.SignedBeaconBlock{}
+			if err := decode(ctx, bkt.Get(child), childBlock); err != nil {
+				return err
+			}
+			root := childBlock.Block.ParentRoot
+			enc := bkt.Get(root)
+			if enc == nil {
+				return errors.Errorf("missing block %#x in the chain from %#x to %#x", root, child, blockRoot)
+			}
+			blk := &ethpb.SignedBeaconBlock{}
+			if err := decode(ctx, enc, blk); err != nil {
+				return err
+			}
+			container, err := encode(ctx, &dbpb.FinalizedBlockRootContainer{
+				ParentRoot: blk.Block.ParentRoot,
+				ChildRoot:  child,
+			})
+			if err != nil {
+				return err
+			}
+			if err := idx.Put(root, container); err != nil {
+				return err
+			}
+			child = root
+		}
+		return bkt.Put(backfillBlockRootKey, blockRoot[:])
+	})
+}
+
+// SaveOrigin seeds an empty database with a finalized checkpoint state and the block that state
+// was produced by. The block becomes the head, the justified and the finalized checkpoint of the
+// chain, so that the node syncs forward from it instead of from genesis. The history before the
+// origin block is not available until it is backfilled. Everything is written in a single
+// transaction, so that a failure leaves the database empty.
+func (s *Store) SaveOrigin(ctx context.Context, st *state.BeaconState, blk *ethpb.SignedBeaconBlock) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveOrigin")
+	defer span.End()
+
+	if st == nil || blk == nil || blk.Block == nil {
+		return errors.New("nil checkpoint state or block")
+	}
+	if st.Slot()%params.BeaconConfig().SlotsPerEpoch != 0 {
+		return errors.Errorf("checkpoint state slot %d is not at an epoch boundary", st.Slot())
+	}
+	if blk.Block.Slot != st.Slot() {
+		return errors.Errorf("checkpoint block slot %d does not match state slot %d", blk.Block.Slot, st.Slot())
+	}
+	if err := verifyOriginNetwork(st); err != nil {
+		return err
+	}
+	stateRoot, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not hash checkpoint state")
+	}
+	if !bytes.Equal(blk.Block.StateRoot, stateRoot[:]) {
+		return errors.Errorf("checkpoint block state root %#x does not match state root %#x", blk.Block.StateRoot, stateRoot)
+	}
+	blockRoot, err := blk.Block.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not hash checkpoint block")
+	}
+	encBlock, err := encode(ctx, blk)
+	if err != nil {
+		return err
+	}
+	encState, err := encode(ctx, st.InnerStateUnsafe())
+	if err != nil {
+		return err
+	}
+	encSummary, err := encode(ctx, &pbp2p.StateSummary{Slot: st.Slot(), Root: blockRoot[:]})
+	if err != nil {
+		return err
+	}
+	cp := &ethpb.Checkpoint{Epoch: helpers.SlotToEpoch(st.Slot()), Root: blockRoot[:]}
+	encCheckpoint, err := encode(ctx, cp)
+	if err != nil {
+		return err
+	}
+	encContainer, err := encode(ctx, &dbpb.FinalizedBlockRootContainer{ParentRoot: blk.Block.ParentRoot})
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		blocks := tx.Bucket(blocksBucket)
+		if blocks.Get(headBlockRootKey) != nil {
+			return errors.New("database is not empty")
+		}
+		if err := updateValueForIndices(ctx, createBlockIndicesFromBlock(ctx, blk.Block), blockRoot[:], tx); err != nil {
+			return errors.Wrap(err, "could not update block indices")
+		}
+		if err := blocks.Put(blockRoot[:], encBlock); err != nil {
+			return errors.Wrap(err, "could not save checkpoint block")
+		}
+		if err := updateValueForIndices(ctx, createStateIndicesFromStateSlot(ctx, st.Slot()), blockRoot[:], tx); err != nil {
+			return errors.Wrap(err, "could not update state indices")
+		}
+		if err := tx.Bucket(stateBucket).Put(blockRoot[:], encState); err != nil {
+			return errors.Wrap(err, "could not save checkpoint state")
+		}
+		if err := tx.Bucket(stateSummaryBucket).Put(blockRoot[:], encSummary); err != nil {
+			return errors.Wrap(err, "could not save checkpoint state summary")
+		}
+		if err := blocks.Put(originBlockRootKey, blockRoot[:]); err != nil {
+			return errors.Wrap(err, "could not save origin block root")
+		}
+		if err := blocks.Put(headBlockRootKey, blockRoot[:]); err != nil {
+			return errors.Wrap(err, "could not save head block root")
+		}
+		checkpoints := tx.Bucket(checkpointBucket)
+		if err := checkpoints.Put(justifiedCheckpointKey, encCheckpoint); err != nil {
+			return errors.Wrap(err, "could not save justified checkpoint")
+		}
+		if err := checkpoints.Put(finalizedCheckpointKey, encCheckpoint); err != nil {
+			return errors.Wrap(err, "could not save finalized checkpoint")
+		}
+		// The origin block is the first entry of the finalized block roots index, the blocks
+		// before it are indexed as they are backfilled.
+		finalized := tx.Bucket(finalizedBlockRootsIndexBucket)
+		if err := finalized.Put(blockRoot[:], encContainer); err != nil {
+			return errors.Wrap(err, "could not index checkpoint block as finalized")
+		}
+		return finalized.Put(previousFinalizedCheckpointKey, encCheckpoint)
+	})
+}
+
+// verifyOriginNetwork checks that the checkpoint state belongs to the network the node is configured
+// for, by comparing its fork version with the fork version scheduled at its epoch.
+func verifyOriginNetwork(st *state.BeaconState) error {
+	if root := st.GenesisValidatorRoot(); len(root) == 0 || bytes.Equal(root, params.BeaconConfig().ZeroHash[:]) {
+		return errors.New("checkpoint state has no genesis validators root")
+	}
+	fork, err := p2putils.Fork(helpers.SlotToEpoch(st.Slot()))
+	if err != nil {
+		return err
+	}
+	if st.Fork() == nil || !bytes.Equal(st.Fork().CurrentVersion, fork.CurrentVersion) {
+		return errors.Errorf(
+			"checkpoint state fork version %#x does not match the %s network fork version %#x",
+			st.Fork().GetCurrentVersion(), params.BeaconConfig().NetworkName, fork.CurrentVersion,
+		)
+	}
+	return nil
+}
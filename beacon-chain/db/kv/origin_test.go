@@ -0,0 +1,135 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func originStateAndBlock(t *testing.T, slot uint64) (*state.BeaconState, [32]byte, *ethpb.SignedBeaconBlock) {
+	st := testutil.NewBeaconState()
+	require.NoError(t, st.SetSlot(slot))
+	require.NoError(t, st.SetGenesisValidatorRoot(bytesutil.PadTo([]byte("genesis"), 32)))
+	stateRoot, err := st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = slot
+	blk.Block.ParentRoot = bytesutil.PadTo([]byte("parent"), 32)
+	blk.Block.StateRoot = stateRoot[:]
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	return st, root, blk
+}
+
+func TestStore_SaveOrigin(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	slot := 10 * params.BeaconConfig().SlotsPerEpoch
+	st, root, blk := originStateAndBlock(t, slot)
+
+	require.NoError(t, db.SaveOrigin(ctx, st, blk))
+
+	origin, err := db.OriginBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, root, origin)
+	head, err := db.HeadBlock(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, blk, head)
+	assert.Equal(t, true, db.HasState(ctx, root))
+	assert.Equal(t, true, db.HasStateSummary(ctx, root))
+	finalized, err := db.FinalizedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), finalized.Epoch)
+	assert.DeepEqual(t, root[:], finalized.Root)
+	justified, err := db.JustifiedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, finalized, justified)
+	assert.Equal(t, true, db.IsFinalizedBlock(ctx, root))
+
+	assert.ErrorContains(t, "database is not empty", db.SaveOrigin(ctx, st, blk))
+}
+
+func TestStore_SaveOrigin_Invalid(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	st, _, blk := originStateAndBlock(t, params.BeaconConfig().SlotsPerEpoch+1)
+	assert.ErrorContains(t, "not at an epoch boundary", db.SaveOrigin(ctx, st, blk))
+
+	st, _, blk = originStateAndBlock(t, params.BeaconConfig().SlotsPerEpoch)
+	blk.Block.Slot++
+	assert.ErrorContains(t, "does not match state slot", db.SaveOrigin(ctx, st, blk))
+
+	st, _, blk = originStateAndBlock(t, params.BeaconConfig().SlotsPerEpoch)
+	blk.Block.StateRoot = make([]byte, 32)
+	assert.ErrorContains(t, "does not match state root", db.SaveOrigin(ctx, st, blk))
+
+	st, _, blk = originStateAndBlock(t, params.BeaconConfig().SlotsPerEpoch)
+	require.NoError(t, st.SetGenesisValidatorRoot(make([]byte, 32)))
+	assert.ErrorContains(t, "no genesis validators root", db.SaveOrigin(ctx, st, blk))
+
+	st, _, blk = originStateAndBlock(t, params.BeaconConfig().SlotsPerEpoch)
+	require.NoError(t, st.SetFork(&pb.Fork{PreviousVersion: []byte{0, 0, 0x20, 0x09}, CurrentVersion: []byte{0, 0, 0x20, 0x09}}))
+	assert.ErrorContains(t, "does not match the Mainnet network fork version", db.SaveOrigin(ctx, st, blk))
+
+	origin, err := db.OriginBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{}, origin)
+}
+
+func TestStore_SaveBackfillBlockRoot(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	genesis := testutil.NewBeaconBlock()
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	parentRoot := genesisRoot
+	var history []*ethpb.SignedBeaconBlock
+	var roots [][32]byte
+	for slot := uint64(1); slot < params.BeaconConfig().SlotsPerEpoch; slot++ {
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = slot
+		blk.Block.ParentRoot = bytesutil.SafeCopyBytes(parentRoot[:])
+		parentRoot, err = blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		history = append(history, blk)
+		roots = append(roots, parentRoot)
+	}
+	st, _, origin := originStateAndBlock(t, params.BeaconConfig().SlotsPerEpoch)
+	origin.Block.ParentRoot = parentRoot[:]
+	require.NoError(t, db.SaveOrigin(ctx, st, origin))
+
+	root, err := db.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{}, root)
+	assert.ErrorContains(t, "missing block", db.SaveBackfillBlockRoot(ctx, roots[0]))
+
+	require.NoError(t, db.SaveBlocks(ctx, history[len(history)/2:]))
+	require.NoError(t, db.SaveBackfillBlockRoot(ctx, roots[len(roots)/2]))
+	root, err = db.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, roots[len(roots)/2], root)
+	assert.Equal(t, true, db.IsFinalizedBlock(ctx, roots[len(roots)-1]))
+	assert.Equal(t, false, db.IsFinalizedBlock(ctx, roots[0]))
+
+	require.NoError(t, db.SaveBlocks(ctx, append(history[:len(history)/2], genesis)))
+	require.NoError(t, db.SaveBackfillBlockRoot(ctx, genesisRoot))
+	for _, r := range roots {
+		assert.Equal(t, true, db.IsFinalizedBlock(ctx, r))
+	}
+	child, err := db.FinalizedChildBlock(ctx, roots[len(roots)-1])
+	require.NoError(t, err)
+	assert.DeepEqual(t, origin, child)
+	child, err = db.FinalizedChildBlock(ctx, genesisRoot)
+	require.NoError(t, err)
+	assert.DeepEqual(t, history[0], child)
+}
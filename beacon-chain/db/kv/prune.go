@@ -0,0 +1,126 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// pruneBatchSize is the number of block roots whose objects are deleted in a single db transaction,
+// so that pruning a large history does not block writers for long.
+const pruneBatchSize = 256
+
+// PruneHistoryBelow deletes the blocks, states and state summaries of the finalized history below
+// the given slot, along with their slot indices and finalized block roots index entries. The
+// pruning boundary is lowered to the highest saved state at or below the slot, so the remaining
+// blocks can still be replayed from an archived state. The genesis block and state are kept.
+// It returns the slot of the lowest block or state which is retained.
+func (s *Store) PruneHistoryBelow(ctx context.Context, slot uint64) (uint64, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PruneHistoryBelow")
+	defer span.End()
+
+	f, err := s.FinalizedCheckpoint(ctx)
+	if err != nil {
+		return 0, err
+	}
+	finalized, err := s.Block(ctx, bytesutil.ToBytes32(f.Root))
+	if err != nil {
+		return 0, err
+	}
+	if finalized == nil || finalized.Block == nil || slot > finalized.Block.Slot {
+		return 0, errors.New("cannot prune history above the finalized block")
+	}
+
+	var boundary uint64
+	roots := make([][]byte, 0)
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		genesisRoot := tx.Bucket(blocksBucket).Get(genesisBlockRootKey)
+		c := tx.Bucket(stateSlotIndicesBucket).Cursor()
+		for k, _ := c.First(); k != nil && bytesutil.BytesToUint64BigEndian(k) <= slot; k, _ = c.Next() {
+			boundary = bytesutil.BytesToUint64BigEndian(k)
+		}
+		seen := make(map[string]bool)
+		collect := func(bkt *bolt.Bucket) {
+			c := bkt.Cursor()
+			for k, v := c.First(); k != nil && bytesutil.BytesToUint64BigEndian(k) < boundary; k, v = c.Next() {
+				for i := 0; i+32 <= len(v); i += 32 {
+					root := v[i : i+32]
+					if bytes.Equal(root, genesisRoot) || seen[string(root)] {
+						continue
+					}
+					seen[string(root)] = true
+					roots = append(roots, bytesutil.SafeCopyBytes(root))
+				}
+			}
+		}
+		collect(tx.Bucket(blockSlotIndicesBucket))
+		collect(tx.Bucket(stateSlotIndicesBucket))
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+
+	for len(roots) > 0 {
+		if ctx.Err() != nil {
+			return 0, ctx.Err()
+		}
+		n := pruneBatchSize
+		if n > len(roots) {
+			n = len(roots)
+		}
+		if err := s.db.Update(func(tx *bolt.Tx) error {
+			return s.pruneRoots(ctx, tx, roots[:n])
+		}); err != nil {
+			return 0, errors.Wrap(err, "could not prune history")
+		}
+		roots = roots[n:]
+	}
+	return boundary, nil
+}
+
+// pruneRoots deletes the block, state, state summary and finalized index entry of each block root.
+func (s *Store) pruneRoots(ctx context.Context, tx *bolt.Tx, roots [][]byte) error {
+	blocks := tx.Bucket(blocksBucket)
+	states := tx.Bucket(stateBucket)
+	summaries := tx.Bucket(stateSummaryBucket)
+	finalizedIdx := tx.Bucket(finalizedBlockRootsIndexBucket)
+	for _, root := range roots {
+		if enc := blocks.Get(root); enc != nil {
+			blk := &ethpb.SignedBeaconBlock{}
+			if err := decode(ctx, enc, blk); err != nil {
+				return err
+			}
+			if err := deleteValueForIndices(ctx, createBlockIndicesFromBlock(ctx, blk.Block), root, tx); err != nil {
+				return errors.Wrap(err, "could not delete root for DB indices")
+			}
+			s.blockCache.Del(string(root))
+			if err := blocks.Delete(root); err != nil {
+				return err
+			}
+		}
+		if states.Get(root) != nil {
+			slot, err := slotByBlockRoot(ctx, tx, root)
+			if err != nil {
+				return err
+			}
+			if err := deleteValueForIndices(ctx, createStateIndicesFromStateSlot(ctx, slot), root, tx); err != nil {
+				return errors.Wrap(err, "could not delete root for DB indices")
+			}
+			if err := states.Delete(root); err != nil {
+				return err
+			}
+		}
+		if err := summaries.Delete(root); err != nil {
+			return err
+		}
+		if err := finalizedIdx.Delete(root); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_PruneHistoryBelow(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+	genesis := testutil.NewBeaconBlock()
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+	require.NoError(t, db.SaveState(ctx, testutil.NewBeaconState(), genesisRoot))
+
+	blks := makeBlocks(t, 0, 4*slotsPerEpoch, genesisRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	roots := make([][32]byte, len(blks))
+	for i, blk := range blks {
+		roots[i], err = blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, db.SaveStateSummary(ctx, &pb.StateSummary{Slot: blk.Block.Slot, Root: roots[i][:]}))
+	}
+	// Archived states at the first and second epoch boundaries.
+	for _, slot := range []uint64{slotsPerEpoch, 2 * slotsPerEpoch} {
+		st := testutil.NewBeaconState()
+		require.NoError(t, st.SetSlot(slot))
+		require.NoError(t, db.SaveState(ctx, st, roots[slot-1]))
+	}
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 3, Root: roots[3*slotsPerEpoch-1][:]}))
+
+	_, err = db.PruneHistoryBelow(ctx, 4*slotsPerEpoch)
+	assert.ErrorContains(t, "cannot prune history above the finalized block", err)
+
+	// The boundary is lowered to the highest archived state.
+	boundary, err := db.PruneHistoryBelow(ctx, 2*slotsPerEpoch+5)
+	require.NoError(t, err)
+	assert.Equal(t, 2*slotsPerEpoch, boundary)
+
+	for i, root := range roots {
+		pruned := blks[i].Block.Slot < boundary
+		assert.Equal(t, !pruned, db.HasBlock(ctx, root), "Unexpected block at slot %d", blks[i].Block.Slot)
+		assert.Equal(t, !pruned, db.HasStateSummary(ctx, root), "Unexpected state summary at slot %d", blks[i].Block.Slot)
+		if blks[i].Block.Slot < 3*slotsPerEpoch {
+			assert.Equal(t, !pruned, db.IsFinalizedBlock(ctx, root), "Unexpected finalized block at slot %d", blks[i].Block.Slot)
+		}
+	}
+	assert.Equal(t, false, db.HasState(ctx, roots[slotsPerEpoch-1]))
+	assert.Equal(t, true, db.HasState(ctx, roots[2*slotsPerEpoch-1]))
+	assert.Equal(t, true, db.HasBlock(ctx, genesisRoot))
+	assert.Equal(t, true, db.HasState(ctx, genesisRoot))
+
+	prunedRoots, err := db.BlockRoots(ctx, filters.NewFilter().SetStartSlot(1).SetEndSlot(boundary-1))
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(prunedRoots))
+	states, err := db.HighestSlotStatesBelow(ctx, 2*slotsPerEpoch)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), states[0].Slot())
+}
+
+func TestStore_PruneHistoryBelow_NoArchivedState(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	genesis := testutil.NewBeaconBlock()
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+	require.NoError(t, db.SaveState(ctx, testutil.NewBeaconState(), genesisRoot))
+	blks := makeBlocks(t, 0, params.BeaconConfig().SlotsPerEpoch, genesisRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	root, err := blks[len(blks)-1].Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveStateSummary(ctx, &pb.StateSummary{Slot: blks[len(blks)-1].Block.Slot, Root: root[:]}))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 1, Root: root[:]}))
+
+	// Without an archived state to replay from, nothing is pruned.
+	boundary, err := db.PruneHistoryBelow(ctx, params.BeaconConfig().SlotsPerEpoch)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), boundary)
+	for _, blk := range blks {
+		r, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, true, db.HasBlock(ctx, r))
+	}
+}
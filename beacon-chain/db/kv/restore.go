@@ -0,0 +1,163 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"path"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/dbutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// restoreRequiredBuckets are the buckets a backup must contain to be restored. Buckets which were
+// added to the schema later are created when the restored database is opened.
+var restoreRequiredBuckets = [][]byte{
+	blocksBucket,
+	stateBucket,
+	stateSummaryBucket,
+	chainMetadataBucket,
+	checkpointBucket,
+	blockSlotIndicesBucket,
+	stateSlotIndicesBucket,
+	blockParentRootIndicesBucket,
+	finalizedBlockRootsIndexBucket,
+	migrationsBucket,
+}
+
+// chainIdentity identifies the chain a database belongs to.
+type chainIdentity struct {
+	genesisBlockRoot      []byte
+	genesisValidatorsRoot []byte
+	fork                  *pb.Fork
+	depositContract       []byte
+}
+
+// Restore replaces the database in the given directory with a backup written by Backup. The backup
+// must contain the buckets of the current schema with completed migrations, and its genesis state
+// must belong to the configured network. If the directory already holds a database, the backup
+// must be of the same chain. The database must not be opened by a running node while restoring.
+func Restore(ctx context.Context, backupPath, dirPath string) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.Restore")
+	defer span.End()
+
+	dbPath := path.Join(dirPath, databaseFileName)
+	err := dbutil.Restore(backupPath, dbPath, restoreRequiredBuckets, func(backupTx, existingTx *bolt.Tx) error {
+		if !bytes.Equal(backupTx.Bucket(migrationsBucket).Get(migrationBlockSlotIndex0Key), migrationCompleted) {
+			return errors.Errorf("migration %s did not complete", migrationBlockSlotIndex0Key)
+		}
+		backup, err := readChainIdentity(ctx, backupTx)
+		if err != nil {
+			return errors.Wrap(err, "could not validate backup")
+		}
+		if backup == nil {
+			return errors.New("backup has no genesis or origin state")
+		}
+		if err := backup.verifyNetwork(); err != nil {
+			return err
+		}
+		if existingTx == nil {
+			return nil
+		}
+		existing, err := readChainIdentity(ctx, existingTx)
+		if err != nil {
+			return errors.Wrap(err, "could not read existing database")
+		}
+		if existing == nil {
+			return nil
+		}
+		return backup.verifySameChain(existing)
+	})
+	if err != nil {
+		return err
+	}
+	logrus.WithField("prefix", "db").WithFields(logrus.Fields{
+		"backup": backupPath,
+		"path":   dbPath,
+	}).Info("Restored database from backup")
+	return nil
+}
+
+// readChainIdentity reads the identity of the chain in the database of the given transaction. It
+// returns nil if the database has neither a genesis nor an origin block root.
+func readChainIdentity(ctx context.Context, tx *bolt.Tx) (*chainIdentity, error) {
+	blocks := tx.Bucket(blocksBucket)
+	states := tx.Bucket(stateBucket)
+	if blocks == nil || states == nil {
+		return nil, nil
+	}
+	// A checkpoint synced database has no genesis block root until its history is backfilled.
+	genesisRoot := blocks.Get(genesisBlockRootKey)
+	anchorRoot := genesisRoot
+	if anchorRoot == nil {
+		anchorRoot = blocks.Get(originBlockRootKey)
+	}
+	if anchorRoot == nil {
+		return nil, nil
+	}
+	if blocks.Get(anchorRoot) == nil {
+		return nil, errors.Errorf("missing block %#x", anchorRoot)
+	}
+	enc := states.Get(anchorRoot)
+	if enc == nil {
+		return nil, errors.Errorf("missing state %#x", anchorRoot)
+	}
+	st, err := createState(ctx, enc)
+	if err != nil {
+		return nil, err
+	}
+	id := &chainIdentity{
+		genesisBlockRoot:      bytesutil.SafeCopyBytes(genesisRoot),
+		genesisValidatorsRoot: bytesutil.SafeCopyBytes(st.GenesisValidatorsRoot),
+		fork:                  st.Fork,
+	}
+	if chainInfo := tx.Bucket(chainMetadataBucket); chainInfo != nil {
+		id.depositContract = bytesutil.SafeCopyBytes(chainInfo.Get(depositContractAddressKey))
+	}
+	return id, nil
+}
+
+// verifyNetwork checks that the chain belongs to the network the node is configured for.
+func (c *chainIdentity) verifyNetwork() error {
+	genesisFork := params.BeaconConfig().GenesisForkVersion
+	if c.fork == nil || (!bytes.Equal(c.fork.CurrentVersion, genesisFork) && !bytes.Equal(c.fork.PreviousVersion, genesisFork)) {
+		return errors.Errorf("backup is not of the configured network, genesis fork version %#x not found in state fork", genesisFork)
+	}
+	if len(c.depositContract) == 0 {
+		return nil
+	}
+	want := common.HexToAddress(params.BeaconNetworkConfig().DepositContractAddress)
+	if !bytes.Equal(c.depositContract, want.Bytes()) {
+		return errors.Errorf(
+			"backup is not of the configured network, deposit contract %#x does not match %s",
+			c.depositContract,
+			want.Hex(),
+		)
+	}
+	return nil
+}
+
+// verifySameChain checks that both databases hold the same chain.
+func (c *chainIdentity) verifySameChain(other *chainIdentity) error {
+	if !bytes.Equal(c.genesisValidatorsRoot, other.genesisValidatorsRoot) {
+		return errors.Errorf(
+			"backup genesis validators root %#x does not match the existing database %#x",
+			c.genesisValidatorsRoot,
+			other.genesisValidatorsRoot,
+		)
+	}
+	if c.genesisBlockRoot != nil && other.genesisBlockRoot != nil && !bytes.Equal(c.genesisBlockRoot, other.genesisBlockRoot) {
+		return errors.Errorf(
+			"backup genesis block root %#x does not match the existing database %#x",
+			c.genesisBlockRoot,
+			other.genesisBlockRoot,
+		)
+	}
+	return nil
+}
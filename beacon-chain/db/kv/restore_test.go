@@ -0,0 +1,147 @@
+package kv
+
+import (
+	"context"
+	"io/ioutil"
+	"path"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+// backupOf writes a backup of the database and returns its path.
+func backupOf(t *testing.T, db *Store) string {
+	require.NoError(t, db.Backup(context.Background(), ""))
+	dir := path.Join(db.databasePath, backupsDirectoryName)
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(files))
+	return path.Join(dir, files[0].Name())
+}
+
+// setupBackup writes a backup of a chain and closes its database, so that another database can be opened.
+func setupBackup(t *testing.T) (string, [][32]byte) {
+	db, err := NewKVStore(t.TempDir(), cache.NewStateSummaryCache())
+	require.NoError(t, err)
+	roots := setupChain(t, db)
+	backupPath := backupOf(t, db)
+	require.NoError(t, db.Close())
+	return backupPath, roots
+}
+
+func TestRestore(t *testing.T) {
+	ctx := context.Background()
+	backupPath, roots := setupBackup(t)
+
+	// Restore into an empty directory and over an existing database of the same chain.
+	dirPath := path.Join(t.TempDir(), "beaconchaindata")
+	require.NoError(t, Restore(ctx, backupPath, dirPath))
+	require.NoError(t, Restore(ctx, backupPath, dirPath))
+
+	restored, err := NewKVStore(dirPath, cache.NewStateSummaryCache())
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, restored.Close())
+	}()
+	head, err := restored.HeadBlock(ctx)
+	require.NoError(t, err)
+	headRoot, err := head.Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, roots[len(roots)-1], headRoot)
+	issues, err := restored.VerifyIntegrity(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(issues), "Unexpected issues: %v", issues)
+}
+
+func TestRestore_DifferentChain(t *testing.T) {
+	ctx := context.Background()
+	backupPath, _ := setupBackup(t)
+
+	other := setupDB(t)
+	genesis := testutil.NewBeaconBlock()
+	genesis.Block.Slot = 1
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, other.SaveBlock(ctx, genesis))
+	require.NoError(t, other.SaveGenesisBlockRoot(ctx, genesisRoot))
+	st := testutil.NewBeaconState()
+	require.NoError(t, st.SetGenesisValidatorRoot(bytesutil.PadTo([]byte("other"), 32)))
+	require.NoError(t, other.SaveState(ctx, st, genesisRoot))
+	assert.ErrorContains(t, "cannot obtain database lock", Restore(ctx, backupPath, other.databasePath))
+	require.NoError(t, other.Close())
+
+	assert.ErrorContains(t, "does not match the existing database", Restore(ctx, backupPath, other.databasePath))
+}
+
+func TestRestore_InvalidBackup(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name    string
+		corrupt func(t *testing.T, db *Store)
+		wantErr string
+	}{
+		{
+			name: "wrong network",
+			corrupt: func(t *testing.T, db *Store) {
+				genesis, err := db.GenesisBlock(ctx)
+				require.NoError(t, err)
+				genesisRoot, err := genesis.Block.HashTreeRoot()
+				require.NoError(t, err)
+				st := testutil.NewBeaconState()
+				require.NoError(t, st.SetFork(&pb.Fork{
+					PreviousVersion: []byte{0xff, 0xff, 0xff, 0xff},
+					CurrentVersion:  []byte{0xff, 0xff, 0xff, 0xff},
+				}))
+				require.NoError(t, db.SaveState(ctx, st, genesisRoot))
+			},
+			wantErr: "not of the configured network",
+		},
+		{
+			name: "wrong deposit contract",
+			corrupt: func(t *testing.T, db *Store) {
+				require.NoError(t, db.db.Update(func(tx *bolt.Tx) error {
+					return tx.Bucket(chainMetadataBucket).Put(depositContractAddressKey, []byte("contract"))
+				}))
+			},
+			wantErr: "deposit contract",
+		},
+		{
+			name: "incomplete migration",
+			corrupt: func(t *testing.T, db *Store) {
+				require.NoError(t, db.db.Update(func(tx *bolt.Tx) error {
+					return tx.Bucket(migrationsBucket).Delete(migrationBlockSlotIndex0Key)
+				}))
+			},
+			wantErr: "did not complete",
+		},
+		{
+			name: "missing bucket",
+			corrupt: func(t *testing.T, db *Store) {
+				require.NoError(t, db.db.Update(func(tx *bolt.Tx) error {
+					return tx.DeleteBucket(stateSummaryBucket)
+				}))
+			},
+			wantErr: "missing bucket",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupDB(t)
+			setupChain(t, db)
+			tt.corrupt(t, db)
+			backupPath := backupOf(t, db)
+
+			dirPath := t.TempDir()
+			assert.ErrorContains(t, tt.wantErr, Restore(ctx, backupPath, dirPath))
+			files, err := ioutil.ReadDir(dirPath)
+			require.NoError(t, err)
+			assert.Equal(t, 0, len(files), "Invalid backup was restored")
+		})
+	}
+}
@@ -0,0 +1,316 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	dbpb "github.com/prysmaticlabs/prysm/proto/beacon/db"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/dbutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// integrityCheck collects the issues found while walking the database in a single read transaction.
+type integrityCheck struct {
+	ctx    context.Context
+	tx     *bolt.Tx
+	issues dbutil.IntegrityIssues
+}
+
+// VerifyIntegrity walks the blocks, states and state summaries of the database and checks that they
+// are consistent with each other and with the parent root, slot, archived state and finalized block
+// roots indices. It returns the issues found, an error is only returned if the database could not be read.
+func (s *Store) VerifyIntegrity(ctx context.Context) ([]*dbutil.IntegrityIssue, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.VerifyIntegrity")
+	defer span.End()
+
+	var issues []*dbutil.IntegrityIssue
+	err := s.db.View(func(tx *bolt.Tx) error {
+		c := &integrityCheck{ctx: ctx, tx: tx}
+		checks := []func() error{
+			c.verifyMigrations,
+			c.verifyBlocks,
+			c.verifyBlockIndices,
+			c.verifyStates,
+			c.verifyStateSummaries,
+			c.verifyFinalizedBlockRoots,
+			c.verifyChainMetadata,
+		}
+		for _, check := range checks {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if err := check(); err != nil {
+				return err
+			}
+		}
+		issues = c.issues
+		return nil
+	})
+	return issues, err
+}
+
+// verifyMigrations checks that the block slot index migration completed, an interrupted migration
+// leaves the slot index in a mixed format.
+func (c *integrityCheck) verifyMigrations() error {
+	if !bytes.Equal(c.tx.Bucket(migrationsBucket).Get(migrationBlockSlotIndex0Key), migrationCompleted) {
+		c.issues.Report(migrationsBucket, migrationBlockSlotIndex0Key, "migration did not complete")
+	}
+	return nil
+}
+
+// verifyBlocks checks that every block is stored under its root, links to a parent block and is
+// present in the slot and parent root indices. The lowest blocks of a checkpoint synced or pruned
+// database are allowed to have no parent.
+func (c *integrityCheck) verifyBlocks() error {
+	bkt := c.tx.Bucket(blocksBucket)
+	specialKeys := [][]byte{headBlockRootKey, genesisBlockRootKey, originBlockRootKey, backfillBlockRootKey}
+	anchors := [][]byte{bkt.Get(originBlockRootKey), bkt.Get(backfillBlockRootKey)}
+	genesisRoot := bkt.Get(genesisBlockRootKey)
+
+	type orphan struct {
+		root       []byte
+		parentRoot []byte
+		slot       uint64
+	}
+	var orphans []orphan
+	var lowestSlot uint64
+	hasLowest := false
+	err := bkt.ForEach(func(k, v []byte) error {
+		for _, key := range specialKeys {
+			if bytes.Equal(k, key) {
+				return nil
+			}
+		}
+		blk := &ethpb.SignedBeaconBlock{}
+		if err := decode(c.ctx, v, blk); err != nil || blk.Block == nil {
+			c.issues.Report(blocksBucket, k, "could not decode block: %v", err)
+			return nil
+		}
+		root, err := blk.Block.HashTreeRoot()
+		if err != nil {
+			c.issues.Report(blocksBucket, k, "could not hash block: %v", err)
+			return nil
+		}
+		if !bytes.Equal(root[:], k) {
+			c.issues.Report(blocksBucket, k, "block is stored under the wrong root, its root is %#x", root)
+		}
+		if !rootAtIndex(c.tx.Bucket(blockSlotIndicesBucket).Get(bytesutil.Uint64ToBytesBigEndian(blk.Block.Slot)), k) {
+			c.issues.Report(blocksBucket, k, "block is missing from the slot index at slot %d", blk.Block.Slot)
+		}
+		if bytes.Equal(k, genesisRoot) || blk.Block.Slot == 0 {
+			return nil
+		}
+		if !rootAtIndex(c.tx.Bucket(blockParentRootIndicesBucket).Get(blk.Block.ParentRoot), k) {
+			c.issues.Report(blocksBucket, k, "block is missing from the parent root index of %#x", blk.Block.ParentRoot)
+		}
+		if !hasLowest || blk.Block.Slot < lowestSlot {
+			lowestSlot = blk.Block.Slot
+			hasLowest = true
+		}
+		if bkt.Get(blk.Block.ParentRoot) == nil {
+			orphans = append(orphans, orphan{
+				root:       bytesutil.SafeCopyBytes(k),
+				parentRoot: bytesutil.SafeCopyBytes(blk.Block.ParentRoot),
+				slot:       blk.Block.Slot,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, o := range orphans {
+		if o.slot == lowestSlot || bytes.Equal(o.root, anchors[0]) || bytes.Equal(o.root, anchors[1]) {
+			continue
+		}
+		c.issues.Report(blocksBucket, o.root, "parent block %#x of block at slot %d is missing", o.parentRoot, o.slot)
+	}
+	return nil
+}
+
+// verifyBlockIndices checks that the block slot and parent root indices only reference existing blocks.
+func (c *integrityCheck) verifyBlockIndices() error {
+	blocks := c.tx.Bucket(blocksBucket)
+	if err := c.tx.Bucket(blockSlotIndicesBucket).ForEach(func(k, v []byte) error {
+		if len(k) != 8 {
+			c.issues.Report(blockSlotIndicesBucket, k, "slot index key is not a big endian slot")
+			return nil
+		}
+		slot := bytesutil.BytesToUint64BigEndian(k)
+		forEachRoot(v, func(root []byte) {
+			enc := blocks.Get(root)
+			if enc == nil {
+				c.issues.Report(blockSlotIndicesBucket, k, "slot %d references missing block %#x", slot, root)
+				return
+			}
+			blk := &ethpb.SignedBeaconBlock{}
+			if err := decode(c.ctx, enc, blk); err == nil && blk.Block != nil && blk.Block.Slot != slot {
+				c.issues.Report(blockSlotIndicesBucket, k, "slot %d references block %#x at slot %d", slot, root, blk.Block.Slot)
+			}
+		})
+		return nil
+	}); err != nil {
+		return err
+	}
+	return c.tx.Bucket(blockParentRootIndicesBucket).ForEach(func(k, v []byte) error {
+		forEachRoot(v, func(root []byte) {
+			if blocks.Get(root) == nil {
+				c.issues.Report(blockParentRootIndicesBucket, k, "parent root index references missing block %#x", root)
+			}
+		})
+		return nil
+	})
+}
+
+// verifyStates checks that every state belongs to a known block root and is present in the archived
+// state slot index, and that the index only references saved states.
+func (c *integrityCheck) verifyStates() error {
+	states := c.tx.Bucket(stateBucket)
+	if err := states.ForEach(func(k, _ []byte) error {
+		slot, err := slotByBlockRoot(c.ctx, c.tx, k)
+		if err != nil {
+			c.issues.Report(stateBucket, k, "could not determine state slot: %v", err)
+			return nil
+		}
+		if !rootAtIndex(c.tx.Bucket(stateSlotIndicesBucket).Get(bytesutil.Uint64ToBytesBigEndian(slot)), k) {
+			c.issues.Report(stateBucket, k, "state is missing from the archived index at slot %d", slot)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	return c.tx.Bucket(stateSlotIndicesBucket).ForEach(func(k, v []byte) error {
+		if len(k) != 8 {
+			c.issues.Report(stateSlotIndicesBucket, k, "archived index key is not a big endian slot")
+			return nil
+		}
+		forEachRoot(v, func(root []byte) {
+			if states.Get(root) == nil {
+				c.issues.Report(stateSlotIndicesBucket, k, "archived index at slot %d references missing state %#x",
+					bytesutil.BytesToUint64BigEndian(k), root)
+			}
+		})
+		return nil
+	})
+}
+
+// verifyStateSummaries checks that every state summary is stored under its root and belongs to a saved block.
+func (c *integrityCheck) verifyStateSummaries() error {
+	blocks := c.tx.Bucket(blocksBucket)
+	return c.tx.Bucket(stateSummaryBucket).ForEach(func(k, v []byte) error {
+		summary := &pb.StateSummary{}
+		if err := decode(c.ctx, v, summary); err != nil {
+			c.issues.Report(stateSummaryBucket, k, "could not decode state summary: %v", err)
+			return nil
+		}
+		if !bytes.Equal(summary.Root, k) {
+			c.issues.Report(stateSummaryBucket, k, "state summary is stored under the wrong root, its root is %#x", summary.Root)
+		}
+		enc := blocks.Get(k)
+		if enc == nil {
+			c.issues.Report(stateSummaryBucket, k, "state summary at slot %d has no block", summary.Slot)
+			return nil
+		}
+		blk := &ethpb.SignedBeaconBlock{}
+		if err := decode(c.ctx, enc, blk); err == nil && blk.Block != nil && blk.Block.Slot != summary.Slot {
+			c.issues.Report(stateSummaryBucket, k, "state summary slot %d does not match block slot %d", summary.Slot, blk.Block.Slot)
+		}
+		return nil
+	})
+}
+
+// verifyFinalizedBlockRoots checks that the finalized block roots index forms a chain of saved blocks
+// which links parents to children.
+func (c *integrityCheck) verifyFinalizedBlockRoots() error {
+	blocks := c.tx.Bucket(blocksBucket)
+	idx := c.tx.Bucket(finalizedBlockRootsIndexBucket)
+	if err := idx.ForEach(func(k, v []byte) error {
+		if bytes.Equal(k, previousFinalizedCheckpointKey) || bytes.Equal(v, containerFinalizedButNotCanonical) {
+			return nil
+		}
+		if blocks.Get(k) == nil {
+			c.issues.Report(finalizedBlockRootsIndexBucket, k, "finalized block is missing")
+		}
+		container := &dbpb.FinalizedBlockRootContainer{}
+		if err := decode(c.ctx, v, container); err != nil {
+			c.issues.Report(finalizedBlockRootsIndexBucket, k, "could not decode finalized block root container: %v", err)
+			return nil
+		}
+		if len(container.ChildRoot) == 0 {
+			return nil
+		}
+		enc := blocks.Get(container.ChildRoot)
+		if enc == nil {
+			return nil
+		}
+		child := &ethpb.SignedBeaconBlock{}
+		if err := decode(c.ctx, enc, child); err == nil && child.Block != nil && !bytes.Equal(child.Block.ParentRoot, k) {
+			c.issues.Report(finalizedBlockRootsIndexBucket, k, "finalized child %#x has parent %#x", container.ChildRoot, child.Block.ParentRoot)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	enc := c.tx.Bucket(checkpointBucket).Get(finalizedCheckpointKey)
+	if enc == nil {
+		return nil
+	}
+	cp := &ethpb.Checkpoint{}
+	if err := decode(c.ctx, enc, cp); err != nil {
+		c.issues.Report(checkpointBucket, finalizedCheckpointKey, "could not decode finalized checkpoint: %v", err)
+		return nil
+	}
+	genesisRoot := blocks.Get(genesisBlockRootKey)
+	if !bytes.Equal(cp.Root, genesisRoot) && idx.Get(cp.Root) == nil {
+		c.issues.Report(finalizedBlockRootsIndexBucket, cp.Root, "finalized checkpoint block at epoch %d is not indexed", cp.Epoch)
+	}
+	return nil
+}
+
+// verifyChainMetadata checks that the head, genesis and checkpoint roots reference saved objects.
+func (c *integrityCheck) verifyChainMetadata() error {
+	blocks := c.tx.Bucket(blocksBucket)
+	for _, key := range [][]byte{headBlockRootKey, genesisBlockRootKey, originBlockRootKey, backfillBlockRootKey} {
+		if root := blocks.Get(key); root != nil && blocks.Get(root) == nil {
+			c.issues.Report(blocksBucket, key, "references missing block %#x", root)
+		}
+	}
+	checkpoints := c.tx.Bucket(checkpointBucket)
+	for _, key := range [][]byte{justifiedCheckpointKey, finalizedCheckpointKey} {
+		enc := checkpoints.Get(key)
+		if enc == nil {
+			continue
+		}
+		cp := &ethpb.Checkpoint{}
+		if err := decode(c.ctx, enc, cp); err != nil {
+			c.issues.Report(checkpointBucket, key, "could not decode checkpoint: %v", err)
+			continue
+		}
+		hasState := c.tx.Bucket(stateBucket).Get(cp.Root) != nil || c.tx.Bucket(stateSummaryBucket).Get(cp.Root) != nil
+		if !hasState {
+			c.issues.Report(checkpointBucket, key, "checkpoint at epoch %d has no state or state summary for %#x", cp.Epoch, cp.Root)
+		}
+	}
+	return nil
+}
+
+// rootAtIndex returns true if the root is one of the concatenated roots stored at an index.
+func rootAtIndex(values, root []byte) bool {
+	found := false
+	forEachRoot(values, func(r []byte) {
+		found = found || bytes.Equal(r, root)
+	})
+	return found
+}
+
+// forEachRoot calls f with each of the concatenated roots stored at an index.
+func forEachRoot(values []byte, f func(root []byte)) {
+	for i := 0; i+32 <= len(values); i += 32 {
+		f(values[i : i+32])
+	}
+}
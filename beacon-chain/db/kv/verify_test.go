@@ -0,0 +1,150 @@
+package kv
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+// setupChain saves a genesis block and state followed by a chain of blocks with state summaries,
+// an archived state at the first epoch boundary and a finalized checkpoint at the second.
+func setupChain(t *testing.T, db *Store) [][32]byte {
+	ctx := context.Background()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	require.NoError(t, db.RunMigrations(ctx))
+
+	genesis := testutil.NewBeaconBlock()
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, genesis))
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+	require.NoError(t, db.SaveState(ctx, testutil.NewBeaconState(), genesisRoot))
+
+	blks := makeBlocks(t, 0, 3*slotsPerEpoch, genesisRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	roots := rootsOf(t, blks)
+	for i, blk := range blks {
+		require.NoError(t, db.SaveStateSummary(ctx, &pb.StateSummary{Slot: blk.Block.Slot, Root: roots[i][:]}))
+	}
+	st := testutil.NewBeaconState()
+	require.NoError(t, st.SetSlot(slotsPerEpoch))
+	require.NoError(t, db.SaveState(ctx, st, roots[slotsPerEpoch-1]))
+	require.NoError(t, db.SaveHeadBlockRoot(ctx, roots[len(roots)-1]))
+	cp := &ethpb.Checkpoint{Epoch: 2, Root: roots[2*slotsPerEpoch-1][:]}
+	require.NoError(t, db.SaveJustifiedCheckpoint(ctx, cp))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, cp))
+	return roots
+}
+
+func TestStore_VerifyIntegrity(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	setupChain(t, db)
+
+	issues, err := db.VerifyIntegrity(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(issues), "Unexpected issues: %v", issues)
+}
+
+func TestStore_VerifyIntegrity_Pruned(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+	setupChain(t, db)
+	_, err := db.PruneHistoryBelow(ctx, params.BeaconConfig().SlotsPerEpoch)
+	require.NoError(t, err)
+
+	// The lowest block left after pruning has no parent.
+	issues, err := db.VerifyIntegrity(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(issues), "Unexpected issues: %v", issues)
+}
+
+func TestStore_VerifyIntegrity_Corrupted(t *testing.T) {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	tests := []struct {
+		name    string
+		corrupt func(tx *bolt.Tx, roots [][32]byte) error
+		reason  string
+	}{
+		{
+			name: "missing parent block",
+			corrupt: func(tx *bolt.Tx, roots [][32]byte) error {
+				return tx.Bucket(blocksBucket).Delete(roots[5][:])
+			},
+			reason: "parent block",
+		},
+		{
+			name: "block missing from slot index",
+			corrupt: func(tx *bolt.Tx, roots [][32]byte) error {
+				return tx.Bucket(blockSlotIndicesBucket).Delete(bytesutil.Uint64ToBytesBigEndian(3))
+			},
+			reason: "block is missing from the slot index at slot 3",
+		},
+		{
+			name: "unmigrated slot index key",
+			corrupt: func(tx *bolt.Tx, roots [][32]byte) error {
+				return tx.Bucket(blockSlotIndicesBucket).Put([]byte("3"), roots[2][:])
+			},
+			reason: "slot index key is not a big endian slot",
+		},
+		{
+			name: "archived index references missing state",
+			corrupt: func(tx *bolt.Tx, roots [][32]byte) error {
+				return tx.Bucket(stateBucket).Delete(roots[slotsPerEpoch-1][:])
+			},
+			reason: "references missing state",
+		},
+		{
+			name: "state summary without block",
+			corrupt: func(tx *bolt.Tx, roots [][32]byte) error {
+				enc, err := encode(context.Background(), &pb.StateSummary{Slot: 1000, Root: []byte("summary")})
+				if err != nil {
+					return err
+				}
+				return tx.Bucket(stateSummaryBucket).Put([]byte("summary"), enc)
+			},
+			reason: "has no block",
+		},
+		{
+			name: "finalized checkpoint not indexed",
+			corrupt: func(tx *bolt.Tx, roots [][32]byte) error {
+				return tx.Bucket(finalizedBlockRootsIndexBucket).Delete(roots[2*slotsPerEpoch-1][:])
+			},
+			reason: "finalized checkpoint block at epoch 2 is not indexed",
+		},
+		{
+			name: "head block missing",
+			corrupt: func(tx *bolt.Tx, roots [][32]byte) error {
+				return tx.Bucket(blocksBucket).Put(headBlockRootKey, bytesutil.PadTo([]byte("head"), 32))
+			},
+			reason: "references missing block",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := setupDB(t)
+			roots := setupChain(t, db)
+			require.NoError(t, db.db.Update(func(tx *bolt.Tx) error {
+				return tt.corrupt(tx, roots)
+			}))
+
+			issues, err := db.VerifyIntegrity(context.Background())
+			require.NoError(t, err)
+			require.NotEqual(t, 0, len(issues))
+			found := false
+			for _, issue := range issues {
+				found = found || strings.Contains(issue.Reason, tt.reason)
+			}
+			assert.Equal(t, true, found, "Issue %q not found in %v", tt.reason, issues)
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package pruner
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	prunedSlot = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "pruned_history_slot",
+		Help: "Slot below which the finalized block and state history has been pruned.",
+	})
+	pruneFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "prune_history_failures_total",
+		Help: "Count of history pruning runs which failed.",
+	})
+)
@@ -0,0 +1,177 @@
+// Package pruner defines a service which deletes the finalized block and state history of a
+// non-archival beacon node once it is older than a configured number of epochs.
+package pruner
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+var _ shared.Service = (*Service)(nil)
+
+// Config to set up the pruner service.
+type Config struct {
+	DB              db.NoHeadAccessDatabase
+	HeadFetcher     blockchain.HeadFetcher
+	StateNotifier   statefeed.Notifier
+	RetentionEpochs uint64
+}
+
+// Service prunes the history below the retention period whenever the finalized checkpoint advances.
+// The retention period is never shorter than the weak subjectivity period of the chain, so that the
+// node can always serve a weak subjectivity checkpoint state and the blocks after it.
+type Service struct {
+	ctx             context.Context
+	cancel          context.CancelFunc
+	db              db.NoHeadAccessDatabase
+	headFetcher     blockchain.HeadFetcher
+	stateNotifier   statefeed.Notifier
+	retentionEpochs uint64
+	finalized       chan uint64
+	prunedSlot      uint64
+	wsWarned        bool
+}
+
+// New configures the pruner service.
+func New(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		ctx:             ctx,
+		cancel:          cancel,
+		db:              cfg.DB,
+		headFetcher:     cfg.HeadFetcher,
+		stateNotifier:   cfg.StateNotifier,
+		retentionEpochs: cfg.RetentionEpochs,
+		finalized:       make(chan uint64, 1),
+	}
+}
+
+// Start listening for finalized checkpoints and pruning in the background.
+func (s *Service) Start() {
+	log.WithField("retentionEpochs", s.retentionEpochs).Info("Pruning finalized history")
+	go s.run()
+
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.stateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case ev := <-stateChannel:
+			data, ok := ev.Data.(*statefeed.FinalizedCheckpointData)
+			if !ok {
+				continue
+			}
+			// Pruning is slow, only the latest finalized epoch is queued while a run is in progress.
+			select {
+			case <-s.finalized:
+			default:
+			}
+			s.finalized <- data.Epoch
+		case err := <-stateSub.Err():
+			log.WithError(err).Error("Could not subscribe to state notifier")
+			return
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// Stop the pruner service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the pruner service.
+func (s *Service) Status() error {
+	return nil
+}
+
+func (s *Service) run() {
+	for {
+		select {
+		case epoch := <-s.finalized:
+			if err := s.prune(s.ctx, epoch); err != nil {
+				if s.ctx.Err() != nil {
+					return
+				}
+				pruneFailures.Inc()
+				log.WithError(err).Error("Could not prune history")
+			}
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// prune deletes the history older than the retention period before the given finalized epoch.
+func (s *Service) prune(ctx context.Context, finalizedEpoch uint64) error {
+	ctx, span := trace.StartSpan(ctx, "pruner.prune")
+	defer span.End()
+
+	retention, err := s.retention(ctx)
+	if err != nil {
+		return err
+	}
+	if finalizedEpoch <= retention {
+		return nil
+	}
+	slot, err := helpers.StartSlot(finalizedEpoch - retention)
+	if err != nil {
+		return err
+	}
+	if slot <= s.prunedSlot {
+		return nil
+	}
+	boundary, err := s.db.PruneHistoryBelow(ctx, slot)
+	if err != nil {
+		return err
+	}
+	s.prunedSlot = slot
+	prunedSlot.Set(float64(boundary))
+	log.WithFields(logrus.Fields{
+		"slot":           boundary,
+		"finalizedEpoch": finalizedEpoch,
+	}).Debug("Pruned history")
+	return nil
+}
+
+// retention returns the number of finalized epochs to keep, which is the configured retention
+// period or the weak subjectivity period of the head state, whichever is longer.
+func (s *Service) retention(ctx context.Context) (uint64, error) {
+	st, err := s.headFetcher.HeadState(ctx)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get head state")
+	}
+	if st == nil {
+		return 0, errors.New("head state is nil")
+	}
+	count, err := helpers.ActiveValidatorCount(st, helpers.CurrentEpoch(st))
+	if err != nil {
+		return 0, errors.Wrap(err, "could not get active validator count")
+	}
+	wsPeriod, err := helpers.WeakSubjectivityCheckptEpoch(count)
+	if err != nil {
+		return 0, errors.Wrap(err, "could not compute weak subjectivity period")
+	}
+	if s.retentionEpochs >= wsPeriod {
+		return s.retentionEpochs, nil
+	}
+	if !s.wsWarned {
+		log.WithFields(logrus.Fields{
+			"retentionEpochs":        s.retentionEpochs,
+			"weakSubjectivityPeriod": wsPeriod,
+		}).Warn("Retention period is shorter than the weak subjectivity period, retaining the weak subjectivity period instead")
+		s.wsWarned = true
+	}
+	return wsPeriod, nil
+}
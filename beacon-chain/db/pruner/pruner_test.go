@@ -0,0 +1,91 @@
+package pruner
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_Retention(t *testing.T) {
+	headState, _ := testutil.DeterministicGenesisState(t, 64)
+	count, err := helpers.ActiveValidatorCount(headState, 0)
+	require.NoError(t, err)
+	wsPeriod, err := helpers.WeakSubjectivityCheckptEpoch(count)
+	require.NoError(t, err)
+
+	s := New(context.Background(), &Config{
+		HeadFetcher:     &mock.ChainService{State: headState},
+		RetentionEpochs: 1,
+	})
+	retention, err := s.retention(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, wsPeriod, retention, "Retention is not floored at the weak subjectivity period")
+
+	s.retentionEpochs = wsPeriod + 10
+	retention, err = s.retention(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, wsPeriod+10, retention)
+}
+
+func TestService_Prune(t *testing.T) {
+	beaconDB, _ := testDB.SetupDB(t)
+	ctx := context.Background()
+	headState, _ := testutil.DeterministicGenesisState(t, 64)
+	count, err := helpers.ActiveValidatorCount(headState, 0)
+	require.NoError(t, err)
+	wsPeriod, err := helpers.WeakSubjectivityCheckptEpoch(count)
+	require.NoError(t, err)
+	retention := wsPeriod + 10
+
+	genesis := testutil.NewBeaconBlock()
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, genesis))
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot))
+	require.NoError(t, beaconDB.SaveState(ctx, testutil.NewBeaconState(), genesisRoot))
+
+	// Blocks with archived states in epochs 1 and 11, and the finalized block.
+	parentRoot := genesisRoot
+	var roots [][32]byte
+	for _, epoch := range []uint64{1, 11, retention + 12} {
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = epoch * params.BeaconConfig().SlotsPerEpoch
+		blk.Block.ParentRoot = parentRoot[:]
+		root, err := blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, beaconDB.SaveBlock(ctx, blk))
+		require.NoError(t, beaconDB.SaveStateSummary(ctx, &pb.StateSummary{Slot: blk.Block.Slot, Root: root[:]}))
+		st := testutil.NewBeaconState()
+		require.NoError(t, st.SetSlot(blk.Block.Slot))
+		require.NoError(t, beaconDB.SaveState(ctx, st, root))
+		roots = append(roots, root)
+		parentRoot = root
+	}
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: retention + 12, Root: roots[2][:]}))
+
+	s := New(ctx, &Config{
+		DB:              beaconDB,
+		HeadFetcher:     &mock.ChainService{State: headState},
+		RetentionEpochs: retention,
+	})
+	// Nothing to prune before the retention period has passed.
+	require.NoError(t, s.prune(ctx, retention))
+	assert.Equal(t, true, beaconDB.HasBlock(ctx, roots[0]))
+
+	require.NoError(t, s.prune(ctx, retention+12))
+	assert.Equal(t, false, beaconDB.HasBlock(ctx, roots[0]))
+	assert.Equal(t, false, beaconDB.HasState(ctx, roots[0]))
+	assert.Equal(t, true, beaconDB.HasBlock(ctx, roots[1]))
+	assert.Equal(t, true, beaconDB.HasState(ctx, roots[1]))
+	assert.Equal(t, true, beaconDB.HasBlock(ctx, genesisRoot))
+	assert.Equal(t, uint64(12*params.BeaconConfig().SlotsPerEpoch), s.prunedSlot)
+}
@@ -7,6 +7,18 @@ import (
 	"github.com/urfave/cli/v2"
 )
 
+const (
+	// DefaultGossipBadResponsesWeight is the default weight of the bad responses peer scorer in the
+	// gossipsub peer score, a bad peer falls below the gossip threshold.
+	DefaultGossipBadResponsesWeight = 5000
+	// DefaultGossipPeerStatusWeight is the default weight of the peer status peer scorer in the
+	// gossipsub peer score, a peer with an incompatible chain status falls below the graylist threshold.
+	DefaultGossipPeerStatusWeight = 20000
+	// DefaultGossipBlockProviderWeight is the default weight of the block provider peer scorer in the
+	// gossipsub peer score.
+	DefaultGossipBlockProviderWeight = 0
+)
+
 var (
 	// HTTPWeb3ProviderFlag provides an HTTP access endpoint to an ETH 1.0 RPC.
 	HTTPWeb3ProviderFlag = &cli.StringFlag{
@@ -79,6 +91,13 @@ var (
 		Usage: "The required number of valid peers to connect with before syncing.",
 		Value: 3,
 	}
+	// MinPeersPerSubnet specifies the number of peers subscribed to an attestation subnet the node
+	// maintains for every subnet it is subscribed to.
+	MinPeersPerSubnet = &cli.IntFlag{
+		Name:  "minimum-peers-per-subnet",
+		Usage: "The number of peers to maintain on every subscribed attestation subnet, searching the network for more when below it.",
+		Value: 4,
+	}
 	// ContractDeploymentBlock is the block in which the eth1 deposit contract was deployed.
 	ContractDeploymentBlock = &cli.IntFlag{
 		Name:  "contract-deployment-block",
@@ -167,4 +186,99 @@ var (
 		Name:  "db-backup-output-dir",
 		Usage: "Output directory for db backups",
 	}
+	// BackupWebhookRetentionFlag defines the number of db backups to keep in the output directory.
+	BackupWebhookRetentionFlag = &cli.IntFlag{
+		Name:  "db-backup-retention",
+		Usage: "Number of most recent db backups to keep in the output directory, older backups are removed after each backup. 0 keeps all of them",
+	}
+	// ExportFileFlag defines the file the objects saved by the beacon node are exported to.
+	ExportFileFlag = &cli.StringFlag{
+		Name:  "export-file",
+		Usage: "Export blocks, slashings, exits and checkpoints to this file as newline-delimited JSON",
+	}
+	// ExportFileEncodingFlag defines the encoding of the objects in the export file.
+	ExportFileEncodingFlag = &cli.StringFlag{
+		Name:  "export-file-encoding",
+		Usage: "Encoding of the exported objects in the export file, either json or ssz",
+		Value: "json",
+	}
+	// ExportFileMaxSizeFlag defines the size after which the export file is rotated.
+	ExportFileMaxSizeFlag = &cli.Int64Flag{
+		Name:  "export-file-max-size-mb",
+		Usage: "Size in megabytes after which the export file is rotated. 0 disables rotation",
+		Value: 100,
+	}
+	// ExportFileMaxBackupsFlag defines the number of rotated export files to keep.
+	ExportFileMaxBackupsFlag = &cli.IntFlag{
+		Name:  "export-file-max-backups",
+		Usage: "Number of rotated export files to keep. 0 keeps all of them",
+		Value: 10,
+	}
+	// ExportWebhookFlag defines the HTTP endpoint the objects saved by the beacon node are posted to.
+	ExportWebhookFlag = &cli.StringFlag{
+		Name:  "export-webhook-url",
+		Usage: "Export blocks, slashings, exits and checkpoints by posting them to this HTTP endpoint as newline-delimited JSON",
+	}
+	// ExportTypesFlag restricts the object types which are exported.
+	ExportTypesFlag = &cli.StringSliceFlag{
+		Name: "export-types",
+		Usage: "Object types to export, all of them by default. Any of beacon_block, proposer_slashing, " +
+			"attester_slashing, voluntary_exit, justified_checkpoint and finalized_checkpoint",
+	}
+	// CheckpointStateFlag defines the finalized checkpoint state a node with an empty database starts from.
+	CheckpointStateFlag = &cli.StringFlag{
+		Name: "checkpoint-state",
+		Usage: "Start from this SSZ encoded finalized checkpoint state instead of genesis when the database is empty. " +
+			"Requires --checkpoint-block",
+	}
+	// CheckpointBlockFlag defines the block of the finalized checkpoint state a node starts from.
+	CheckpointBlockFlag = &cli.StringFlag{
+		Name:  "checkpoint-block",
+		Usage: "SSZ encoded signed beacon block of the --checkpoint-state, which must be at an epoch boundary",
+	}
+	// HistoryRetentionEpochsFlag defines the number of finalized epochs of block and state history a node keeps.
+	HistoryRetentionEpochsFlag = &cli.Uint64Flag{
+		Name: "history-retention-epochs",
+		Usage: "Prune the finalized blocks, states and state summaries older than this number of epochs in the background. " +
+			"The history of the weak subjectivity period is always kept. 0 keeps the full history",
+	}
+	// CompactDBFlag compacts the database file before the node starts.
+	CompactDBFlag = &cli.BoolFlag{
+		Name:  "compact-db",
+		Usage: "Rewrite the database into a compacted file at startup, releasing the disk space freed by pruning",
+	}
+	// DBCompactOutputFlag defines the file the db compact command writes the compacted database to.
+	DBCompactOutputFlag = &cli.StringFlag{
+		Name:  "output",
+		Usage: "Write the compacted database to this file instead of replacing the database in the data directory",
+	}
+	// GossipBadResponsesWeightFlag defines the weight of the bad responses peer scorer in the gossipsub peer score.
+	GossipBadResponsesWeightFlag = &cli.Float64Flag{
+		Name: "gossip-bad-responses-weight",
+		Usage: "Weight of the bad responses peer scorer in the gossipsub peer score, a peer which sent too many bad " +
+			"RPC responses loses this many points. Requires --enable-peer-scorer",
+		Value: DefaultGossipBadResponsesWeight,
+	}
+	// GossipPeerStatusWeightFlag defines the weight of the peer status peer scorer in the gossipsub peer score.
+	GossipPeerStatusWeightFlag = &cli.Float64Flag{
+		Name: "gossip-peer-status-weight",
+		Usage: "Weight of the peer status peer scorer in the gossipsub peer score, a peer with an incompatible " +
+			"chain status loses this many points. Requires --enable-peer-scorer",
+		Value: DefaultGossipPeerStatusWeight,
+	}
+	// GossipBlockProviderWeightFlag defines the weight of the block provider peer scorer in the gossipsub peer score.
+	GossipBlockProviderWeightFlag = &cli.Float64Flag{
+		Name: "gossip-block-provider-weight",
+		Usage: "Weight of the block provider peer scorer in the gossipsub peer score, peers gain up to this many " +
+			"points for the blocks they served. Requires --enable-peer-scorer",
+		Value: DefaultGossipBlockProviderWeight,
+	}
+	// TrustedPeersFileFlag defines a path to a YAML file with the trusted peer groups of the node.
+	TrustedPeersFileFlag = &cli.StringFlag{
+		Name: "trusted-peers-file",
+		Usage: "The filepath to a YAML file with a list of named trusted peer groups, such as sentry nodes. Each group " +
+			"has a name, peers given as multiaddrs or ENRs, and inbound_slots and outbound_slots reserved for its " +
+			"connections which default to the number of peers. Trusted peers are exempt from pruning and bad peer " +
+			"scoring, and do not count against the peer limit",
+	}
 )
@@ -14,6 +14,7 @@ type GlobalFlags struct {
 	DisableDiscv5              bool
 	SubscribeToAllSubnets      bool
 	MinimumSyncPeers           int
+	MinimumPeersPerSubnet      int
 	BlockBatchLimit            int
 	BlockBatchLimitBurstFactor int
 }
@@ -64,4 +65,9 @@ func configureMinimumPeers(ctx *cli.Context, cfg *GlobalFlags) {
 		log.Warnf("Changing Minimum Sync Peers to %d", maxPeers)
 		cfg.MinimumSyncPeers = maxPeers
 	}
+	cfg.MinimumPeersPerSubnet = ctx.Int(MinPeersPerSubnet.Name)
+	if cfg.MinimumPeersPerSubnet > maxPeers {
+		log.Warnf("Changing Minimum Peers Per Subnet to %d", maxPeers)
+		cfg.MinimumPeersPerSubnet = maxPeers
+	}
 }
@@ -0,0 +1,105 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/grpc-ecosystem/grpc-gateway/protoc-gen-grpc-gateway/httprule"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"google.golang.org/grpc"
+)
+
+// apiCall proxies a single HTTP request to the beacon node's gRPC server, returning the
+// response message together with the gRPC header and trailer metadata of the call.
+type apiCall func(
+	ctx context.Context,
+	conn *grpc.ClientConn,
+	req *http.Request,
+	pathParams map[string]string,
+) (proto.Message, gwruntime.ServerMetadata, error)
+
+// apiRoute binds an HTTP method and path template, such as "/eth/v1/node/peers/{peer_id}",
+// to the gRPC call serving it.
+type apiRoute struct {
+	method   string
+	template string
+	call     apiCall
+}
+
+// newV1Mux creates the serve mux for the standard eth/v1 API. Field names are kept in
+// their original snake_case form as required by the API specification.
+func newV1Mux() *gwruntime.ServeMux {
+	return gwruntime.NewServeMux(
+		gwruntime.WithMarshalerOption(
+			gwruntime.MIMEWildcard,
+			&gwruntime.JSONPb{OrigName: true, EmitDefaults: true},
+		),
+		gwruntime.WithForwardResponseOption(httpResponseModifier),
+	)
+}
+
+// registerAPIRoutes registers each of the routes on the given mux, in the same manner
+// handlers generated by protoc-gen-grpc-gateway register themselves.
+func registerAPIRoutes(mux *gwruntime.ServeMux, conn *grpc.ClientConn, routes []apiRoute) error {
+	for _, r := range routes {
+		compiler, err := httprule.Parse(r.template)
+		if err != nil {
+			return errors.Wrapf(err, "could not parse path template %s", r.template)
+		}
+		tmpl := compiler.Compile()
+		pattern, err := gwruntime.NewPattern(1, tmpl.OpCodes, tmpl.Pool, tmpl.Verb)
+		if err != nil {
+			return errors.Wrapf(err, "could not create pattern for %s", r.template)
+		}
+		call := r.call
+		mux.Handle(r.method, pattern, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+			ctx, cancel := context.WithCancel(req.Context())
+			defer cancel()
+			_, outboundMarshaler := gwruntime.MarshalerForRequest(mux, req)
+			rctx, err := gwruntime.AnnotateContext(ctx, mux, req)
+			if err != nil {
+				gwruntime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+				return
+			}
+			resp, md, err := call(rctx, conn, req, pathParams)
+			ctx = gwruntime.NewServerMetadataContext(ctx, md)
+			if err != nil {
+				gwruntime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+				return
+			}
+			gwruntime.ForwardResponseMessage(ctx, mux, outboundMarshaler, w, req, resp, mux.GetForwardResponseOptions()...)
+		})
+	}
+	return nil
+}
+
+// callMetadata returns the call options capturing the header and trailer metadata of a
+// gRPC call into md.
+func callMetadata(md *gwruntime.ServerMetadata) []grpc.CallOption {
+	return []grpc.CallOption{grpc.Header(&md.HeaderMD), grpc.Trailer(&md.TrailerMD)}
+}
+
+// httpResponseModifier overrides the HTTP status code of a successful response when the
+// gRPC server requested a custom one, e.g. 206 for a node which is still syncing.
+func httpResponseModifier(ctx context.Context, w http.ResponseWriter, _ proto.Message) error {
+	md, ok := gwruntime.ServerMetadataFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	vals := md.HeaderMD.Get(grpcutils.HTTPCodeMetadataKey)
+	if len(vals) == 0 {
+		return nil
+	}
+	code, err := strconv.Atoi(vals[0])
+	if err != nil {
+		return errors.Wrapf(err, "could not parse custom http code %s", vals[0])
+	}
+	// The header is an internal signal and should not be forwarded to the client.
+	w.Header().Del(gwruntime.MetadataHeaderPrefix + grpcutils.HTTPCodeMetadataKey)
+	w.WriteHeader(code)
+	return nil
+}
@@ -0,0 +1,62 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestHTTPResponseModifier(t *testing.T) {
+	t.Run("No metadata", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		require.NoError(t, httpResponseModifier(context.Background(), w, nil))
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("Custom code", func(t *testing.T) {
+		md := gwruntime.ServerMetadata{
+			HeaderMD: metadata.Pairs(grpcutils.HTTPCodeMetadataKey, "206"),
+		}
+		ctx := gwruntime.NewServerMetadataContext(context.Background(), md)
+		w := httptest.NewRecorder()
+		w.Header().Set(gwruntime.MetadataHeaderPrefix+grpcutils.HTTPCodeMetadataKey, "206")
+		require.NoError(t, httpResponseModifier(ctx, w, nil))
+		assert.Equal(t, http.StatusPartialContent, w.Code)
+		assert.Equal(t, "", w.Header().Get(gwruntime.MetadataHeaderPrefix+grpcutils.HTTPCodeMetadataKey))
+	})
+
+	t.Run("Invalid code", func(t *testing.T) {
+		md := gwruntime.ServerMetadata{
+			HeaderMD: metadata.Pairs(grpcutils.HTTPCodeMetadataKey, "foo"),
+		}
+		ctx := gwruntime.NewServerMetadataContext(context.Background(), md)
+		assert.ErrorContains(t, "could not parse custom http code", httpResponseModifier(ctx, httptest.NewRecorder(), nil))
+	})
+}
+
+func TestRegisterAPIRoutes(t *testing.T) {
+	mux := newV1Mux()
+	require.NoError(t, registerAPIRoutes(mux, nil, nodeV1Routes()))
+	require.NoError(t, registerAPIRoutes(mux, nil, beaconV1Routes()))
+	require.NoError(t, registerAPIRoutes(mux, nil, debugV1Routes()))
+	assert.ErrorContains(t, "could not parse path template", registerAPIRoutes(mux, nil, []apiRoute{{
+		method:   http.MethodGet,
+		template: "eth/v1/{",
+	}}))
+}
+
+func TestDebugV1Routes_StateServedByNode(t *testing.T) {
+	mux := newV1Mux()
+	require.NoError(t, registerAPIRoutes(mux, nil, debugV1Routes()))
+
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/eth/v1/debug/beacon/states/head", nil))
+	assert.Equal(t, http.StatusNotFound, w.Code, "Expected the state route to be left to the beacon node")
+}
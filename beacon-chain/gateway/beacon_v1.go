@@ -0,0 +1,156 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/golang/protobuf/proto"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// beaconV1Routes returns the routes of the eth/v1 beacon state API.
+func beaconV1Routes() []apiRoute {
+	return []apiRoute{
+		{
+			method:   http.MethodGet,
+			template: "/eth/v1/beacon/genesis",
+			call: func(ctx context.Context, conn *grpc.ClientConn, _ *http.Request, _ map[string]string) (proto.Message, gwruntime.ServerMetadata, error) {
+				var md gwruntime.ServerMetadata
+				resp, err := ethpb.NewBeaconChainClient(conn).GetGenesis(ctx, &ptypes.Empty{}, callMetadata(&md)...)
+				return resp, md, err
+			},
+		},
+		{
+			method:   http.MethodGet,
+			template: "/eth/v1/beacon/states/{state_id}/root",
+			call: func(ctx context.Context, conn *grpc.ClientConn, _ *http.Request, pathParams map[string]string) (proto.Message, gwruntime.ServerMetadata, error) {
+				var md gwruntime.ServerMetadata
+				req := &ethpb.StateRequest{StateId: []byte(pathParams["state_id"])}
+				resp, err := ethpb.NewBeaconChainClient(conn).GetStateRoot(ctx, req, callMetadata(&md)...)
+				return resp, md, err
+			},
+		},
+		{
+			method:   http.MethodGet,
+			template: "/eth/v1/beacon/states/{state_id}/fork",
+			call: func(ctx context.Context, conn *grpc.ClientConn, _ *http.Request, pathParams map[string]string) (proto.Message, gwruntime.ServerMetadata, error) {
+				var md gwruntime.ServerMetadata
+				req := &ethpb.StateRequest{StateId: []byte(pathParams["state_id"])}
+				resp, err := ethpb.NewBeaconChainClient(conn).GetStateFork(ctx, req, callMetadata(&md)...)
+				return resp, md, err
+			},
+		},
+		{
+			method:   http.MethodGet,
+			template: "/eth/v1/beacon/states/{state_id}/finality_checkpoints",
+			call: func(ctx context.Context, conn *grpc.ClientConn, _ *http.Request, pathParams map[string]string) (proto.Message, gwruntime.ServerMetadata, error) {
+				var md gwruntime.ServerMetadata
+				req := &ethpb.StateRequest{StateId: []byte(pathParams["state_id"])}
+				resp, err := ethpb.NewBeaconChainClient(conn).GetFinalityCheckpoints(ctx, req, callMetadata(&md)...)
+				return resp, md, err
+			},
+		},
+		{
+			method:   http.MethodGet,
+			template: "/eth/v1/beacon/states/{state_id}/validators/{validator_id}",
+			call: func(ctx context.Context, conn *grpc.ClientConn, _ *http.Request, pathParams map[string]string) (proto.Message, gwruntime.ServerMetadata, error) {
+				var md gwruntime.ServerMetadata
+				req := &ethpb.StateValidatorRequest{
+					StateId:     []byte(pathParams["state_id"]),
+					ValidatorId: []byte(pathParams["validator_id"]),
+				}
+				resp, err := ethpb.NewBeaconChainClient(conn).GetValidator(ctx, req, callMetadata(&md)...)
+				return resp, md, err
+			},
+		},
+		{
+			method:   http.MethodGet,
+			template: "/eth/v1/beacon/states/{state_id}/validators",
+			call: func(ctx context.Context, conn *grpc.ClientConn, r *http.Request, pathParams map[string]string) (proto.Message, gwruntime.ServerMetadata, error) {
+				var md gwruntime.ServerMetadata
+				req := &ethpb.StateValidatorsRequest{
+					StateId: []byte(pathParams["state_id"]),
+					Status:  strings.Join(queryList(r, "status"), ","),
+				}
+				for _, id := range queryList(r, "id") {
+					req.Id = append(req.Id, []byte(id))
+				}
+				resp, err := ethpb.NewBeaconChainClient(conn).ListValidators(ctx, req, callMetadata(&md)...)
+				return resp, md, err
+			},
+		},
+		{
+			method:   http.MethodGet,
+			template: "/eth/v1/beacon/states/{state_id}/validator_balances",
+			call: func(ctx context.Context, conn *grpc.ClientConn, r *http.Request, pathParams map[string]string) (proto.Message, gwruntime.ServerMetadata, error) {
+				var md gwruntime.ServerMetadata
+				req := &ethpb.ValidatorBalancesRequest{
+					StateId: []byte(pathParams["state_id"]),
+					Id:      queryList(r, "id"),
+				}
+				resp, err := ethpb.NewBeaconChainClient(conn).ListValidatorBalances(ctx, req, callMetadata(&md)...)
+				return resp, md, err
+			},
+		},
+		{
+			method:   http.MethodGet,
+			template: "/eth/v1/beacon/states/{state_id}/committees/{epoch}",
+			call: func(ctx context.Context, conn *grpc.ClientConn, r *http.Request, pathParams map[string]string) (proto.Message, gwruntime.ServerMetadata, error) {
+				var md gwruntime.ServerMetadata
+				epoch, err := parseUint(pathParams["epoch"], "epoch")
+				if err != nil {
+					return nil, md, err
+				}
+				index, err := parseUint(r.URL.Query().Get("index"), "index")
+				if err != nil {
+					return nil, md, err
+				}
+				slot, err := parseUint(r.URL.Query().Get("slot"), "slot")
+				if err != nil {
+					return nil, md, err
+				}
+				req := &ethpb.StateCommitteesRequest{
+					StateId: []byte(pathParams["state_id"]),
+					Epoch:   epoch,
+					Index:   index,
+					Slot:    slot,
+				}
+				resp, err := ethpb.NewBeaconChainClient(conn).ListCommittees(ctx, req, callMetadata(&md)...)
+				return resp, md, err
+			},
+		},
+	}
+}
+
+// queryList returns all values of a query parameter. Both repeated parameters
+// and comma separated values are accepted.
+func queryList(r *http.Request, key string) []string {
+	var values []string
+	for _, value := range r.URL.Query()[key] {
+		for _, v := range strings.Split(value, ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				values = append(values, v)
+			}
+		}
+	}
+	return values
+}
+
+// parseUint parses a decimal parameter, treating an empty value as zero.
+func parseUint(value, name string) (uint64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	v, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, status.Errorf(codes.InvalidArgument, "Invalid %s %q", name, value)
+	}
+	return v, nil
+}
@@ -0,0 +1,27 @@
+package gateway
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestQueryList(t *testing.T) {
+	r := httptest.NewRequest("GET", "/eth/v1/beacon/states/head/validators?id=1,2&id=0xab&status=", nil)
+	assert.DeepEqual(t, []string{"1", "2", "0xab"}, queryList(r, "id"))
+	assert.Equal(t, 0, len(queryList(r, "status")))
+	assert.Equal(t, 0, len(queryList(r, "missing")))
+}
+
+func TestParseUint(t *testing.T) {
+	v, err := parseUint("", "slot")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), v)
+	v, err = parseUint("42", "slot")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), v)
+	_, err = parseUint("-1", "slot")
+	assert.ErrorContains(t, "Invalid slot", err)
+}
@@ -0,0 +1,28 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/golang/protobuf/proto"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	"google.golang.org/grpc"
+)
+
+// debugV1Routes returns the routes of the eth/v1 debug API. The state route is not part of
+// them, the beacon node mounts its own handler for it in order to support SSZ encoded responses.
+func debugV1Routes() []apiRoute {
+	return []apiRoute{
+		{
+			method:   http.MethodGet,
+			template: "/eth/v1/debug/beacon/heads",
+			call: func(ctx context.Context, conn *grpc.ClientConn, _ *http.Request, _ map[string]string) (proto.Message, gwruntime.ServerMetadata, error) {
+				var md gwruntime.ServerMetadata
+				resp, err := ethpb.NewBeaconDebugClient(conn).ListForkChoiceHeads(ctx, &ptypes.Empty{}, callMetadata(&md)...)
+				return resp, md, err
+			},
+		},
+	}
+}
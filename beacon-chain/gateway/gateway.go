@@ -62,6 +62,7 @@ func (g *Gateway) Start() {
 		ethpb.RegisterNodeHandler,
 		ethpb.RegisterBeaconChainHandler,
 		ethpb.RegisterBeaconNodeValidatorHandler,
+		pbrpc.RegisterLivenessHandler,
 	}
 	if g.enableDebugRPCEndpoints {
 		handlers = append(handlers, pbrpc.RegisterDebugHandler)
@@ -74,6 +75,20 @@ func (g *Gateway) Start() {
 		}
 	}
 
+	gwmuxV1 := newV1Mux()
+	v1Routes := [][]apiRoute{nodeV1Routes(), beaconV1Routes()}
+	if g.enableDebugRPCEndpoints {
+		v1Routes = append(v1Routes, debugV1Routes())
+	}
+	for _, routes := range v1Routes {
+		if err := registerAPIRoutes(gwmuxV1, conn, routes); err != nil {
+			log.WithError(err).Error("Failed to start gateway")
+			g.startFailure = err
+			return
+		}
+	}
+
+	g.mux.Handle("/eth/v1/", gwmuxV1)
 	g.mux.Handle("/", gwmux)
 
 	g.server = &http.Server{
@@ -0,0 +1,73 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/golang/protobuf/proto"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	"google.golang.org/grpc"
+)
+
+// nodeV1Routes returns the routes of the eth/v1 node API.
+func nodeV1Routes() []apiRoute {
+	return []apiRoute{
+		{
+			method:   http.MethodGet,
+			template: "/eth/v1/node/identity",
+			call: func(ctx context.Context, conn *grpc.ClientConn, _ *http.Request, _ map[string]string) (proto.Message, gwruntime.ServerMetadata, error) {
+				var md gwruntime.ServerMetadata
+				resp, err := ethpb.NewBeaconNodeClient(conn).GetIdentity(ctx, &ptypes.Empty{}, callMetadata(&md)...)
+				return resp, md, err
+			},
+		},
+		{
+			method:   http.MethodGet,
+			template: "/eth/v1/node/peers/{peer_id}",
+			call: func(ctx context.Context, conn *grpc.ClientConn, _ *http.Request, pathParams map[string]string) (proto.Message, gwruntime.ServerMetadata, error) {
+				var md gwruntime.ServerMetadata
+				req := &ethpb.PeerRequest{PeerId: pathParams["peer_id"]}
+				resp, err := ethpb.NewBeaconNodeClient(conn).GetPeer(ctx, req, callMetadata(&md)...)
+				return resp, md, err
+			},
+		},
+		{
+			method:   http.MethodGet,
+			template: "/eth/v1/node/peers",
+			call: func(ctx context.Context, conn *grpc.ClientConn, _ *http.Request, _ map[string]string) (proto.Message, gwruntime.ServerMetadata, error) {
+				var md gwruntime.ServerMetadata
+				resp, err := ethpb.NewBeaconNodeClient(conn).ListPeers(ctx, &ptypes.Empty{}, callMetadata(&md)...)
+				return resp, md, err
+			},
+		},
+		{
+			method:   http.MethodGet,
+			template: "/eth/v1/node/version",
+			call: func(ctx context.Context, conn *grpc.ClientConn, _ *http.Request, _ map[string]string) (proto.Message, gwruntime.ServerMetadata, error) {
+				var md gwruntime.ServerMetadata
+				resp, err := ethpb.NewBeaconNodeClient(conn).GetVersion(ctx, &ptypes.Empty{}, callMetadata(&md)...)
+				return resp, md, err
+			},
+		},
+		{
+			method:   http.MethodGet,
+			template: "/eth/v1/node/syncing",
+			call: func(ctx context.Context, conn *grpc.ClientConn, _ *http.Request, _ map[string]string) (proto.Message, gwruntime.ServerMetadata, error) {
+				var md gwruntime.ServerMetadata
+				resp, err := ethpb.NewBeaconNodeClient(conn).GetSyncStatus(ctx, &ptypes.Empty{}, callMetadata(&md)...)
+				return resp, md, err
+			},
+		},
+		{
+			method:   http.MethodGet,
+			template: "/eth/v1/node/health",
+			call: func(ctx context.Context, conn *grpc.ClientConn, _ *http.Request, _ map[string]string) (proto.Message, gwruntime.ServerMetadata, error) {
+				var md gwruntime.ServerMetadata
+				resp, err := ethpb.NewBeaconNodeClient(conn).GetHealth(ctx, &ptypes.Empty{}, callMetadata(&md)...)
+				return resp, md, err
+			},
+		},
+	}
+}
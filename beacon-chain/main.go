@@ -10,6 +10,7 @@ import (
 	gethlog "github.com/ethereum/go-ethereum/log"
 	golog "github.com/ipfs/go-log/v2"
 	joonix "github.com/joonix/log"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/beacon-chain/node"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
@@ -37,6 +38,7 @@ var appFlags = []cli.Flag{
 	flags.GRPCGatewayPort,
 	flags.GPRCGatewayCorsDomain,
 	flags.MinSyncPeers,
+	flags.MinPeersPerSubnet,
 	flags.ContractDeploymentBlock,
 	flags.SetGCPercent,
 	flags.HeadSync,
@@ -53,6 +55,21 @@ var appFlags = []cli.Flag{
 	flags.SubscribeToAllSubnets,
 	flags.EnableBackupWebhookFlag,
 	flags.BackupWebhookOutputDir,
+	flags.BackupWebhookRetentionFlag,
+	flags.ExportFileFlag,
+	flags.ExportFileEncodingFlag,
+	flags.ExportFileMaxSizeFlag,
+	flags.ExportFileMaxBackupsFlag,
+	flags.ExportWebhookFlag,
+	flags.ExportTypesFlag,
+	flags.CheckpointStateFlag,
+	flags.CheckpointBlockFlag,
+	flags.HistoryRetentionEpochsFlag,
+	flags.CompactDBFlag,
+	flags.GossipBadResponsesWeightFlag,
+	flags.GossipPeerStatusWeightFlag,
+	flags.GossipBlockProviderWeightFlag,
+	flags.TrustedPeersFileFlag,
 	flags.HistoricalSlasherNode,
 	flags.ChainID,
 	flags.NetworkID,
@@ -112,6 +129,9 @@ func main() {
 	app.Usage = "this is a beacon chain implementation for Ethereum 2.0"
 	app.Action = startNode
 	app.Version = version.GetVersion()
+	app.Commands = []*cli.Command{
+		db.Commands,
+	}
 
 	app.Flags = appFlags
 
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"os/signal"
 	"path/filepath"
@@ -17,10 +18,13 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/export"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/pruner"
 	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
@@ -32,9 +36,14 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/debugv1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/eventsv1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/statefetcher"
+	stateTrie "github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	regularsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	initialsync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/debug"
@@ -53,7 +62,6 @@ import (
 
 var log = logrus.WithField("prefix", "node")
 
-const beaconChainDBName = "beaconchaindata"
 const testSkipPowFlag = "test-skip-pow"
 
 // BeaconNode defines a struct that handles the services running a random beacon chain
@@ -174,6 +182,10 @@ func NewBeaconNode(cliCtx *cli.Context) (*BeaconNode, error) {
 
 	beacon.startStateGen()
 
+	if err := beacon.startFromCheckpoint(cliCtx); err != nil {
+		return nil, err
+	}
+
 	if err := beacon.registerP2P(cliCtx); err != nil {
 		return nil, err
 	}
@@ -200,10 +212,18 @@ func NewBeaconNode(cliCtx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if err := beacon.registerBackfillService(); err != nil {
+		return nil, err
+	}
+
 	if err := beacon.registerSyncService(); err != nil {
 		return nil, err
 	}
 
+	if err := beacon.registerPrunerService(); err != nil {
+		return nil, err
+	}
+
 	if err := beacon.registerRPCService(); err != nil {
 		return nil, err
 	}
@@ -290,12 +310,19 @@ func (b *BeaconNode) startForkChoice() {
 
 func (b *BeaconNode) startDB(cliCtx *cli.Context) error {
 	baseDir := cliCtx.String(cmd.DataDirFlag.Name)
-	dbPath := filepath.Join(baseDir, beaconChainDBName)
+	dbPath := filepath.Join(baseDir, db.DirName)
 	clearDB := cliCtx.Bool(cmd.ClearDB.Name)
 	forceClearDB := cliCtx.Bool(cmd.ForceClearDB.Name)
 
 	log.WithField("database-path", dbPath).Info("Checking DB")
 
+	if cliCtx.Bool(flags.CompactDBFlag.Name) {
+		log.Info("Compacting database")
+		if err := db.Compact(b.ctx, dbPath); err != nil {
+			return errors.Wrap(err, "could not compact database")
+		}
+	}
+
 	d, err := db.NewDB(dbPath, b.stateSummaryCache)
 	if err != nil {
 		return err
@@ -328,6 +355,15 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context) error {
 		return err
 	}
 
+	exportConfig, err := exporterConfig(cliCtx)
+	if err != nil {
+		return errors.Wrap(err, "could not configure exporter")
+	}
+	d, err = export.Wrap(d, exportConfig)
+	if err != nil {
+		return errors.Wrap(err, "could not wrap database with exporter")
+	}
+
 	b.db = d
 
 	depositCache, err := depositcache.New()
@@ -339,10 +375,95 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context) error {
 	return nil
 }
 
+// exporterConfig creates the sinks which the objects saved to the database are exported to.
+func exporterConfig(cliCtx *cli.Context) (*export.Config, error) {
+	cfg := &export.Config{Topics: cliCtx.StringSlice(flags.ExportTypesFlag.Name)}
+	if path := cliCtx.String(flags.ExportFileFlag.Name); path != "" {
+		sink, err := export.NewFileSink(&export.FileSinkConfig{
+			Path:       path,
+			Encoding:   export.Encoding(cliCtx.String(flags.ExportFileEncodingFlag.Name)),
+			MaxSize:    cliCtx.Int64(flags.ExportFileMaxSizeFlag.Name) * 1024 * 1024,
+			MaxBackups: cliCtx.Int(flags.ExportFileMaxBackupsFlag.Name),
+		})
+		if err != nil {
+			return nil, err
+		}
+		cfg.Sinks = append(cfg.Sinks, sink)
+	}
+	if url := cliCtx.String(flags.ExportWebhookFlag.Name); url != "" {
+		cfg.Sinks = append(cfg.Sinks, export.NewWebhookSink(url))
+	}
+	if servers := featureconfig.Get().KafkaBootstrapServers; servers != "" {
+		sink, err := export.NewKafkaSink(servers)
+		if err != nil {
+			return nil, err
+		}
+		cfg.Sinks = append(cfg.Sinks, sink)
+	}
+	return cfg, nil
+}
+
 func (b *BeaconNode) startStateGen() {
 	b.stateGen = stategen.New(b.db, b.stateSummaryCache)
 }
 
+// startFromCheckpoint seeds an empty database with the finalized checkpoint state and block given on
+// the command line, so that the node syncs forward from the checkpoint instead of from genesis.
+func (b *BeaconNode) startFromCheckpoint(cliCtx *cli.Context) error {
+	statePath := cliCtx.String(flags.CheckpointStateFlag.Name)
+	blockPath := cliCtx.String(flags.CheckpointBlockFlag.Name)
+	if statePath == "" && blockPath == "" {
+		return nil
+	}
+	if statePath == "" || blockPath == "" {
+		return fmt.Errorf("--%s and --%s must be used together", flags.CheckpointStateFlag.Name, flags.CheckpointBlockFlag.Name)
+	}
+	head, err := b.db.HeadBlock(b.ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get head block")
+	}
+	if head != nil {
+		log.Warn("Database is not empty, ignoring the checkpoint state and block")
+		return nil
+	}
+
+	enc, err := ioutil.ReadFile(statePath)
+	if err != nil {
+		return errors.Wrap(err, "could not read checkpoint state")
+	}
+	pbState := &pbp2p.BeaconState{}
+	if err := pbState.UnmarshalSSZ(enc); err != nil {
+		return errors.Wrap(err, "could not unmarshal checkpoint state")
+	}
+	st, err := stateTrie.InitializeFromProtoUnsafe(pbState)
+	if err != nil {
+		return errors.Wrap(err, "could not initialize checkpoint state")
+	}
+	enc, err = ioutil.ReadFile(blockPath)
+	if err != nil {
+		return errors.Wrap(err, "could not read checkpoint block")
+	}
+	blk := &ethpb.SignedBeaconBlock{}
+	if err := blk.UnmarshalSSZ(enc); err != nil {
+		return errors.Wrap(err, "could not unmarshal checkpoint block")
+	}
+	blockRoot, err := blk.Block.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not hash checkpoint block")
+	}
+
+	if err := b.db.SaveOrigin(b.ctx, st, blk); err != nil {
+		return errors.Wrap(err, "could not save checkpoint state and block")
+	}
+	b.stateGen.SaveFinalizedState(st.Slot(), blockRoot, st)
+
+	log.WithFields(logrus.Fields{
+		"slot":      st.Slot(),
+		"blockRoot": fmt.Sprintf("%#x", blockRoot),
+	}).Info("Initialized database from checkpoint state")
+	return nil
+}
+
 func readbootNodes(fileName string) ([]string, error) {
 	fileContent, err := ioutil.ReadFile(fileName)
 	if err != nil {
@@ -356,6 +477,21 @@ func readbootNodes(fileName string) ([]string, error) {
 	return listNodes, nil
 }
 
+func readTrustedPeerGroups(fileName string) ([]*p2p.PeerGroup, error) {
+	if fileName == "" {
+		return nil, nil
+	}
+	fileContent, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	var groups []*p2p.PeerGroup
+	if err := yaml.UnmarshalStrict(fileContent, &groups); err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
 func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 	// Bootnode ENR may be a filepath to a YAML file
 	bootnodesTemp := params.BeaconNetworkConfig().BootstrapNodes //actual CLI values
@@ -383,6 +519,11 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 		}
 	}
 
+	trustedPeerGroups, err := readTrustedPeerGroups(cliCtx.String(flags.TrustedPeersFileFlag.Name))
+	if err != nil {
+		return errors.Wrap(err, "could not read trusted peer groups")
+	}
+
 	svc, err := p2p.NewService(b.ctx, &p2p.Config{
 		NoDiscovery:       cliCtx.Bool(cmd.NoDiscovery.Name),
 		StaticPeers:       sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.StaticPeers.Name)),
@@ -402,6 +543,12 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 		EnableUPnP:        cliCtx.Bool(cmd.EnableUPnPFlag.Name),
 		DisableDiscv5:     cliCtx.Bool(flags.DisableDiscv5.Name),
 		StateNotifier:     b,
+		GossipScorerWeights: &p2p.GossipScorerWeights{
+			BadResponses:  cliCtx.Float64(flags.GossipBadResponsesWeightFlag.Name),
+			PeerStatus:    cliCtx.Float64(flags.GossipPeerStatusWeightFlag.Name),
+			BlockProvider: cliCtx.Float64(flags.GossipBlockProviderWeightFlag.Name),
+		},
+		TrustedPeerGroups: trustedPeerGroups,
 	})
 	if err != nil {
 		return err
@@ -409,7 +556,7 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 	return b.services.RegisterService(svc)
 }
 
-func (b *BeaconNode) fetchP2P() p2p.P2P {
+func (b *BeaconNode) fetchP2P() *p2p.Service {
 	var p *p2p.Service
 	if err := b.services.FetchService(&p); err != nil {
 		panic(err)
@@ -567,6 +714,51 @@ func (b *BeaconNode) registerInitialSyncService() error {
 	return b.services.RegisterService(is)
 }
 
+func (b *BeaconNode) registerBackfillService() error {
+	// A node pruning its history has no use for the history below its checkpoint sync origin.
+	if b.cliCtx.Uint64(flags.HistoryRetentionEpochsFlag.Name) > 0 {
+		return nil
+	}
+
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+
+	var initSync *initialsync.Service
+	if err := b.services.FetchService(&initSync); err != nil {
+		return err
+	}
+
+	bs := initialsync.NewBackfillService(b.ctx, &initialsync.BackfillConfig{
+		DB:          b.db,
+		Chain:       chainService,
+		P2P:         b.fetchP2P(),
+		InitialSync: initSync,
+	})
+	return b.services.RegisterService(bs)
+}
+
+func (b *BeaconNode) registerPrunerService() error {
+	retentionEpochs := b.cliCtx.Uint64(flags.HistoryRetentionEpochsFlag.Name)
+	if retentionEpochs == 0 {
+		return nil
+	}
+
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+
+	ps := pruner.New(b.ctx, &pruner.Config{
+		DB:              b.db,
+		HeadFetcher:     chainService,
+		StateNotifier:   b,
+		RetentionEpochs: retentionEpochs,
+	})
+	return b.services.RegisterService(ps)
+}
+
 func (b *BeaconNode) registerRPCService() error {
 	var chainService *blockchain.Service
 	if err := b.services.FetchService(&chainService); err != nil {
@@ -583,6 +775,12 @@ func (b *BeaconNode) registerRPCService() error {
 		return err
 	}
 
+	var backfillChecker regularsync.BackfillChecker
+	var backfillService *initialsync.BackfillService
+	if err := b.services.FetchService(&backfillService); err == nil {
+		backfillChecker = backfillService
+	}
+
 	genesisValidators := b.cliCtx.Uint64(flags.InteropNumValidatorsFlag.Name)
 	genesisStatePath := b.cliCtx.String(flags.InteropGenesisStateFlag.Name)
 	var depositFetcher depositcache.DepositFetcher
@@ -616,6 +814,8 @@ func (b *BeaconNode) registerRPCService() error {
 		Broadcaster:             p2pService,
 		PeersFetcher:            p2pService,
 		PeerManager:             p2pService,
+		PeerAdmin:               p2pService,
+		MetadataProvider:        p2pService,
 		ChainInfoFetcher:        chainService,
 		HeadFetcher:             chainService,
 		ForkFetcher:             chainService,
@@ -631,6 +831,7 @@ func (b *BeaconNode) registerRPCService() error {
 		ChainStartFetcher:       chainStartFetcher,
 		MockEth1Votes:           mockEth1DataVotes,
 		SyncService:             syncService,
+		BackfillChecker:         backfillChecker,
 		DepositFetcher:          depositFetcher,
 		PendingDepositFetcher:   b.depositCache,
 		BlockNotifier:           b,
@@ -661,8 +862,12 @@ func (b *BeaconNode) registerPrometheusService(cliCtx *cli.Context) error {
 		additionalHandlers = append(
 			additionalHandlers,
 			prometheus.Handler{
-				Path:    "/db/backup",
-				Handler: db.BackupHandler(b.db, cliCtx.String(flags.BackupWebhookOutputDir.Name)),
+				Path: "/db/backup",
+				Handler: db.BackupHandler(
+					b.db,
+					cliCtx.String(flags.BackupWebhookOutputDir.Name),
+					cliCtx.Int(flags.BackupWebhookRetentionFlag.Name),
+				),
 			},
 		)
 	}
@@ -690,12 +895,38 @@ func (b *BeaconNode) registerGRPCGateway() error {
 	gatewayAddress := fmt.Sprintf("%s:%d", gatewayHost, gatewayPort)
 	allowedOrigins := strings.Split(b.cliCtx.String(flags.GPRCGatewayCorsDomain.Name), ",")
 	enableDebugRPCEndpoints := b.cliCtx.Bool(flags.EnableDebugRPCEndpoints.Name)
+	// The events stream subscribes to the node's event feeds directly instead of going through gRPC.
+	mux := http.NewServeMux()
+	mux.Handle("/eth/v1/events", &eventsv1.Server{
+		Ctx:               b.ctx,
+		StateNotifier:     b,
+		BlockNotifier:     b,
+		OperationNotifier: b,
+	})
+	if enableDebugRPCEndpoints {
+		var chainService *blockchain.Service
+		if err := b.services.FetchService(&chainService); err != nil {
+			return err
+		}
+		// Full states are served directly as well, so they can be SSZ encoded on request
+		// without a round trip through the gRPC server.
+		mux.Handle(debugv1.StateRoutePrefix, &debugv1.StateHandler{
+			Server: &debugv1.Server{
+				HeadFetcher: chainService,
+				StateFetcher: &statefetcher.StateProvider{
+					ChainInfoFetcher:   chainService,
+					GenesisTimeFetcher: chainService,
+					StateGen:           b.stateGen,
+				},
+			},
+		})
+	}
 	return b.services.RegisterService(
 		gateway.New(
 			b.ctx,
 			selfAddress,
 			gatewayAddress,
-			nil, /*optional mux*/
+			mux,
 			allowedOrigins,
 			enableDebugRPCEndpoints,
 			b.cliCtx.Uint64(cmd.GrpcMaxCallRecvMsgSizeFlag.Name),
@@ -26,4 +26,6 @@ type Config struct {
 	AllowListCIDR       string
 	DenyListCIDR        []string
 	StateNotifier       statefeed.Notifier
+	GossipScorerWeights *GossipScorerWeights
+	TrustedPeerGroups   []*PeerGroup
 }
@@ -20,7 +20,11 @@ const ipLimit = 4
 const ipBurst = 8
 
 // InterceptPeerDial tests whether we're permitted to Dial the specified peer.
-func (s *Service) InterceptPeerDial(_ peer.ID) (allow bool) {
+func (s *Service) InterceptPeerDial(pid peer.ID) (allow bool) {
+	// Trusted peers are only dialed while their group has outbound slots available.
+	if _, ok := s.trustedPeers[pid]; ok {
+		return s.hasTrustedSlot(pid, network.DirOutbound)
+	}
 	return true
 }
 
@@ -31,6 +35,9 @@ func (s *Service) InterceptAddrDial(pid peer.ID, m multiaddr.Multiaddr) (allow b
 	if s.peers.IsBad(pid) {
 		return false
 	}
+	if s.isAddrBanned(m) {
+		return false
+	}
 	return filterConnections(s.addrFilter, m)
 }
 
@@ -44,7 +51,13 @@ func (s *Service) InterceptAccept(n network.ConnMultiaddrs) (allow bool) {
 			"reason": "exceeded dial limit"}).Trace("Not accepting inbound dial from ip address")
 		return false
 	}
-	if s.isPeerAtLimit() {
+	if s.isAddrBanned(n.RemoteMultiaddr()) {
+		log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
+			"reason": "banned subnet"}).Trace("Not accepting inbound dial")
+		return false
+	}
+	// Trusted peers have reserved slots, their connections are checked once secured.
+	if s.isPeerAtLimit() && !s.isTrustedAddr(n.RemoteMultiaddr()) {
 		log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
 			"reason": "at peer limit"}).Trace("Not accepting inbound dial")
 		return false
@@ -54,7 +67,29 @@ func (s *Service) InterceptAccept(n network.ConnMultiaddrs) (allow bool) {
 
 // InterceptSecured tests whether a given connection, now authenticated,
 // is allowed.
-func (s *Service) InterceptSecured(_ network.Direction, _ peer.ID, _ network.ConnMultiaddrs) (allow bool) {
+func (s *Service) InterceptSecured(direction network.Direction, pid peer.ID, n network.ConnMultiaddrs) (allow bool) {
+	if s.peers.IsBanned(pid) {
+		log.WithFields(logrus.Fields{"peer": pid,
+			"reason": "banned peer"}).Trace("Not accepting connection")
+		return false
+	}
+	if direction != network.DirInbound {
+		return true
+	}
+	if _, ok := s.trustedPeers[pid]; ok {
+		if !s.hasTrustedSlot(pid, network.DirInbound) {
+			log.WithFields(logrus.Fields{"peer": pid,
+				"reason": "no trusted slot available"}).Trace("Not accepting inbound dial")
+			return false
+		}
+		return true
+	}
+	// Connections from the address of a trusted peer were accepted at the peer limit.
+	if s.isTrustedAddr(n.RemoteMultiaddr()) && s.isPeerAtLimit() {
+		log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
+			"reason": "at peer limit"}).Trace("Not accepting inbound dial")
+		return false
+	}
 	return true
 }
 
@@ -3,6 +3,7 @@ package p2p
 import (
 	"bytes"
 	"crypto/ecdsa"
+	"fmt"
 	"net"
 	"time"
 
@@ -34,20 +35,21 @@ type Listener interface {
 // RefreshENR uses an epoch to refresh the enr entry for our node
 // with the tracked committee ids for the epoch, allowing our node
 // to be dynamically discoverable by others given our tracked committee ids.
+// Without discv5, only our metadata is refreshed.
 func (s *Service) RefreshENR() {
-	// return early if discv5 isnt running
-	if s.dv5Listener == nil {
-		return
-	}
 	bitV := bitfield.NewBitvector64()
 	committees := cache.SubnetIDs.GetAllSubnets()
 	for _, idx := range committees {
 		bitV.SetBitAt(idx, true)
 	}
-	currentBitV, err := retrieveBitvector(s.dv5Listener.Self().Record())
-	if err != nil {
-		log.Errorf("Could not retrieve bitfield: %v", err)
-		return
+	currentBitV := s.metaData.Attnets
+	if s.dv5Listener != nil {
+		var err error
+		currentBitV, err = retrieveBitvector(s.dv5Listener.Self().Record())
+		if err != nil {
+			log.Errorf("Could not retrieve bitfield: %v", err)
+			return
+		}
 	}
 	if bytes.Equal(bitV, currentBitV) {
 		// return early if bitfield hasn't changed
@@ -277,9 +279,12 @@ func (s *Service) filterPeer(node *enode.Node) bool {
 // determines whether our currently connected and
 // active peers are above our set max peer limit.
 func (s *Service) isPeerAtLimit() bool {
-	numOfConns := len(s.host.Network().Peers())
+	connectedPeers := s.host.Network().Peers()
 	maxPeers := int(s.cfg.MaxPeers)
-	activePeers := len(s.Peers().Active())
+	active := s.Peers().Active()
+	// Trusted peers are held in reserved slots, which do not count against the peer limit.
+	numOfConns := len(connectedPeers) - s.numTrustedPeers(connectedPeers)
+	activePeers := len(active) - s.numTrustedPeers(active)
 
 	return activePeers >= maxPeers || numOfConns >= maxPeers
 }
@@ -352,6 +357,23 @@ func convertToSingleMultiAddr(node *enode.Node) (ma.Multiaddr, error) {
 	return multiAddressBuilderWithID(node.IP().String(), uint(node.TCP()), id)
 }
 
+func convertToUdpMultiAddr(node *enode.Node) (ma.Multiaddr, error) {
+	pubkey := node.Pubkey()
+	assertedKey := convertToInterfacePubkey(pubkey)
+	id, err := peer.IDFromPublicKey(assertedKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get peer id")
+	}
+	ip := node.IP()
+	if ip == nil {
+		return nil, errors.New("node has no ip address")
+	}
+	if ip.To4() != nil {
+		return ma.NewMultiaddr(fmt.Sprintf("/ip4/%s/udp/%d/p2p/%s", ip.String(), node.UDP(), id.String()))
+	}
+	return ma.NewMultiaddr(fmt.Sprintf("/ip6/%s/udp/%d/p2p/%s", ip.String(), node.UDP(), id.String()))
+}
+
 func peersFromStringAddrs(addrs []string) ([]ma.Multiaddr, error) {
 	var allAddrs []ma.Multiaddr
 	enodeString, multiAddrString := parseGenericAddrs(addrs)
@@ -8,6 +8,7 @@ import (
 	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/shared/params"
 )
 
@@ -24,9 +25,48 @@ const (
 	// decayToZero specifies the terminal value that we will use when decaying
 	// a value.
 	decayToZero = 0.01
+	// defaultBadResponsesGossipWeight specifies the weight of the bad responses scorer in the
+	// application-specific score, it is shared with the default of the corresponding flag.
+	defaultBadResponsesGossipWeight = flags.DefaultGossipBadResponsesWeight
+	// defaultPeerStatusGossipWeight specifies the weight of the peer status scorer in the
+	// application-specific score, it is shared with the default of the corresponding flag.
+	defaultPeerStatusGossipWeight = flags.DefaultGossipPeerStatusWeight
+	// defaultBlockProviderGossipWeight specifies the weight of the block provider scorer in the
+	// application-specific score, it is shared with the default of the corresponding flag.
+	defaultBlockProviderGossipWeight = flags.DefaultGossipBlockProviderWeight
 )
 
-func peerScoringParams() (*pubsub.PeerScoreParams, *pubsub.PeerScoreThresholds) {
+// GossipScorerWeights defines the weights of the peer scorers in the application-specific
+// score of a peer in gossipsub. Scorers report penalties as negative scores, so a peer
+// classified as bad by a scorer has the full weight of the scorer subtracted from its score.
+type GossipScorerWeights struct {
+	BadResponses  float64
+	PeerStatus    float64
+	BlockProvider float64
+}
+
+// DefaultGossipScorerWeights returns the weights of the peer scorers used when none are configured.
+func DefaultGossipScorerWeights() *GossipScorerWeights {
+	return &GossipScorerWeights{
+		BadResponses:  defaultBadResponsesGossipWeight,
+		PeerStatus:    defaultPeerStatusGossipWeight,
+		BlockProvider: defaultBlockProviderGossipWeight,
+	}
+}
+
+// gossipScoreComponents holds the weighted contribution of each peer scorer to the
+// application-specific score of a peer.
+type gossipScoreComponents struct {
+	badResponses  float64
+	peerStatus    float64
+	blockProvider float64
+}
+
+func (c *gossipScoreComponents) total() float64 {
+	return c.badResponses + c.peerStatus + c.blockProvider
+}
+
+func peerScoringParams(appSpecificScore func(p peer.ID) float64) (*pubsub.PeerScoreParams, *pubsub.PeerScoreThresholds) {
 	thresholds := &pubsub.PeerScoreThresholds{
 		GossipThreshold:             -4000,
 		PublishThreshold:            -8000,
@@ -35,11 +75,9 @@ func peerScoringParams() (*pubsub.PeerScoreParams, *pubsub.PeerScoreThresholds)
 		OpportunisticGraftThreshold: 5,
 	}
 	scoreParams := &pubsub.PeerScoreParams{
-		Topics:        make(map[string]*pubsub.TopicScoreParams),
-		TopicScoreCap: 32.72,
-		AppSpecificScore: func(p peer.ID) float64 {
-			return 0
-		},
+		Topics:                      make(map[string]*pubsub.TopicScoreParams),
+		TopicScoreCap:               32.72,
+		AppSpecificScore:            appSpecificScore,
 		AppSpecificWeight:           1,
 		IPColocationFactorWeight:    -35.11,
 		IPColocationFactorThreshold: 10,
@@ -54,6 +92,38 @@ func peerScoringParams() (*pubsub.PeerScoreParams, *pubsub.PeerScoreThresholds)
 	return scoreParams, thresholds
 }
 
+// appSpecificScore feeds the scores of the peer scorers into the score of a peer in gossipsub,
+// so that peers misbehaving over RPC or following an incompatible chain are pruned from our meshes.
+func (s *Service) appSpecificScore(pid peer.ID) float64 {
+	components := s.gossipScoreComponents(pid)
+	return components.total()
+}
+
+// gossipScoreComponents computes the weighted contribution of each peer scorer to the
+// application-specific score of a peer.
+func (s *Service) gossipScoreComponents(pid peer.ID) *gossipScoreComponents {
+	weights := s.cfg.GossipScorerWeights
+	if weights == nil {
+		weights = DefaultGossipScorerWeights()
+	}
+	scorers := s.peers.Scorers()
+	components := &gossipScoreComponents{
+		badResponses: weights.BadResponses * scorers.BadResponsesScorer().Score(pid),
+		// The peer status score rewards peers close to the highest known head slot, which says
+		// nothing about their gossip, only its penalty for an incompatible chain is applied.
+		peerStatus: weights.PeerStatus * math.Min(scorers.PeerStatusScorer().Score(pid), 0),
+	}
+	// The block provider score is normalized, so that its weight is the most a peer can gain.
+	if maxScore := scorers.BlockProviderScorer().MaxScore(); maxScore > 0 {
+		components.blockProvider = weights.BlockProvider * scorers.BlockProviderScorer().Score(pid) / maxScore
+	}
+	// Trusted peers are exempt from bad peer scoring, only the rewards apply to them.
+	if s.peers.IsTrusted(pid) {
+		components.badResponses, components.peerStatus = 0, 0
+	}
+	return components
+}
+
 func topicScoreParams(topic string) *pubsub.TopicScoreParams {
 	switch {
 	case strings.Contains(topic, "beacon_block"):
@@ -0,0 +1,57 @@
+package p2p
+
+import (
+	"context"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+)
+
+func TestService_AppSpecificScore(t *testing.T) {
+	s := &Service{
+		cfg: &Config{},
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit: 30,
+			ScorerParams: &scorers.Config{
+				BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+					Threshold: 4,
+				},
+			},
+		}),
+	}
+	good, unresponsive, wrongFork := peer.ID("good"), peer.ID("unresponsive"), peer.ID("wrongFork")
+	for _, pid := range []peer.ID{good, unresponsive, wrongFork} {
+		s.peers.Add(nil, pid, nil, network.DirOutbound)
+	}
+	s.peers.Scorers().PeerStatusScorer().SetPeerStatus(good, &pb.Status{HeadSlot: 64}, nil)
+	s.peers.Scorers().BadResponsesScorer().Increment(unresponsive)
+	s.peers.Scorers().PeerStatusScorer().SetPeerStatus(wrongFork, &pb.Status{}, p2ptypes.ErrWrongForkDigestVersion)
+
+	assert.Equal(t, float64(0), s.appSpecificScore(good), "Unexpected score of a good peer")
+	assert.Equal(t, -defaultBadResponsesGossipWeight/4.0, s.appSpecificScore(unresponsive))
+	assert.Equal(t, float64(-defaultPeerStatusGossipWeight), s.appSpecificScore(wrongFork))
+	assert.Equal(t, true, s.appSpecificScore(wrongFork) < -16000, "Expected peer on a wrong fork to be graylisted")
+
+	// Peers reaching the bad responses threshold stop exchanging gossip with us.
+	for i := 0; i < 3; i++ {
+		s.peers.Scorers().BadResponsesScorer().Increment(unresponsive)
+	}
+	assert.Equal(t, true, s.appSpecificScore(unresponsive) < -4000, "Expected bad peer to be below the gossip threshold")
+
+	s.cfg.GossipScorerWeights = &GossipScorerWeights{
+		BadResponses:  100,
+		BlockProvider: 10,
+	}
+	assert.Equal(t, float64(0), s.gossipScoreComponents(wrongFork).peerStatus, "Expected peer status to be ignored")
+	components := s.gossipScoreComponents(unresponsive)
+	assert.Equal(t, float64(-100), components.badResponses)
+	// Peers without blocks served yet are boosted to the maximum block provider score.
+	assert.Equal(t, float64(10), components.blockProvider)
+	assert.Equal(t, float64(-90), components.total())
+}
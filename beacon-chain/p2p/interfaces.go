@@ -2,6 +2,7 @@ package p2p
 
 import (
 	"context"
+	"time"
 
 	"github.com/ethereum/go-ethereum/p2p/enr"
 	"github.com/gogo/protobuf/proto"
@@ -10,9 +11,11 @@ import (
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	"github.com/multiformats/go-multiaddr"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 )
 
@@ -73,8 +76,9 @@ type PeerManager interface {
 	PeerID() peer.ID
 	Host() host.Host
 	ENR() *enr.Record
+	DiscoveryAddresses() ([]multiaddr.Multiaddr, error)
 	RefreshENR()
-	FindPeersWithSubnet(ctx context.Context, index uint64) (bool, error)
+	FindPeersWithSubnet(ctx context.Context, index uint64, threshold int) (bool, error)
 	AddPingMethod(reqFunc func(ctx context.Context, id peer.ID) error)
 }
 
@@ -93,3 +97,14 @@ type MetadataProvider interface {
 	Metadata() *pb.MetaData
 	MetadataSeq() uint64
 }
+
+// PeerAdmin provides runtime administration of peers, such as banning and disconnecting them.
+type PeerAdmin interface {
+	AddPeer(ctx context.Context, addr string) (peer.ID, error)
+	DisconnectPeer(ctx context.Context, pid peer.ID, code types.RPCGoodbyeCode) error
+	BanPeer(ctx context.Context, pid peer.ID, duration time.Duration) error
+	BanCIDR(ctx context.Context, cidr string, duration time.Duration) error
+	UnbanPeer(pid peer.ID) error
+	UnbanCIDR(cidr string) error
+	Bans() []*Ban
+}
@@ -25,6 +25,12 @@ var (
 		Name: "p2p_attestation_subnet_attempted_broadcasts",
 		Help: "The number of attestations that were attempted to be broadcast.",
 	})
+	gossipPeerScore = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "p2p_gossip_peer_score",
+		Help: "The gossipsub score of connected peers broken down by component: the weighted score " +
+			"of each peer scorer, the score computed by gossipsub itself and the total score.",
+	},
+		[]string{"peer_id", "component"})
 )
 
 func (s *Service) updateMetrics() {
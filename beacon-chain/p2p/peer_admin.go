@@ -0,0 +1,208 @@
+package p2p
+
+import (
+	"context"
+	"net"
+	"sort"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/helpers"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr-net"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	// ErrNotBanned is returned when lifting a ban which does not exist.
+	ErrNotBanned = errors.New("not banned")
+	// ErrNotConnected is returned when disconnecting from a peer we are not connected to.
+	ErrNotConnected = errors.New("peer is not connected")
+)
+
+// Ban describes a ban of a peer ID or a CIDR subnet, only one of which is set.
+type Ban struct {
+	PeerID peer.ID
+	CIDR   *net.IPNet
+	Until  time.Time
+}
+
+// cidrBan is a CIDR subnet refused by the connection gater until its expiry.
+type cidrBan struct {
+	ipNet *net.IPNet
+	until time.Time
+}
+
+// AddPeer connects to the peer with the given multiaddr, including the peer ID, or ENR.
+func (s *Service) AddPeer(ctx context.Context, addr string) (peer.ID, error) {
+	addrs, err := peersFromStringAddrs([]string{addr})
+	if err != nil {
+		return "", err
+	}
+	if len(addrs) != 1 {
+		return "", errors.Errorf("invalid peer address %q", addr)
+	}
+	info, err := peer.AddrInfoFromP2pAddr(addrs[0])
+	if err != nil {
+		return "", err
+	}
+	if s.isAddrBanned(addrs[0]) {
+		return "", errors.New("refused to connect to banned address")
+	}
+	if err := s.connectWithPeer(ctx, *info); err != nil {
+		return "", err
+	}
+	log.WithField("peer", info.ID).Info("Added peer")
+	return info.ID, nil
+}
+
+// DisconnectPeer sends a goodbye message with the given code to the peer and disconnects from it.
+func (s *Service) DisconnectPeer(ctx context.Context, pid peer.ID, code types.RPCGoodbyeCode) error {
+	if s.host.Network().Connectedness(pid) != network.Connected {
+		return ErrNotConnected
+	}
+	s.peers.SetConnectionState(pid, peers.PeerDisconnecting)
+	if err := s.sendGoodbye(ctx, pid, code); err != nil {
+		log.WithError(err).WithField("peer", pid).Debug("Could not send goodbye message to peer")
+	}
+	if err := s.Disconnect(pid); err != nil {
+		return err
+	}
+	s.peers.SetConnectionState(pid, peers.PeerDisconnected)
+	log.WithFields(logrus.Fields{
+		"peer": pid,
+		"code": uint64(code),
+	}).Info("Disconnected from peer")
+	return nil
+}
+
+// BanPeer bans the peer for the given duration and disconnects from it.
+func (s *Service) BanPeer(ctx context.Context, pid peer.ID, duration time.Duration) error {
+	if duration <= 0 {
+		return errors.New("ban duration must be positive")
+	}
+	until := timeutils.Now().Add(duration)
+	s.peers.Ban(pid, until)
+	log.WithField("peer", pid).WithField("until", until).Info("Banned peer")
+	if err := s.DisconnectPeer(ctx, pid, types.GoodbyeCodeBanned); err != nil && err != ErrNotConnected {
+		return err
+	}
+	return nil
+}
+
+// BanCIDR bans the peers of the given CIDR subnet for the given duration and disconnects from them.
+func (s *Service) BanCIDR(ctx context.Context, cidr string, duration time.Duration) error {
+	if duration <= 0 {
+		return errors.New("ban duration must be positive")
+	}
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return err
+	}
+	until := timeutils.Now().Add(duration)
+	s.cidrBansLock.Lock()
+	if s.cidrBans == nil {
+		s.cidrBans = make(map[string]*cidrBan)
+	}
+	s.cidrBans[ipNet.String()] = &cidrBan{ipNet: ipNet, until: until}
+	s.cidrBansLock.Unlock()
+	log.WithField("cidr", ipNet).WithField("until", until).Info("Banned subnet")
+
+	for _, pid := range s.host.Network().Peers() {
+		for _, conn := range s.host.Network().ConnsToPeer(pid) {
+			if ip, err := manet.ToIP(conn.RemoteMultiaddr()); err == nil && ipNet.Contains(ip) {
+				if err := s.DisconnectPeer(ctx, pid, types.GoodbyeCodeBanned); err != nil && err != ErrNotConnected {
+					log.WithError(err).WithField("peer", pid).Debug("Could not disconnect from banned peer")
+				}
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// UnbanPeer lifts the ban of the peer.
+func (s *Service) UnbanPeer(pid peer.ID) error {
+	if !s.peers.Unban(pid) {
+		return ErrNotBanned
+	}
+	log.WithField("peer", pid).Info("Unbanned peer")
+	return nil
+}
+
+// UnbanCIDR lifts the ban of the CIDR subnet, subnets denied by the --p2p-denylist flag can not be
+// unbanned.
+func (s *Service) UnbanCIDR(cidr string) error {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return err
+	}
+	s.cidrBansLock.Lock()
+	defer s.cidrBansLock.Unlock()
+	ban, ok := s.cidrBans[ipNet.String()]
+	if !ok || !ban.until.After(timeutils.Now()) {
+		return ErrNotBanned
+	}
+	delete(s.cidrBans, ipNet.String())
+	log.WithField("cidr", ipNet).Info("Unbanned subnet")
+	return nil
+}
+
+// Bans returns the peer IDs and CIDR subnets currently banned, sorted by expiry.
+func (s *Service) Bans() []*Ban {
+	var bans []*Ban
+	for pid, until := range s.peers.Bans() {
+		bans = append(bans, &Ban{PeerID: pid, Until: until})
+	}
+	now := timeutils.Now()
+	s.cidrBansLock.Lock()
+	for key, ban := range s.cidrBans {
+		if !ban.until.After(now) {
+			delete(s.cidrBans, key)
+			continue
+		}
+		bans = append(bans, &Ban{CIDR: ban.ipNet, Until: ban.until})
+	}
+	s.cidrBansLock.Unlock()
+	sort.Slice(bans, func(i, j int) bool {
+		return bans[i].Until.Before(bans[j].Until)
+	})
+	return bans
+}
+
+// isAddrBanned returns whether the IP address of the multiaddr is in a banned CIDR subnet.
+func (s *Service) isAddrBanned(addr ma.Multiaddr) bool {
+	s.cidrBansLock.RLock()
+	defer s.cidrBansLock.RUnlock()
+	if len(s.cidrBans) == 0 {
+		return false
+	}
+	ip, err := manet.ToIP(addr)
+	if err != nil {
+		return false
+	}
+	now := timeutils.Now()
+	for _, ban := range s.cidrBans {
+		if ban.until.After(now) && ban.ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// sendGoodbye sends a goodbye message with the given code to the peer.
+func (s *Service) sendGoodbye(ctx context.Context, pid peer.ID, code types.RPCGoodbyeCode) error {
+	ctx, cancel := context.WithTimeout(ctx, params.BeaconNetworkConfig().RespTimeout)
+	defer cancel()
+	stream, err := s.Send(ctx, &code, RPCGoodByeTopic, pid)
+	if err != nil {
+		return err
+	}
+	return helpers.FullClose(stream)
+}
@@ -0,0 +1,173 @@
+package p2p
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/kevinms/leakybucket-go"
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/encoder"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_BanCIDR(t *testing.T) {
+	ipAddr, pkey := createAddrAndPrivKey(t)
+	listen, err := multiaddr.NewMultiaddr(fmt.Sprintf("/ip4/%s/tcp/%d", ipAddr, 2020))
+	require.NoError(t, err)
+	h, err := libp2p.New(context.Background(), []libp2p.Option{privKeyOption(pkey), libp2p.ListenAddrs(listen)}...)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, h.Close())
+	}()
+	s := &Service{
+		host:      h,
+		ipLimiter: leakybucket.NewCollector(ipLimit, ipBurst, false),
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			ScorerParams: &scorers.Config{},
+		}),
+	}
+	s.addrFilter, err = configureFilter(&Config{})
+	require.NoError(t, err)
+	bannedAddr, err := multiaddr.NewMultiaddr("/ip4/212.67.10.122/tcp/3000")
+	require.NoError(t, err)
+	otherAddr, err := multiaddr.NewMultiaddr("/ip4/212.68.10.122/tcp/3000")
+	require.NoError(t, err)
+
+	assert.ErrorContains(t, "ban duration must be positive", s.BanCIDR(context.Background(), "212.67.0.0/16", 0))
+	assert.NotNil(t, s.BanCIDR(context.Background(), "212.67.0.0", time.Hour), "Expected an invalid CIDR to be refused")
+	require.NoError(t, s.BanCIDR(context.Background(), "212.67.89.112/16", time.Hour))
+	assert.Equal(t, false, s.InterceptAddrDial("", bannedAddr), "Expected address in banned subnet to be rejected")
+	assert.Equal(t, true, s.InterceptAddrDial("", otherAddr), "Expected address outside of banned subnet to be accepted")
+
+	bans := s.Bans()
+	require.Equal(t, 1, len(bans))
+	assert.Equal(t, "212.67.0.0/16", bans[0].CIDR.String())
+	assert.Equal(t, peer.ID(""), bans[0].PeerID)
+
+	require.NoError(t, s.UnbanCIDR("212.67.0.0/16"))
+	assert.Equal(t, ErrNotBanned, s.UnbanCIDR("212.67.0.0/16"))
+	assert.Equal(t, true, s.InterceptAddrDial("", bannedAddr), "Expected address to be accepted once unbanned")
+
+	// Expired bans are ignored, and dropped when listing bans.
+	_, ipNet, err := net.ParseCIDR("212.67.0.0/16")
+	require.NoError(t, err)
+	s.cidrBans[ipNet.String()] = &cidrBan{ipNet: ipNet, until: time.Now().Add(-time.Second)}
+	assert.Equal(t, true, s.InterceptAddrDial("", bannedAddr), "Expected address to be accepted once the ban expired")
+	assert.Equal(t, 0, len(s.Bans()))
+	assert.Equal(t, 0, len(s.cidrBans))
+}
+
+func TestService_BanPeer(t *testing.T) {
+	// create host and remote peer
+	ipAddr, pkey := createAddrAndPrivKey(t)
+	ipAddr2, pkey2 := createAddrAndPrivKey(t)
+
+	listen, err := multiaddr.NewMultiaddr(fmt.Sprintf("/ip4/%s/tcp/%d", ipAddr, 2021))
+	require.NoError(t, err, "Failed to p2p listen")
+	s := &Service{
+		cfg:       &Config{MaxPeers: 30},
+		ipLimiter: leakybucket.NewCollector(ipLimit, ipBurst, false),
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit:    30,
+			ScorerParams: &scorers.Config{},
+		}),
+	}
+	s.addrFilter, err = configureFilter(&Config{})
+	require.NoError(t, err)
+	h1, err := libp2p.New(context.Background(), []libp2p.Option{privKeyOption(pkey), libp2p.ListenAddrs(listen), libp2p.ConnectionGater(s)}...)
+	require.NoError(t, err)
+	s.host = h1
+	defer func() {
+		require.NoError(t, h1.Close())
+	}()
+
+	// create alternate host
+	listen, err = multiaddr.NewMultiaddr(fmt.Sprintf("/ip4/%s/tcp/%d", ipAddr2, 3021))
+	require.NoError(t, err, "Failed to p2p listen")
+	h2, err := libp2p.New(context.Background(), []libp2p.Option{privKeyOption(pkey2), libp2p.ListenAddrs(listen)}...)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, h2.Close())
+	}()
+	multiAddress, err := multiaddr.NewMultiaddr(fmt.Sprintf("/ip4/%s/tcp/%d/p2p/%s", ipAddr, 2021, h1.ID()))
+	require.NoError(t, err)
+	addrInfo, err := peer.AddrInfoFromP2pAddr(multiAddress)
+	require.NoError(t, err)
+	require.NoError(t, h2.Connect(context.Background(), *addrInfo))
+	require.Equal(t, network.Connected, h1.Network().Connectedness(h2.ID()))
+
+	assert.ErrorContains(t, "ban duration must be positive", s.BanPeer(context.Background(), h2.ID(), -time.Second))
+	require.NoError(t, s.BanPeer(context.Background(), h2.ID(), time.Hour))
+	assert.Equal(t, network.NotConnected, h1.Network().Connectedness(h2.ID()), "Expected banned peer to be disconnected")
+	assert.Equal(t, true, s.peers.IsBad(h2.ID()))
+	bans := s.Bans()
+	require.Equal(t, 1, len(bans))
+	assert.Equal(t, h2.ID(), bans[0].PeerID)
+	assert.Equal(t, false, s.InterceptSecured(network.DirOutbound, h2.ID(), nil), "Expected connection with banned peer to be rejected")
+
+	require.NoError(t, s.UnbanPeer(h2.ID()))
+	assert.Equal(t, ErrNotBanned, s.UnbanPeer(h2.ID()))
+	assert.Equal(t, 0, len(s.Bans()))
+	assert.Equal(t, true, s.InterceptSecured(network.DirOutbound, h2.ID(), nil), "Expected connection with unbanned peer to be accepted")
+}
+
+func TestService_AddAndDisconnectPeer(t *testing.T) {
+	ipAddr, pkey := createAddrAndPrivKey(t)
+	ipAddr2, pkey2 := createAddrAndPrivKey(t)
+
+	listen, err := multiaddr.NewMultiaddr(fmt.Sprintf("/ip4/%s/tcp/%d", ipAddr, 2022))
+	require.NoError(t, err, "Failed to p2p listen")
+	s := &Service{
+		ctx: context.Background(),
+		cfg: &Config{MaxPeers: 30},
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit:    30,
+			ScorerParams: &scorers.Config{},
+		}),
+	}
+	h1, err := libp2p.New(context.Background(), []libp2p.Option{privKeyOption(pkey), libp2p.ListenAddrs(listen)}...)
+	require.NoError(t, err)
+	s.host = h1
+	defer func() {
+		require.NoError(t, h1.Close())
+	}()
+
+	listen, err = multiaddr.NewMultiaddr(fmt.Sprintf("/ip4/%s/tcp/%d", ipAddr2, 3022))
+	require.NoError(t, err, "Failed to p2p listen")
+	h2, err := libp2p.New(context.Background(), []libp2p.Option{privKeyOption(pkey2), libp2p.ListenAddrs(listen)}...)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, h2.Close())
+	}()
+	received := make(chan struct{})
+	h2.SetStreamHandler(protocol.ID(RPCGoodByeTopic+(&encoder.SszNetworkEncoder{}).ProtocolSuffix()), func(stream network.Stream) {
+		close(received)
+		require.NoError(t, stream.Close())
+	})
+
+	_, err = s.AddPeer(context.Background(), fmt.Sprintf("/ip4/%s/tcp/%d", ipAddr2, 3022))
+	assert.NotNil(t, err, "Expected address without peer ID to be refused")
+	pid, err := s.AddPeer(context.Background(), fmt.Sprintf("/ip4/%s/tcp/%d/p2p/%s", ipAddr2, 3022, h2.ID()))
+	require.NoError(t, err)
+	assert.Equal(t, h2.ID(), pid)
+	require.Equal(t, network.Connected, h1.Network().Connectedness(h2.ID()))
+
+	require.NoError(t, s.DisconnectPeer(context.Background(), pid, 2))
+	select {
+	case <-received:
+	case <-time.After(time.Second):
+		t.Error("Expected goodbye message to be sent")
+	}
+	assert.Equal(t, network.NotConnected, h1.Network().Connectedness(h2.ID()))
+	assert.Equal(t, ErrNotConnected, s.DisconnectPeer(context.Background(), pid, 2))
+}
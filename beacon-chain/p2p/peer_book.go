@@ -0,0 +1,137 @@
+package p2p
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+const (
+	// peerBookPath is the file the peer book is saved to in the data directory.
+	peerBookPath = "peerbook.json"
+	// peerBookSaveInterval is how often the peer book is saved while the node runs.
+	peerBookSaveInterval = 5 * time.Minute
+	// peerBookMaxAge is how long a peer we are no longer connected to is kept in the peer book.
+	peerBookMaxAge = 7 * 24 * time.Hour
+)
+
+// loadPeerBook restores the peers saved in the peer book of the data directory into the peer
+// status, so that their scoring history and bans survive a restart. The peers which are not banned
+// are kept to be dialed at startup.
+func (s *Service) loadPeerBook() error {
+	if s.cfg.DataDir == "" {
+		return nil
+	}
+	peerBookFile := path.Join(s.cfg.DataDir, peerBookPath)
+	if !fileutil.FileExists(peerBookFile) {
+		return nil
+	}
+	enc, err := ioutil.ReadFile(peerBookFile)
+	if err != nil {
+		return errors.Wrap(err, "could not read peer book")
+	}
+	var entries []*peers.PeerBookEntry
+	if err := json.Unmarshal(enc, &entries); err != nil {
+		return errors.Wrap(err, "could not decode peer book")
+	}
+	now := timeutils.Now()
+	restored := make([]*peers.PeerBookEntry, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.Banned(now) && now.Sub(entry.LastSeen) > peerBookMaxAge {
+			continue
+		}
+		restored = append(restored, entry)
+		if !entry.Banned(now) {
+			s.peerBook = append(s.peerBook, entry)
+		}
+	}
+	s.peers.RestorePeerBook(restored)
+	log.WithField("peers", len(s.peerBook)).WithField("banned", len(restored)-len(s.peerBook)).Debug("Loaded peer book")
+	return nil
+}
+
+// savePeerBook writes the best known peers and the banned peers to the peer book of the data
+// directory. The file is replaced atomically, so a crash leaves the previous peer book intact.
+func (s *Service) savePeerBook() {
+	if s.cfg.DataDir == "" || s.peers == nil {
+		return
+	}
+	entries := s.peers.PeerBook(s.peers.MaxPeerLimit(), peerBookMaxAge)
+	enc, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		log.WithError(err).Error("Could not encode peer book")
+		return
+	}
+	peerBookFile := path.Join(s.cfg.DataDir, peerBookPath)
+	tmpFile := peerBookFile + ".tmp"
+	if err := fileutil.WriteFile(tmpFile, enc); err != nil {
+		log.WithError(err).Error("Could not write peer book")
+		return
+	}
+	if err := os.Rename(tmpFile, peerBookFile); err != nil {
+		log.WithError(err).Error("Could not replace peer book")
+	}
+}
+
+// connectWithPeerBook dials the best peers of the peer book loaded at startup, up to the peer
+// limit, so that the node does not have to wait for discovery to find peers again.
+func (s *Service) connectWithPeerBook() {
+	dialed := 0
+	for _, entry := range s.peerBook {
+		if dialed >= int(s.cfg.MaxPeers) {
+			break
+		}
+		info, err := peerBookAddrInfo(entry)
+		if err != nil || len(info.Addrs) == 0 {
+			continue
+		}
+		dialed++
+		// make each dial non-blocking
+		go func(info peer.AddrInfo) {
+			if err := s.connectWithPeer(s.ctx, info); err != nil {
+				log.WithError(err).Tracef("Could not connect with peer %s", info.String())
+			}
+		}(*info)
+	}
+	// The peer book is only dialed once, discovery takes over afterwards.
+	s.peerBook = nil
+	if dialed > 0 {
+		log.WithField("peers", dialed).Info("Dialing peers from the peer book")
+	}
+}
+
+// peerBookAddrInfo returns the addresses a peer of the peer book can be dialed on, taken from its
+// ENR and the addresses it was dialed on before.
+func peerBookAddrInfo(entry *peers.PeerBookEntry) (*peer.AddrInfo, error) {
+	pid, err := peer.Decode(entry.ID)
+	if err != nil {
+		return nil, err
+	}
+	info := &peer.AddrInfo{ID: pid}
+	if entry.ENR != "" {
+		node, err := enode.Parse(enode.ValidSchemes, entry.ENR)
+		if err == nil {
+			if nodeInfo, _, err := convertToAddrInfo(node); err == nil && nodeInfo.ID == pid {
+				info.Addrs = append(info.Addrs, nodeInfo.Addrs...)
+			}
+		}
+	}
+	for _, addr := range entry.Addresses {
+		multiAddr, err := ma.NewMultiaddr(addr)
+		if err != nil {
+			continue
+		}
+		info.Addrs = append(info.Addrs, multiAddr)
+	}
+	return info, nil
+}
@@ -0,0 +1,94 @@
+package p2p
+
+import (
+	"context"
+	"net"
+	"path"
+	"testing"
+
+	gethCrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_PeerBook_SaveAndLoad(t *testing.T) {
+	dataDir := t.TempDir()
+	newService := func() *Service {
+		return &Service{
+			cfg: &Config{DataDir: dataDir, MaxPeers: 30},
+			peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+				PeerLimit: 30,
+				ScorerParams: &scorers.Config{
+					BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+						Threshold: 1,
+					},
+				},
+			}),
+		}
+	}
+	s := newService()
+	// Loading without a saved peer book is a no-op.
+	require.NoError(t, s.loadPeerBook())
+	assert.Equal(t, 0, len(s.peerBook))
+
+	good, goodRecord := peerBookTestNode(t, 13000)
+	address, err := ma.NewMultiaddr("/ip4/213.202.254.180/tcp/13001")
+	require.NoError(t, err)
+	s.peers.Add(goodRecord, good, address, network.DirOutbound)
+	s.peers.SetConnectionState(good, peers.PeerConnected)
+	bad, badRecord := peerBookTestNode(t, 13002)
+	s.peers.Add(badRecord, bad, nil, network.DirInbound)
+	s.peers.SetConnectionState(bad, peers.PeerConnected)
+	s.peers.Scorers().BadResponsesScorer().Increment(bad)
+	s.savePeerBook()
+	require.Equal(t, true, fileutil.FileExists(path.Join(dataDir, peerBookPath)))
+
+	restored := newService()
+	require.NoError(t, restored.loadPeerBook())
+	assert.Equal(t, 2, len(restored.peers.All()))
+	assert.Equal(t, true, restored.peers.IsBad(bad), "Expected bad peer to be banned after a restart")
+	assert.Equal(t, false, restored.peers.IsBad(good))
+	// Only peers which are not banned are dialed at startup.
+	require.Equal(t, 1, len(restored.peerBook))
+	info, err := peerBookAddrInfo(restored.peerBook[0])
+	require.NoError(t, err)
+	assert.Equal(t, good, info.ID)
+	require.Equal(t, 2, len(info.Addrs))
+	assert.Equal(t, "/ip4/213.202.254.180/tcp/13000", info.Addrs[0].String())
+	assert.Equal(t, address.String(), info.Addrs[1].String())
+}
+
+func TestPeerBookAddrInfo_MismatchedENR(t *testing.T) {
+	pid, _ := peerBookTestNode(t, 13000)
+	_, record := peerBookTestNode(t, 13001)
+	node, err := enode.New(enode.ValidSchemes, record)
+	require.NoError(t, err)
+	info, err := peerBookAddrInfo(&peers.PeerBookEntry{ID: pid.String(), ENR: node.String()})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(info.Addrs), "Expected addresses of an ENR of another peer to be ignored")
+
+	_, err = peerBookAddrInfo(&peers.PeerBookEntry{ID: "invalid"})
+	assert.NotNil(t, err)
+}
+
+// peerBookTestNode returns the peer ID and a signed ENR of a new node listening on the given TCP port.
+func peerBookTestNode(t *testing.T, port int) (peer.ID, *enr.Record) {
+	key, err := gethCrypto.GenerateKey()
+	require.NoError(t, err)
+	db, err := enode.OpenDB("")
+	require.NoError(t, err)
+	localNode := enode.NewLocalNode(db, key)
+	localNode.Set(enr.IPv4(net.ParseIP("213.202.254.180")))
+	localNode.Set(enr.TCP(port))
+	pid, err := peer.IDFromPublicKey(convertToInterfacePubkey(&key.PublicKey))
+	require.NoError(t, err)
+	return pid, localNode.Node().Record()
+}
@@ -0,0 +1,170 @@
+package p2p
+
+import (
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr-net"
+	"github.com/pkg/errors"
+)
+
+// PeerGroup is a named group of trusted peers, such as the sentry nodes of a beacon node. Trusted
+// peers are exempt from pruning and bad peer scoring, are kept connected like static peers, and
+// their connections are held in slots reserved for their group which do not count against the
+// peer limit.
+type PeerGroup struct {
+	Name string `yaml:"name"`
+	// Peers of the group, as multiaddrs including the peer ID or as ENRs.
+	Peers []string `yaml:"peers"`
+	// InboundSlots and OutboundSlots are the number of inbound and outbound connections reserved
+	// for the peers of the group, connections beyond them are refused. When unset, a slot is
+	// reserved for every peer of the group.
+	InboundSlots  int `yaml:"inbound_slots"`
+	OutboundSlots int `yaml:"outbound_slots"`
+}
+
+// trustedPeerGroup is a trusted peer group along with the address info of its peers.
+type trustedPeerGroup struct {
+	PeerGroup
+	members []peer.AddrInfo
+}
+
+// configureTrustedPeers validates the trusted peer groups of the config and marks their peers
+// as trusted in the peer status.
+func (s *Service) configureTrustedPeers() error {
+	s.trustedPeers = make(map[peer.ID]*trustedPeerGroup)
+	s.trustedPeerGroups = make([]*trustedPeerGroup, 0, len(s.cfg.TrustedPeerGroups))
+	for _, cfgGroup := range s.cfg.TrustedPeerGroups {
+		if cfgGroup.Name == "" {
+			return errors.New("trusted peer group has no name")
+		}
+		for _, other := range s.trustedPeerGroups {
+			if other.Name == cfgGroup.Name {
+				return errors.Errorf("trusted peer group %s is defined more than once", cfgGroup.Name)
+			}
+		}
+		if cfgGroup.InboundSlots < 0 || cfgGroup.OutboundSlots < 0 {
+			return errors.Errorf("trusted peer group %s has a negative number of slots", cfgGroup.Name)
+		}
+		// Peers are parsed one by one, as invalid addresses are otherwise skipped.
+		var addrs []ma.Multiaddr
+		for _, p := range cfgGroup.Peers {
+			addr, err := peersFromStringAddrs([]string{p})
+			if err != nil {
+				return errors.Wrapf(err, "could not parse the peers of trusted peer group %s", cfgGroup.Name)
+			}
+			if len(addr) == 0 {
+				return errors.Errorf("could not parse the peers of trusted peer group %s: invalid address %q", cfgGroup.Name, p)
+			}
+			addrs = append(addrs, addr...)
+		}
+		members, err := peer.AddrInfosFromP2pAddrs(addrs...)
+		if err != nil {
+			return errors.Wrapf(err, "could not parse the peers of trusted peer group %s", cfgGroup.Name)
+		}
+		group := &trustedPeerGroup{PeerGroup: *cfgGroup, members: members}
+		if group.InboundSlots == 0 {
+			group.InboundSlots = len(members)
+		}
+		if group.OutboundSlots == 0 {
+			group.OutboundSlots = len(members)
+		}
+		for _, info := range members {
+			if other, ok := s.trustedPeers[info.ID]; ok {
+				return errors.Errorf("peer %s is in trusted peer groups %s and %s", info.ID, other.Name, group.Name)
+			}
+			s.trustedPeers[info.ID] = group
+			var address ma.Multiaddr
+			if len(info.Addrs) > 0 {
+				address = info.Addrs[0]
+			}
+			s.peers.Add(nil /* ENR */, info.ID, address, network.DirUnknown)
+			s.peers.SetTrustedGroup(info.ID, group.Name)
+		}
+		s.trustedPeerGroups = append(s.trustedPeerGroups, group)
+	}
+	return nil
+}
+
+// hasTrustedSlot returns whether a slot reserved for the trusted peer group of the peer is
+// available for a connection with the peer in the given direction.
+func (s *Service) hasTrustedSlot(pid peer.ID, direction network.Direction) bool {
+	group, ok := s.trustedPeers[pid]
+	if !ok {
+		return false
+	}
+	slots := group.InboundSlots
+	if direction == network.DirOutbound {
+		slots = group.OutboundSlots
+	}
+	connected := 0
+	for _, info := range group.members {
+		// The slot already held by the peer is available to it.
+		if info.ID == pid {
+			continue
+		}
+		for _, conn := range s.host.Network().ConnsToPeer(info.ID) {
+			if conn.Stat().Direction == direction {
+				connected++
+				break
+			}
+		}
+	}
+	return connected < slots
+}
+
+// numTrustedPeers returns the number of trusted peers among the given peers.
+func (s *Service) numTrustedPeers(pids []peer.ID) int {
+	if len(s.trustedPeers) == 0 {
+		return 0
+	}
+	n := 0
+	for _, pid := range pids {
+		if _, ok := s.trustedPeers[pid]; ok {
+			n++
+		}
+	}
+	return n
+}
+
+// isTrustedAddr returns whether the multiaddr has the IP address of a trusted peer. Inbound
+// connections are accepted before the peer ID is known, so the IP address is all there is to
+// tell a trusted peer apart at that point.
+func (s *Service) isTrustedAddr(addr ma.Multiaddr) bool {
+	if len(s.trustedPeers) == 0 {
+		return false
+	}
+	ip, err := manet.ToIP(addr)
+	if err != nil {
+		return false
+	}
+	for _, group := range s.trustedPeerGroups {
+		for _, info := range group.members {
+			for _, memberAddr := range info.Addrs {
+				if memberIP, err := manet.ToIP(memberAddr); err == nil && memberIP.Equal(ip) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// ensureTrustedPeerConnections dials the trusted peers we are not connected to, as long as
+// their group has outbound slots available.
+func (s *Service) ensureTrustedPeerConnections() {
+	for _, group := range s.trustedPeerGroups {
+		for i := range group.members {
+			info := group.members[i]
+			if s.host.Network().Connectedness(info.ID) == network.Connected {
+				continue
+			}
+			if !s.hasTrustedSlot(info.ID, network.DirOutbound) {
+				break
+			}
+			if err := connectWithTimeout(s.ctx, s.host, &info); err != nil {
+				log.WithField("peer", info.ID).WithField("group", group.Name).WithError(err).Debug("Failed to connect to trusted peer")
+			}
+		}
+	}
+}
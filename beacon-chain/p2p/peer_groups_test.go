@@ -0,0 +1,139 @@
+package p2p
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"testing"
+
+	"github.com/kevinms/leakybucket-go"
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_ConfigureTrustedPeers(t *testing.T) {
+	_, pkey := createAddrAndPrivKey(t)
+	pid, err := peer.IDFromPublicKey(convertToInterfacePubkey(&pkey.PublicKey))
+	require.NoError(t, err)
+	sentry := fmt.Sprintf("/ip4/10.0.0.1/tcp/13000/p2p/%s", pid)
+
+	tests := []struct {
+		name   string
+		groups []*PeerGroup
+		errMsg string
+	}{
+		{
+			name:   "no name",
+			groups: []*PeerGroup{{Peers: []string{sentry}}},
+			errMsg: "trusted peer group has no name",
+		},
+		{
+			name:   "duplicate name",
+			groups: []*PeerGroup{{Name: "sentries"}, {Name: "sentries"}},
+			errMsg: "trusted peer group sentries is defined more than once",
+		},
+		{
+			name:   "negative slots",
+			groups: []*PeerGroup{{Name: "sentries", Peers: []string{sentry}, InboundSlots: -1}},
+			errMsg: "trusted peer group sentries has a negative number of slots",
+		},
+		{
+			name:   "peer without ID",
+			groups: []*PeerGroup{{Name: "sentries", Peers: []string{"/ip4/10.0.0.1/tcp/13000"}}},
+			errMsg: "could not parse the peers of trusted peer group sentries",
+		},
+		{
+			name:   "peer in two groups",
+			groups: []*PeerGroup{{Name: "sentries", Peers: []string{sentry}}, {Name: "others", Peers: []string{sentry}}},
+			errMsg: fmt.Sprintf("peer %s is in trusted peer groups sentries and others", pid),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{
+				cfg: &Config{TrustedPeerGroups: tt.groups},
+				peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+					ScorerParams: &scorers.Config{},
+				}),
+			}
+			assert.ErrorContains(t, tt.errMsg, s.configureTrustedPeers())
+		})
+	}
+
+	s := &Service{
+		cfg: &Config{TrustedPeerGroups: []*PeerGroup{{Name: "sentries", Peers: []string{sentry}, OutboundSlots: 2}}},
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			ScorerParams: &scorers.Config{},
+		}),
+	}
+	require.NoError(t, s.configureTrustedPeers())
+	require.Equal(t, 1, len(s.trustedPeerGroups))
+	assert.Equal(t, 1, s.trustedPeerGroups[0].InboundSlots, "Expected a slot to be reserved for every peer by default")
+	assert.Equal(t, 2, s.trustedPeerGroups[0].OutboundSlots)
+	assert.Equal(t, "sentries", s.peers.TrustedGroup(pid))
+	address, err := s.peers.Address(pid)
+	require.NoError(t, err)
+	assert.Equal(t, "/ip4/10.0.0.1/tcp/13000", address.String())
+	assert.Equal(t, 0, s.cfg.TrustedPeerGroups[0].InboundSlots, "Expected the config to be left untouched")
+}
+
+func TestPeer_TrustedPeerAtMaxLimit(t *testing.T) {
+	ipAddr, pkey := createAddrAndPrivKey(t)
+	listen, err := multiaddr.NewMultiaddr(fmt.Sprintf("/ip4/%s/tcp/%d", ipAddr, 2010))
+	require.NoError(t, err)
+
+	newHost := func(port int) (host.Host, *ecdsa.PrivateKey) {
+		_, key := createAddrAndPrivKey(t)
+		hostListen, err := multiaddr.NewMultiaddr(fmt.Sprintf("/ip4/%s/tcp/%d", ipAddr, port))
+		require.NoError(t, err)
+		h, err := libp2p.New(context.Background(), []libp2p.Option{privKeyOption(key), libp2p.ListenAddrs(hostListen)}...)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			require.NoError(t, h.Close())
+		})
+		return h, key
+	}
+	sentry1, _ := newHost(3010)
+	sentry2, _ := newHost(3011)
+	stranger, _ := newHost(3012)
+
+	// Both sentries are trusted, but a single inbound slot is reserved for them.
+	s := &Service{
+		ipLimiter: leakybucket.NewCollector(ipLimit, ipBurst, false),
+		cfg: &Config{
+			MaxPeers: 0,
+			TrustedPeerGroups: []*PeerGroup{{
+				Name: "sentries",
+				Peers: []string{
+					fmt.Sprintf("/ip4/%s/tcp/%d/p2p/%s", ipAddr, 3010, sentry1.ID()),
+					fmt.Sprintf("/ip4/%s/tcp/%d/p2p/%s", ipAddr, 3011, sentry2.ID()),
+				},
+				InboundSlots: 1,
+			}},
+		},
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			ScorerParams: &scorers.Config{},
+		}),
+	}
+	require.NoError(t, s.configureTrustedPeers())
+	s.addrFilter, err = configureFilter(&Config{})
+	require.NoError(t, err)
+	h1, err := libp2p.New(context.Background(), []libp2p.Option{privKeyOption(pkey), libp2p.ListenAddrs(listen), libp2p.ConnectionGater(s)}...)
+	require.NoError(t, err)
+	s.host = h1
+	defer func() {
+		require.NoError(t, h1.Close())
+	}()
+	addrInfo := peer.AddrInfo{ID: h1.ID(), Addrs: []multiaddr.Multiaddr{listen}}
+
+	require.NoError(t, sentry1.Connect(context.Background(), addrInfo), "Wanted trusted peer to connect at max peer")
+	assert.NotNil(t, stranger.Connect(context.Background(), addrInfo), "Wanted connection to fail with max peer")
+	assert.NotNil(t, sentry2.Connect(context.Background(), addrInfo), "Wanted connection to fail with no trusted slot available")
+	assert.Equal(t, 1, len(h1.Network().Peers()))
+}
@@ -0,0 +1,203 @@
+package peers
+
+import (
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/peerdata"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+// BadPeerBanPeriod is how long a peer found bad by the scorers when the peer book is saved
+// stays banned after a restart.
+const BadPeerBanPeriod = time.Hour
+
+// PeerBookEntry is the record of a known peer kept across restarts of the node.
+type PeerBookEntry struct {
+	ID string `json:"id"`
+	// ENR of the peer, in its textual representation.
+	ENR string `json:"enr,omitempty"`
+	// Addresses the peer was dialed on, addresses of inbound peers are not dialable.
+	Addresses []string  `json:"addresses,omitempty"`
+	LastSeen  time.Time `json:"last_seen"`
+	// Score of the peer across all scorers, and the scorers data it is computed from.
+	Score           float64 `json:"score"`
+	BadResponses    int     `json:"bad_responses,omitempty"`
+	ProcessedBlocks uint64  `json:"processed_blocks,omitempty"`
+	// BannedUntil is the time until which the peer is refused, nil if it is not banned.
+	BannedUntil *time.Time `json:"banned_until,omitempty"`
+}
+
+// Banned returns whether the peer of the entry is still banned at the given time.
+func (e *PeerBookEntry) Banned(now time.Time) bool {
+	return e.BannedUntil != nil && e.BannedUntil.After(now)
+}
+
+// IsBanned returns whether the peer is banned, banned peers are considered bad until their ban expires.
+func (p *Status) IsBanned(pid peer.ID) bool {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	if peerData, ok := p.store.PeerData(pid); ok {
+		return isBanned(peerData)
+	}
+	return false
+}
+
+// isBanned is a lock-free version of IsBanned.
+func isBanned(peerData *peerdata.PeerData) bool {
+	return peerData.BannedUntil.After(timeutils.Now())
+}
+
+// Ban bans the peer until the given time, replacing any previous ban of the peer. Bans apply to
+// trusted peers as well.
+func (p *Status) Ban(pid peer.ID, until time.Time) {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	peerData := p.store.PeerDataGetOrCreate(pid)
+	peerData.BannedUntil = until
+}
+
+// Unban lifts the ban of the peer and clears its bad responses, so that the peer is no longer
+// considered bad. It returns false if the peer is not banned.
+func (p *Status) Unban(pid peer.ID) bool {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	peerData, ok := p.store.PeerData(pid)
+	if !ok || !isBanned(peerData) {
+		return false
+	}
+	peerData.BannedUntil = time.Time{}
+	peerData.BadResponses = 0
+	return true
+}
+
+// Bans returns the peers currently banned along with the expiry of their ban.
+func (p *Status) Bans() map[peer.ID]time.Time {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	bans := make(map[peer.ID]time.Time)
+	for pid, peerData := range p.store.Peers() {
+		if isBanned(peerData) {
+			bans[pid] = peerData.BannedUntil
+		}
+	}
+	return bans
+}
+
+// PeerBook returns the entries of the peers worth keeping across a restart, sorted from the best
+// peer to the worst one. Peers seen within maxAge are kept, up to maxEntries of them, as well as
+// up to maxEntries banned peers. Peers found bad by the scorers are banned for BadPeerBanPeriod.
+func (p *Status) PeerBook(maxEntries int, maxAge time.Duration) []*PeerBookEntry {
+	now := timeutils.Now()
+	p.store.RLock()
+	entries := make(map[peer.ID]*PeerBookEntry, len(p.store.Peers()))
+	trusted := make(map[peer.ID]bool)
+	for pid, peerData := range p.store.Peers() {
+		trusted[pid] = isTrusted(peerData)
+		lastSeen := peerData.LastSeen
+		if peerData.ConnState == PeerConnected {
+			lastSeen = now
+		}
+		entry := &PeerBookEntry{
+			ID:              pid.String(),
+			LastSeen:        lastSeen,
+			BadResponses:    peerData.BadResponses,
+			ProcessedBlocks: peerData.ProcessedBlocks,
+		}
+		if isBanned(peerData) {
+			bannedUntil := peerData.BannedUntil
+			entry.BannedUntil = &bannedUntil
+		}
+		if peerData.Enr != nil {
+			if node, err := enode.New(enode.ValidSchemes, peerData.Enr); err == nil {
+				entry.ENR = node.String()
+			}
+		}
+		if peerData.Address != nil && peerData.Direction == network.DirOutbound {
+			entry.Addresses = []string{peerData.Address.String()}
+		}
+		entries[pid] = entry
+	}
+	p.store.RUnlock()
+
+	var goodPeers, bannedPeers []*PeerBookEntry
+	for pid, entry := range entries {
+		if !entry.Banned(now) && !trusted[pid] && p.scorers.IsBadPeer(pid) {
+			bannedUntil := now.Add(BadPeerBanPeriod)
+			entry.BannedUntil = &bannedUntil
+		}
+		if entry.Banned(now) {
+			bannedPeers = append(bannedPeers, entry)
+			continue
+		}
+		entry.BannedUntil = nil
+		// Peers we never connected to, or not for too long, are left to discovery.
+		if entry.LastSeen.IsZero() || now.Sub(entry.LastSeen) > maxAge {
+			continue
+		}
+		if entry.ENR == "" && len(entry.Addresses) == 0 {
+			continue
+		}
+		entry.Score = p.scorers.Score(pid)
+		goodPeers = append(goodPeers, entry)
+	}
+	sort.Slice(goodPeers, func(i, j int) bool {
+		if goodPeers[i].Score != goodPeers[j].Score {
+			return goodPeers[i].Score > goodPeers[j].Score
+		}
+		return goodPeers[i].LastSeen.After(goodPeers[j].LastSeen)
+	})
+	sort.Slice(bannedPeers, func(i, j int) bool {
+		return bannedPeers[i].BannedUntil.After(*bannedPeers[j].BannedUntil)
+	})
+	if len(goodPeers) > maxEntries {
+		goodPeers = goodPeers[:maxEntries]
+	}
+	if len(bannedPeers) > maxEntries {
+		bannedPeers = bannedPeers[:maxEntries]
+	}
+	return append(goodPeers, bannedPeers...)
+}
+
+// RestorePeerBook adds the peers of a saved peer book as disconnected peers, along with their
+// scorers data and bans. Entries with an invalid peer ID and expired bans are ignored.
+func (p *Status) RestorePeerBook(entries []*PeerBookEntry) {
+	now := timeutils.Now()
+	for _, entry := range entries {
+		pid, err := peer.Decode(entry.ID)
+		if err != nil {
+			continue
+		}
+		var address ma.Multiaddr
+		for _, addr := range entry.Addresses {
+			if address, err = ma.NewMultiaddr(addr); err == nil {
+				break
+			}
+		}
+		var record *enr.Record
+		if node, err := enode.Parse(enode.ValidSchemes, entry.ENR); err == nil {
+			record = node.Record()
+		}
+		p.Add(record, pid, address, network.DirOutbound)
+
+		p.store.Lock()
+		peerData := p.store.PeerDataGetOrCreate(pid)
+		peerData.LastSeen = entry.LastSeen
+		peerData.BadResponses = entry.BadResponses
+		peerData.ProcessedBlocks = entry.ProcessedBlocks
+		peerData.BlockProviderUpdated = entry.LastSeen
+		if entry.Banned(now) {
+			peerData.BannedUntil = *entry.BannedUntil
+		}
+		p.store.Unlock()
+	}
+}
@@ -0,0 +1,163 @@
+package peers_test
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/peerdata"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStatus_PeerBook(t *testing.T) {
+	maxBadResponses := 2
+	newStatus := func() *peers.Status {
+		return peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit: 30,
+			ScorerParams: &scorers.Config{
+				BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+					Threshold: maxBadResponses,
+				},
+			},
+		})
+	}
+	p := newStatus()
+	address, err := ma.NewMultiaddr("/ip4/213.202.254.180/tcp/13000")
+	require.NoError(t, err)
+
+	good := addPeerBookPeer(t, p, address, network.DirOutbound, peers.PeerConnected)
+	lousy := addPeerBookPeer(t, p, address, network.DirOutbound, peers.PeerConnected)
+	p.Scorers().BadResponsesScorer().Increment(lousy)
+	bad := addPeerBookPeer(t, p, address, network.DirOutbound, peers.PeerConnected)
+	for i := 0; i < maxBadResponses; i++ {
+		p.Scorers().BadResponsesScorer().Increment(bad)
+	}
+	inbound := addPeerBookPeer(t, p, address, network.DirInbound, peers.PeerConnected)
+	// Peers we never connected to are left to discovery.
+	addPeerBookPeer(t, p, address, network.DirOutbound, peers.PeerDisconnected)
+
+	entries := p.PeerBook(10, time.Hour)
+	require.Equal(t, 3, len(entries))
+	assert.Equal(t, good.String(), entries[0].ID)
+	assert.DeepEqual(t, []string{address.String()}, entries[0].Addresses)
+	assert.Equal(t, lousy.String(), entries[1].ID)
+	assert.Equal(t, 1, entries[1].BadResponses)
+	assert.Equal(t, bad.String(), entries[2].ID)
+	assert.Equal(t, true, entries[2].Banned(time.Now()), "Expected bad peer to be banned")
+	enc, err := json.Marshal(entries[0])
+	require.NoError(t, err)
+	assert.Equal(t, false, strings.Contains(string(enc), "banned_until"), "Expected no ban in entry of good peer")
+	for _, entry := range entries {
+		assert.NotEqual(t, inbound.String(), entry.ID, "Expected peer without dialable address to be left out")
+	}
+
+	// The number of good peers is bounded, banned peers are always kept.
+	entries = p.PeerBook(1, time.Hour)
+	require.Equal(t, 2, len(entries))
+	assert.Equal(t, good.String(), entries[0].ID)
+	assert.Equal(t, bad.String(), entries[1].ID)
+
+	restored := newStatus()
+	restored.RestorePeerBook(p.PeerBook(10, time.Hour))
+	assert.Equal(t, 3, len(restored.All()))
+	assert.Equal(t, false, restored.IsBad(good))
+	assert.Equal(t, false, restored.IsBad(lousy))
+	count, err := restored.Scorers().BadResponsesScorer().Count(lousy)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count, "Expected scoring history to be restored")
+	assert.Equal(t, true, restored.IsBanned(bad), "Expected ban to be restored")
+	assert.Equal(t, true, restored.IsBad(bad))
+	assert.DeepEqual(t, []peer.ID{bad}, restored.Bad())
+	state, err := restored.ConnectionState(good)
+	require.NoError(t, err)
+	assert.Equal(t, peers.PeerDisconnected, state)
+}
+
+func TestStatus_PeerBook_ExpiresStalePeers(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+	})
+	address, err := ma.NewMultiaddr("/ip4/213.202.254.180/tcp/13000")
+	require.NoError(t, err)
+	pid := addPeerBookPeer(t, p, address, network.DirOutbound, peers.PeerConnected)
+	p.SetConnectionState(pid, peers.PeerDisconnected)
+
+	require.Equal(t, 1, len(p.PeerBook(10, time.Hour)))
+	assert.Equal(t, 0, len(p.PeerBook(10, 0)), "Expected stale peer to be expired")
+	expired := time.Now().Add(-time.Minute)
+	entries := []*peers.PeerBookEntry{{
+		ID:          pid.String(),
+		LastSeen:    time.Now().Add(-time.Hour),
+		BannedUntil: &expired,
+	}}
+	restored := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit:    30,
+		ScorerParams: &scorers.Config{},
+	})
+	restored.RestorePeerBook(entries)
+	assert.Equal(t, false, restored.IsBanned(pid), "Expected expired ban to be ignored")
+}
+
+func TestStatus_BanAndUnban(t *testing.T) {
+	maxBadResponses := 2
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold: maxBadResponses,
+			},
+		},
+	})
+	address, err := ma.NewMultiaddr("/ip4/213.202.254.180/tcp/13000")
+	require.NoError(t, err)
+
+	bad := addPeerBookPeer(t, p, address, network.DirOutbound, peers.PeerConnected)
+	for i := 0; i < maxBadResponses; i++ {
+		p.Scorers().BadResponsesScorer().Increment(bad)
+	}
+	trusted := addPeerBookPeer(t, p, address, network.DirOutbound, peers.PeerConnected)
+	p.SetTrustedGroup(trusted, "sentries")
+	assert.Equal(t, false, p.Unban(bad), "Expected peer which is not banned to not be unbanned")
+
+	until := time.Now().Add(time.Hour)
+	p.Ban(bad, until)
+	p.Ban(trusted, until)
+	assert.Equal(t, true, p.IsBad(trusted), "Expected banned trusted peer to be bad")
+	assert.Equal(t, 2, len(p.Bad()))
+	bans := p.Bans()
+	assert.Equal(t, 2, len(bans))
+	assert.Equal(t, true, bans[bad].Equal(until))
+
+	// Lifting the ban also forgives the bad responses of the peer.
+	assert.Equal(t, true, p.Unban(bad))
+	assert.Equal(t, true, p.Unban(trusted))
+	assert.Equal(t, false, p.IsBad(bad))
+	assert.Equal(t, false, p.IsBad(trusted))
+	assert.Equal(t, 0, len(p.Bans()))
+
+	p.Ban(bad, time.Now().Add(-time.Second))
+	assert.Equal(t, false, p.IsBanned(bad), "Expected expired ban to be ignored")
+	assert.Equal(t, 0, len(p.Bans()))
+}
+
+// addPeerBookPeer adds a peer with a valid peer ID, as peer IDs are encoded in the peer book.
+func addPeerBookPeer(t *testing.T, p *peers.Status, address ma.Multiaddr, direction network.Direction, state peerdata.PeerConnectionState) peer.ID {
+	key, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+	require.NoError(t, err)
+	pid, err := peer.IDFromPrivateKey(key)
+	require.NoError(t, err)
+	p.Add(nil, pid, address, direction)
+	p.SetConnectionState(pid, state)
+	return pid
+}
@@ -47,6 +47,9 @@ type PeerData struct {
 	ConnState     PeerConnectionState
 	Enr           *enr.Record
 	NextValidTime time.Time
+	LastSeen      time.Time
+	BannedUntil   time.Time
+	TrustedGroup  string
 	// Chain related data.
 	MetaData                  *pb.MetaData
 	ChainState                *pb.Status
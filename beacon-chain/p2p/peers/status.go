@@ -252,6 +252,9 @@ func (p *Status) SetConnectionState(pid peer.ID, state peerdata.PeerConnectionSt
 	defer p.store.Unlock()
 
 	peerData := p.store.PeerDataGetOrCreate(pid)
+	if state == PeerConnected || peerData.ConnState == PeerConnected {
+		peerData.LastSeen = timeutils.Now()
+	}
 	peerData.ConnState = state
 }
 
@@ -281,7 +284,14 @@ func (p *Status) ChainStateLastUpdated(pid peer.ID) (time.Time, error) {
 
 // IsBad states if the peer is to be considered bad (by *any* of the registered scorers).
 // If the peer is unknown this will return `false`, which makes using this function easier than returning an error.
+// Trusted peers are only considered bad once banned.
 func (p *Status) IsBad(pid peer.ID) bool {
+	if p.IsBanned(pid) {
+		return true
+	}
+	if p.IsTrusted(pid) {
+		return false
+	}
 	return p.isfromBadIP(pid) || p.scorers.IsBadPeer(pid)
 }
 
@@ -404,7 +414,21 @@ func (p *Status) Inactive() []peer.ID {
 
 // Bad returns the peers that are bad.
 func (p *Status) Bad() []peer.ID {
-	return p.scorers.BadResponsesScorer().BadPeers()
+	scoredBadPeers := p.scorers.BadResponsesScorer().BadPeers()
+	p.store.RLock()
+	defer p.store.RUnlock()
+	badPeers := make([]peer.ID, 0, len(scoredBadPeers))
+	for _, pid := range scoredBadPeers {
+		if peerData, ok := p.store.PeerData(pid); !ok || !isTrusted(peerData) {
+			badPeers = append(badPeers, pid)
+		}
+	}
+	for pid, peerData := range p.store.Peers() {
+		if isBanned(peerData) && (isTrusted(peerData) || peerData.BadResponses < p.scorers.BadResponsesScorer().Params().Threshold) {
+			badPeers = append(badPeers, pid)
+		}
+	}
+	return badPeers
 }
 
 // All returns all the peers regardless of state.
@@ -429,16 +453,16 @@ func (p *Status) Prune() {
 	}
 
 	notBadPeer := func(peerData *peerdata.PeerData) bool {
-		return peerData.BadResponses < p.scorers.BadResponsesScorer().Params().Threshold
+		return peerData.BadResponses < p.scorers.BadResponsesScorer().Params().Threshold && !isBanned(peerData)
 	}
 	type peerResp struct {
 		pid     peer.ID
 		badResp int
 	}
 	peersToPrune := make([]*peerResp, 0)
-	// Select disconnected peers with a smaller bad response count.
+	// Select disconnected peers with a smaller bad response count, trusted peers are never pruned.
 	for pid, peerData := range p.store.Peers() {
-		if peerData.ConnState == PeerDisconnected && notBadPeer(peerData) {
+		if peerData.ConnState == PeerDisconnected && notBadPeer(peerData) && !isTrusted(peerData) {
 			peersToPrune = append(peersToPrune, &peerResp{
 				pid:     pid,
 				badResp: peerData.BadResponses,
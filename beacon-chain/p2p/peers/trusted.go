@@ -0,0 +1,44 @@
+package peers
+
+import (
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/peerdata"
+)
+
+// SetTrustedGroup marks the peer as a member of the given trusted peer group. Trusted peers are
+// never considered bad by the scorers and are never pruned. An empty group removes the trust.
+func (p *Status) SetTrustedGroup(pid peer.ID, group string) {
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	peerData := p.store.PeerDataGetOrCreate(pid)
+	peerData.TrustedGroup = group
+}
+
+// TrustedGroup returns the name of the trusted peer group of the peer, or an empty string if
+// the peer is not trusted.
+func (p *Status) TrustedGroup(pid peer.ID) string {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	if peerData, ok := p.store.PeerData(pid); ok {
+		return peerData.TrustedGroup
+	}
+	return ""
+}
+
+// IsTrusted returns whether the peer belongs to a trusted peer group.
+func (p *Status) IsTrusted(pid peer.ID) bool {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	if peerData, ok := p.store.PeerData(pid); ok {
+		return isTrusted(peerData)
+	}
+	return false
+}
+
+// isTrusted is a lock-free version of IsTrusted.
+func isTrusted(peerData *peerdata.PeerData) bool {
+	return peerData.TrustedGroup != ""
+}
@@ -0,0 +1,51 @@
+package peers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+)
+
+func TestStatus_TrustedPeers(t *testing.T) {
+	maxBadResponses := 2
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold: maxBadResponses,
+			},
+		},
+	})
+	trusted := addPeer(t, p, peers.PeerDisconnected)
+	p.SetTrustedGroup(trusted, "sentries")
+	bad := addPeer(t, p, peers.PeerDisconnected)
+	for i := 0; i < maxBadResponses; i++ {
+		p.Scorers().BadResponsesScorer().Increment(trusted)
+		p.Scorers().BadResponsesScorer().Increment(bad)
+	}
+	assert.Equal(t, true, p.IsTrusted(trusted))
+	assert.Equal(t, "sentries", p.TrustedGroup(trusted))
+	assert.Equal(t, false, p.IsTrusted(bad))
+	assert.Equal(t, "", p.TrustedGroup(bad))
+
+	// Trusted peers are exempt from bad peer scoring.
+	assert.Equal(t, false, p.IsBad(trusted), "Expected trusted peer not to be bad")
+	assert.Equal(t, true, p.IsBad(bad))
+	for _, pid := range p.Bad() {
+		assert.NotEqual(t, trusted, pid, "Expected trusted peer not to be listed as bad")
+	}
+
+	// Trusted peers are never pruned.
+	for i := 0; i < p.MaxPeerLimit()+10; i++ {
+		_ = addPeer(t, p, peers.PeerDisconnected)
+	}
+	p.Prune()
+	assert.Equal(t, p.MaxPeerLimit(), len(p.All()))
+	assert.Equal(t, true, p.IsTrusted(trusted), "Expected trusted peer to be kept")
+
+	p.SetTrustedGroup(trusted, "")
+	assert.Equal(t, true, p.IsBad(trusted), "Expected peer to be bad once no longer trusted")
+}
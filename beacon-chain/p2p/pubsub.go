@@ -84,11 +84,20 @@ func (s *Service) SubscribeToTopic(topic string, opts ...pubsub.SubOpt) (*pubsub
 	return topicHandle.Subscribe(opts...)
 }
 
-// peerInspector will scrape all the relevant scoring data and add it to our
-// peer handler.
-// TODO(#6043): Add hooks to add in peer inspector to our global peer handler.
+// peerInspector exports the gossipsub score of each peer broken down by component. Peers
+// which are no longer scored are removed from the metrics.
 func (s *Service) peerInspector(peerMap map[peer.ID]*pubsub.PeerScoreSnapshot) {
-	// no-op
+	gossipPeerScore.Reset()
+	for pid, snapshot := range peerMap {
+		id := pid.String()
+		components := s.gossipScoreComponents(pid)
+		gossipPeerScore.WithLabelValues(id, "bad_responses").Set(components.badResponses)
+		gossipPeerScore.WithLabelValues(id, "peer_status").Set(components.peerStatus)
+		gossipPeerScore.WithLabelValues(id, "block_provider").Set(components.blockProvider)
+		// The application-specific weight is 1, the remainder is scored by gossipsub itself.
+		gossipPeerScore.WithLabelValues(id, "gossipsub").Set(snapshot.Score - snapshot.AppSpecificScore)
+		gossipPeerScore.WithLabelValues(id, "total").Set(snapshot.Score)
+	}
 }
 
 // Content addressable ID function.
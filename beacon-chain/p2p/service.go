@@ -88,6 +88,11 @@ type Service struct {
 	host                  host.Host
 	genesisTime           time.Time
 	genesisValidatorsRoot []byte
+	peerBook              []*peers.PeerBookEntry
+	trustedPeers          map[peer.ID]*trustedPeerGroup
+	trustedPeerGroups     []*trustedPeerGroup
+	cidrBans              map[string]*cidrBan
+	cidrBansLock          sync.RWMutex
 }
 
 // NewService initializes a new p2p service compatible with shared.Service interface. No
@@ -147,6 +152,25 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 
 	s.host = h
 
+	// Peer scorers are fed into the gossipsub peer score, so they are
+	// initialized before gossipsub.
+	s.peers = peers.NewStatus(ctx, &peers.StatusConfig{
+		PeerLimit: int(s.cfg.MaxPeers),
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold:     maxBadResponses,
+				DecayInterval: time.Hour,
+			},
+		},
+	})
+	if err := s.loadPeerBook(); err != nil {
+		log.WithError(err).Error("Could not load peer book")
+	}
+	if err := s.configureTrustedPeers(); err != nil {
+		log.WithError(err).Error("Failed to configure trusted peer groups")
+		return nil, err
+	}
+
 	// Gossipsub registration is done before we add in any new peers
 	// due to libp2p's gossipsub implementation not taking into
 	// account previously added peers when creating the gossipsub
@@ -162,7 +186,7 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 	if featureconfig.Get().EnablePeerScorer {
 		psOpts = append(
 			psOpts,
-			pubsub.WithPeerScore(peerScoringParams()),
+			pubsub.WithPeerScore(peerScoringParams(s.appSpecificScore)),
 			pubsub.WithPeerScoreInspect(s.peerInspector, time.Minute))
 	}
 	// Set the pubsub global parameters that we require.
@@ -175,16 +199,6 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 	}
 	s.pubsub = gs
 
-	s.peers = peers.NewStatus(ctx, &peers.StatusConfig{
-		PeerLimit: int(s.cfg.MaxPeers),
-		ScorerParams: &scorers.Config{
-			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
-				Threshold:     maxBadResponses,
-				DecayInterval: time.Hour,
-			},
-		},
-	})
-
 	return s, nil
 }
 
@@ -208,6 +222,9 @@ func (s *Service) Start() {
 		}
 	}
 
+	// Known good peers from the previous run are dialed before discovery kicks in.
+	s.connectWithPeerBook()
+
 	if !s.cfg.NoDiscovery && !s.cfg.DisableDiscv5 {
 		ipAddr := ipAddr()
 		listener, err := s.startDiscoveryV5(
@@ -238,12 +255,15 @@ func (s *Service) Start() {
 		}
 		s.connectWithAllPeers(addrs)
 	}
+	go s.ensureTrustedPeerConnections()
 
 	// Periodic functions.
 	runutil.RunEvery(s.ctx, params.BeaconNetworkConfig().TtfbTimeout, func() {
 		ensurePeerConnections(s.ctx, s.host, peersToWatch...)
+		s.ensureTrustedPeerConnections()
 	})
 	runutil.RunEvery(s.ctx, 30*time.Minute, s.Peers().Prune)
+	runutil.RunEvery(s.ctx, peerBookSaveInterval, s.savePeerBook)
 	runutil.RunEvery(s.ctx, params.BeaconNetworkConfig().RespTimeout, s.updateMetrics)
 	runutil.RunEvery(s.ctx, refreshRate, func() {
 		s.RefreshENR()
@@ -270,6 +290,7 @@ func (s *Service) Start() {
 func (s *Service) Stop() error {
 	defer s.cancel()
 	s.started = false
+	s.savePeerBook()
 	if s.dv5Listener != nil {
 		s.dv5Listener.Close()
 	}
@@ -346,6 +367,18 @@ func (s *Service) ENR() *enr.Record {
 	return s.dv5Listener.Self().Record()
 }
 
+// DiscoveryAddresses represents our enr addresses as multiaddresses.
+func (s *Service) DiscoveryAddresses() ([]ma.Multiaddr, error) {
+	if s.dv5Listener == nil {
+		return nil, nil
+	}
+	addr, err := convertToUdpMultiAddr(s.dv5Listener.Self())
+	if err != nil {
+		return nil, err
+	}
+	return []ma.Multiaddr{addr}, nil
+}
+
 // Metadata returns a copy of the peer's metadata.
 func (s *Service) Metadata() *pb.MetaData {
 	return proto.Clone(s.metaData).(*pb.MetaData)
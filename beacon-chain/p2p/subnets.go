@@ -7,21 +7,29 @@ import (
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/p2p/enr"
 	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/prysmaticlabs/go-bitfield"
 	"go.opencensus.io/trace"
 
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
 )
 
 var attestationSubnetCount = params.BeaconNetworkConfig().AttestationSubnetCount
 
 var attSubnetEnrKey = params.BeaconNetworkConfig().AttSubnetKey
 
+// maxSubnetLookups is the maximum number of network lookups performed when
+// searching for the peers of a subnet.
+const maxSubnetLookups = 3
+
 // FindPeersWithSubnet performs a network search for peers
 // subscribed to a particular subnet. Then we try to connect
-// with those peers.
-func (s *Service) FindPeersWithSubnet(ctx context.Context, index uint64) (bool, error) {
+// with those peers, until we have at least threshold peers
+// subscribed to the subnet. It returns whether the threshold
+// was reached.
+func (s *Service) FindPeersWithSubnet(ctx context.Context, index uint64, threshold int) (bool, error) {
 	ctx, span := trace.StartSpan(ctx, "p2p.FindPeersWithSubnet")
 	defer span.End()
 
@@ -31,55 +39,63 @@ func (s *Service) FindPeersWithSubnet(ctx context.Context, index uint64) (bool,
 		// return if discovery isn't set
 		return false, nil
 	}
+	// Peers known to be subscribed to the subnet, from their metadata or from their ENR.
+	subscribed := make(map[peer.ID]bool)
+	for _, pid := range s.peers.SubscribedToSubnet(index) {
+		subscribed[pid] = true
+	}
 	iterator := s.dv5Listener.RandomNodes()
-	nodes := enode.ReadNodes(iterator, lookupLimit)
-	exists := false
-	for _, node := range nodes {
-		if err := ctx.Err(); err != nil {
-			return false, err
-		}
-		if node.IP() == nil {
-			continue
-		}
-		// do not look for nodes with no tcp port set
-		if err := node.Record().Load(enr.WithEntry("tcp", new(enr.TCP))); err != nil {
-			if !enr.IsNotFound(err) {
-				log.WithError(err).Debug("Could not retrieve tcp port")
+	defer iterator.Close()
+	for i := 0; i < maxSubnetLookups && len(subscribed) < threshold; i++ {
+		nodes := enode.ReadNodes(iterator, lookupLimit)
+		for _, node := range nodes {
+			if err := ctx.Err(); err != nil {
+				return false, err
 			}
-			continue
-		}
-		subnets, err := retrieveAttSubnets(node.Record())
-		if err != nil {
-			log.Debugf("could not retrieve subnets: %v", err)
-			continue
-		}
-		for _, comIdx := range subnets {
-			if comIdx == index {
-				info, multiAddr, err := convertToAddrInfo(node)
-				if err != nil {
-					return false, err
-				}
-				if s.peers.IsActive(info.ID) {
-					exists = true
-					continue
-				}
-				if s.host.Network().Connectedness(info.ID) == network.Connected {
-					exists = true
-					continue
-				}
-				if !s.peers.IsReadyToDial(info.ID) {
-					continue
-				}
-				s.peers.Add(node.Record(), info.ID, multiAddr, network.DirUnknown)
-				if err := s.connectWithPeer(ctx, *info); err != nil {
-					log.WithError(err).Tracef("Could not connect with peer %s", info.String())
-					continue
+			if len(subscribed) >= threshold {
+				break
+			}
+			if node.IP() == nil {
+				continue
+			}
+			// do not look for nodes with no tcp port set
+			if err := node.Record().Load(enr.WithEntry("tcp", new(enr.TCP))); err != nil {
+				if !enr.IsNotFound(err) {
+					log.WithError(err).Debug("Could not retrieve tcp port")
 				}
-				exists = true
+				continue
+			}
+			subnets, err := retrieveAttSubnets(node.Record())
+			if err != nil {
+				log.Debugf("could not retrieve subnets: %v", err)
+				continue
+			}
+			if !sliceutil.IsInUint64(index, subnets) {
+				continue
+			}
+			info, multiAddr, err := convertToAddrInfo(node)
+			if err != nil {
+				return false, err
+			}
+			if subscribed[info.ID] {
+				continue
 			}
+			if s.peers.IsActive(info.ID) || s.host.Network().Connectedness(info.ID) == network.Connected {
+				subscribed[info.ID] = true
+				continue
+			}
+			if !s.peers.IsReadyToDial(info.ID) {
+				continue
+			}
+			s.peers.Add(node.Record(), info.ID, multiAddr, network.DirUnknown)
+			if err := s.connectWithPeer(ctx, *info); err != nil {
+				log.WithError(err).Tracef("Could not connect with peer %s", info.String())
+				continue
+			}
+			subscribed[info.ID] = true
 		}
 	}
-	return exists, nil
+	return len(subscribed) >= threshold, nil
 }
 
 func (s *Service) hasPeerWithSubnet(subnet uint64) bool {
@@ -87,12 +103,14 @@ func (s *Service) hasPeerWithSubnet(subnet uint64) bool {
 }
 
 // Updates the service's discv5 listener record's attestation subnet
-// with a new value for a bitfield of subnets tracked. It also updates
-// the node's metadata by increasing the sequence number and the
-// subnets tracked by the node.
+// with a new value for a bitfield of subnets tracked, if discv5 is
+// running. It also updates the node's metadata by increasing the
+// sequence number and the subnets tracked by the node.
 func (s *Service) updateSubnetRecordWithMetadata(bitV bitfield.Bitvector64) {
-	entry := enr.WithEntry(attSubnetEnrKey, &bitV)
-	s.dv5Listener.LocalNode().Set(entry)
+	if s.dv5Listener != nil {
+		entry := enr.WithEntry(attSubnetEnrKey, &bitV)
+		s.dv5Listener.LocalNode().Set(entry)
+	}
 	s.metaData = &pb.MetaData{
 		SeqNumber: s.metaData.SeqNumber + 1,
 		Attnets:   bitV,
@@ -6,12 +6,17 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/p2p/discover"
+	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/prysmaticlabs/go-bitfield"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
 	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
@@ -105,11 +110,11 @@ func TestStartDiscV5_DiscoverPeersWithSubnets(t *testing.T) {
 
 	// look up 3 different subnets
 	ctx := context.Background()
-	exists, err := s.FindPeersWithSubnet(ctx, 1)
+	exists, err := s.FindPeersWithSubnet(ctx, 1, 1)
 	require.NoError(t, err)
-	exists2, err := s.FindPeersWithSubnet(ctx, 2)
+	exists2, err := s.FindPeersWithSubnet(ctx, 2, 1)
 	require.NoError(t, err)
-	exists3, err := s.FindPeersWithSubnet(ctx, 3)
+	exists3, err := s.FindPeersWithSubnet(ctx, 3, 1)
 	require.NoError(t, err)
 	if !exists || !exists2 || !exists3 {
 		t.Fatal("Peer with subnet doesn't exist")
@@ -126,10 +131,65 @@ func TestStartDiscV5_DiscoverPeersWithSubnets(t *testing.T) {
 	testService.RefreshENR()
 	time.Sleep(2 * time.Second)
 
-	exists, err = s.FindPeersWithSubnet(ctx, 2)
+	exists, err = s.FindPeersWithSubnet(ctx, 2, 1)
 	require.NoError(t, err)
 
 	assert.Equal(t, true, exists, "Peer with subnet doesn't exist")
 	assert.NoError(t, s.Stop())
 	exitRoutine <- true
 }
+
+// emptyListener is a discovery listener which finds no nodes.
+type emptyListener struct {
+	mockListener
+}
+
+func (emptyListener) RandomNodes() enode.Iterator {
+	return enode.IterNodes(nil)
+}
+
+func TestFindPeersWithSubnet_Threshold(t *testing.T) {
+	s := &Service{
+		dv5Listener: emptyListener{},
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit:    30,
+			ScorerParams: &scorers.Config{},
+		}),
+	}
+	bitV := bitfield.NewBitvector64()
+	bitV.SetBitAt(3, true)
+	for i := 0; i < 2; i++ {
+		_, pkey := createAddrAndPrivKey(t)
+		pid, err := peer.IDFromPublicKey(convertToInterfacePubkey(&pkey.PublicKey))
+		require.NoError(t, err)
+		s.peers.Add(nil, pid, nil, network.DirOutbound)
+		s.peers.SetConnectionState(pid, peers.PeerConnected)
+		s.peers.SetMetadata(pid, &pb.MetaData{Attnets: bitV})
+	}
+
+	ok, err := s.FindPeersWithSubnet(context.Background(), 3, 2)
+	require.NoError(t, err)
+	assert.Equal(t, true, ok, "Expected subscribed peers to reach the threshold")
+	ok, err = s.FindPeersWithSubnet(context.Background(), 3, 3)
+	require.NoError(t, err)
+	assert.Equal(t, false, ok, "Expected subscribed peers to be below the threshold")
+	ok, err = s.FindPeersWithSubnet(context.Background(), 4, 1)
+	require.NoError(t, err)
+	assert.Equal(t, false, ok, "Expected no peer subscribed to the subnet")
+}
+
+func TestRefreshENR_WithoutDiscovery(t *testing.T) {
+	s := &Service{
+		metaData: &pb.MetaData{
+			Attnets: bitfield.NewBitvector64(),
+		},
+	}
+	cache.SubnetIDs.AddPersistentCommittee([]byte("refresh-enr-pubkey"), []uint64{20}, time.Minute)
+	s.RefreshENR()
+	assert.Equal(t, uint64(1), s.metaData.SeqNumber, "Expected metadata sequence number to be increased")
+	assert.Equal(t, true, s.metaData.Attnets.BitAt(20), "Expected persistent subnet to be advertised")
+
+	// The metadata is left alone while the subnets are unchanged.
+	s.RefreshENR()
+	assert.Equal(t, uint64(1), s.metaData.SeqNumber)
+}
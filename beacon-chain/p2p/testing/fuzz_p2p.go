@@ -57,10 +57,15 @@ func (p *FakeP2P) ENR() *enr.Record {
 }
 
 // FindPeersWithSubnet mocks the p2p func.
-func (p *FakeP2P) FindPeersWithSubnet(_ context.Context, _ uint64) (bool, error) {
+func (p *FakeP2P) FindPeersWithSubnet(_ context.Context, _ uint64, _ int) (bool, error) {
 	return false, nil
 }
 
+// DiscoveryAddresses -- fake
+func (p *FakeP2P) DiscoveryAddresses() ([]multiaddr.Multiaddr, error) {
+	return nil, nil
+}
+
 // RefreshENR mocks the p2p func.
 func (p *FakeP2P) RefreshENR() {}
 
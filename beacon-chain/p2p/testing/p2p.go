@@ -314,10 +314,15 @@ func (p *TestP2P) Peers() *peers.Status {
 }
 
 // FindPeersWithSubnet mocks the p2p func.
-func (p *TestP2P) FindPeersWithSubnet(_ context.Context, _ uint64) (bool, error) {
+func (p *TestP2P) FindPeersWithSubnet(_ context.Context, _ uint64, _ int) (bool, error) {
 	return false, nil
 }
 
+// DiscoveryAddresses --
+func (p *TestP2P) DiscoveryAddresses() ([]multiaddr.Multiaddr, error) {
+	return nil, nil
+}
+
 // RefreshENR mocks the p2p func.
 func (p *TestP2P) RefreshENR() {}
 
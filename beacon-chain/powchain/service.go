@@ -237,7 +237,14 @@ func (s *Service) Start() {
 			log.Fatal(err)
 		}
 		if genState == nil {
-			log.Fatal("cannot create genesis state: no eth1 http endpoint defined")
+			// A node started from a checkpoint state does not need a genesis state.
+			originRoot, err := s.beaconDB.OriginBlockRoot(s.ctx)
+			if err != nil {
+				log.Fatal(err)
+			}
+			if originRoot == [32]byte{} {
+				log.Fatal("cannot create genesis state: no eth1 http endpoint defined")
+			}
 		}
 	}
 
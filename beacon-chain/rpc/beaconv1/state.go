@@ -2,29 +2,127 @@ package beaconv1
 
 import (
 	"context"
-	"errors"
 
 	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	ethpb_alpha "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/statefetcher"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // GetGenesis retrieves details of the chain's genesis which can be used to identify chain.
 func (bs *Server) GetGenesis(ctx context.Context, _ *ptypes.Empty) (*ethpb.GenesisResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconV1.GetGenesis")
+	defer span.End()
+
+	genesisTime := bs.GenesisTimeFetcher.GenesisTime()
+	if genesisTime.IsZero() {
+		return nil, status.Error(codes.NotFound, "Chain genesis info is not yet known")
+	}
+	validatorsRoot := bs.ChainInfoFetcher.GenesisValidatorRoot()
+	if validatorsRoot == params.BeaconConfig().ZeroHash {
+		return nil, status.Error(codes.NotFound, "Chain genesis info is not yet known")
+	}
+	ts, err := ptypes.TimestampProto(genesisTime)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not convert genesis time: %v", err)
+	}
+
+	return &ethpb.GenesisResponse{
+		GenesisTime:           ts,
+		GenesisValidatorsRoot: validatorsRoot[:],
+		GenesisForkVersion:    params.BeaconConfig().GenesisForkVersion,
+	}, nil
 }
 
 // GetStateRoot calculates HashTreeRoot for state with given 'stateId'. If stateId is root, same value will be returned.
 func (bs *Server) GetStateRoot(ctx context.Context, req *ethpb.StateRequest) (*ethpb.StateRootResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconV1.GetStateRoot")
+	defer span.End()
+
+	st, err := bs.stateFromRequest(ctx, req.StateId)
+	if err != nil {
+		return nil, err
+	}
+	root, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not hash state: %v", err)
+	}
+
+	return &ethpb.StateRootResponse{
+		StateRoot: root[:],
+	}, nil
 }
 
 // GetStateFork returns Fork object for state with given 'stateId'.
 func (bs *Server) GetStateFork(ctx context.Context, req *ethpb.StateRequest) (*ethpb.StateForkResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconV1.GetStateFork")
+	defer span.End()
+
+	st, err := bs.stateFromRequest(ctx, req.StateId)
+	if err != nil {
+		return nil, err
+	}
+	fork := st.Fork()
+	if fork == nil {
+		return nil, status.Error(codes.Internal, "State has no fork")
+	}
+
+	return &ethpb.StateForkResponse{
+		Fork: &ethpb.Fork{
+			PreviousVersion: fork.PreviousVersion,
+			CurrentVersion:  fork.CurrentVersion,
+			Epoch:           fork.Epoch,
+		},
+	}, nil
 }
 
 // GetFinalityCheckpoints returns finality checkpoints for state with given 'stateId'. In case finality is
 // not yet achieved, checkpoint should return epoch 0 and ZERO_HASH as root.
 func (bs *Server) GetFinalityCheckpoints(ctx context.Context, req *ethpb.StateRequest) (*ethpb.StateFinalityCheckpointResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconV1.GetFinalityCheckpoints")
+	defer span.End()
+
+	st, err := bs.stateFromRequest(ctx, req.StateId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ethpb.StateFinalityCheckpointResponse{
+		PreviousJustified: v1Checkpoint(st.PreviousJustifiedCheckpoint()),
+		CurrentJustified:  v1Checkpoint(st.CurrentJustifiedCheckpoint()),
+		Finalized:         v1Checkpoint(st.FinalizedCheckpoint()),
+	}, nil
+}
+
+// stateFromRequest resolves the state ID through the state fetcher and translates
+// resolution failures into the matching gRPC status.
+func (bs *Server) stateFromRequest(ctx context.Context, stateID []byte) (*state.BeaconState, error) {
+	st, err := bs.StateFetcher.State(ctx, stateID)
+	if err != nil {
+		switch {
+		case errors.Is(err, statefetcher.ErrInvalidStateID):
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid state ID: %v", err)
+		case errors.Is(err, statefetcher.ErrStateNotFound):
+			return nil, status.Errorf(codes.NotFound, "Could not find state: %v", err)
+		default:
+			return nil, status.Errorf(codes.Internal, "Could not get state: %v", err)
+		}
+	}
+	return st, nil
+}
+
+func v1Checkpoint(cp *ethpb_alpha.Checkpoint) *ethpb.Checkpoint {
+	if cp == nil {
+		return &ethpb.Checkpoint{Root: params.BeaconConfig().ZeroHash[:]}
+	}
+	return &ethpb.Checkpoint{
+		Epoch: cp.Epoch,
+		Root:  cp.Root,
+	}
 }
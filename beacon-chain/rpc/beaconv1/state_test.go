@@ -0,0 +1,128 @@
+package beaconv1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	ethpb_alpha "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/statefetcher"
+	mockstatefetcher "github.com/prysmaticlabs/prysm/beacon-chain/rpc/statefetcher/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_GetGenesis(t *testing.T) {
+	ctx := context.Background()
+	genesis := time.Unix(1606824023, 0)
+	validatorsRoot := [32]byte{'a'}
+	chainService := &mock.ChainService{
+		Genesis:        genesis,
+		ValidatorsRoot: validatorsRoot,
+	}
+	s := &Server{
+		GenesisTimeFetcher: chainService,
+		ChainInfoFetcher:   chainService,
+	}
+
+	resp, err := s.GetGenesis(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, genesis.Unix(), resp.GenesisTime.Seconds)
+	assert.DeepEqual(t, validatorsRoot[:], resp.GenesisValidatorsRoot)
+	assert.DeepEqual(t, params.BeaconConfig().GenesisForkVersion, resp.GenesisForkVersion)
+}
+
+func TestServer_GetGenesis_NotKnown(t *testing.T) {
+	ctx := context.Background()
+	chainService := &mock.ChainService{}
+	s := &Server{
+		GenesisTimeFetcher: chainService,
+		ChainInfoFetcher:   chainService,
+	}
+
+	_, err := s.GetGenesis(ctx, &ptypes.Empty{})
+	assert.ErrorContains(t, "Chain genesis info is not yet known", err)
+}
+
+func TestServer_GetStateRoot(t *testing.T) {
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 16)
+	s := &Server{
+		StateFetcher: &mockstatefetcher.MockFetcher{BeaconState: st},
+	}
+
+	resp, err := s.GetStateRoot(ctx, &ethpb.StateRequest{StateId: []byte("head")})
+	require.NoError(t, err)
+	want, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want[:], resp.StateRoot)
+}
+
+func TestServer_GetStateRoot_StateFetcherErrors(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name    string
+		err     error
+		wantErr string
+	}{
+		{name: "invalid", err: statefetcher.ErrInvalidStateID, wantErr: "code = InvalidArgument"},
+		{name: "not found", err: statefetcher.ErrStateNotFound, wantErr: "code = NotFound"},
+		{name: "internal", err: context.Canceled, wantErr: "code = Internal"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Server{
+				StateFetcher: &mockstatefetcher.MockFetcher{Err: tt.err},
+			}
+			_, err := s.GetStateRoot(ctx, &ethpb.StateRequest{StateId: []byte("foo")})
+			assert.ErrorContains(t, tt.wantErr, err)
+		})
+	}
+}
+
+func TestServer_GetStateFork(t *testing.T) {
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 16)
+	fork := &pb.Fork{
+		PreviousVersion: []byte{1, 2, 3, 4},
+		CurrentVersion:  []byte{5, 6, 7, 8},
+		Epoch:           10,
+	}
+	require.NoError(t, st.SetFork(fork))
+	s := &Server{
+		StateFetcher: &mockstatefetcher.MockFetcher{BeaconState: st},
+	}
+
+	resp, err := s.GetStateFork(ctx, &ethpb.StateRequest{StateId: []byte("head")})
+	require.NoError(t, err)
+	assert.DeepEqual(t, fork.PreviousVersion, resp.Fork.PreviousVersion)
+	assert.DeepEqual(t, fork.CurrentVersion, resp.Fork.CurrentVersion)
+	assert.Equal(t, fork.Epoch, resp.Fork.Epoch)
+}
+
+func TestServer_GetFinalityCheckpoints(t *testing.T) {
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 16)
+	previous := &ethpb_alpha.Checkpoint{Epoch: 1, Root: bytesutil.PadTo([]byte("previous"), 32)}
+	current := &ethpb_alpha.Checkpoint{Epoch: 2, Root: bytesutil.PadTo([]byte("current"), 32)}
+	finalized := &ethpb_alpha.Checkpoint{Epoch: 1, Root: bytesutil.PadTo([]byte("finalized"), 32)}
+	require.NoError(t, st.SetPreviousJustifiedCheckpoint(previous))
+	require.NoError(t, st.SetCurrentJustifiedCheckpoint(current))
+	require.NoError(t, st.SetFinalizedCheckpoint(finalized))
+	s := &Server{
+		StateFetcher: &mockstatefetcher.MockFetcher{BeaconState: st},
+	}
+
+	resp, err := s.GetFinalityCheckpoints(ctx, &ethpb.StateRequest{StateId: []byte("head")})
+	require.NoError(t, err)
+	assert.DeepEqual(t, &ethpb.Checkpoint{Epoch: 1, Root: previous.Root}, resp.PreviousJustified)
+	assert.DeepEqual(t, &ethpb.Checkpoint{Epoch: 2, Root: current.Root}, resp.CurrentJustified)
+	assert.DeepEqual(t, &ethpb.Checkpoint{Epoch: 1, Root: finalized.Root}, resp.Finalized)
+}
@@ -2,27 +2,332 @@ package beaconv1
 
 import (
 	"context"
-	"errors"
+	"encoding/hex"
+	"strconv"
+	"strings"
 
+	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	ethpb_alpha "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// Validator statuses as defined by the API specification. Each status is prefixed by
+// its top level status, which may also be used as a filter.
+const (
+	statusPendingInitialized = "pending_initialized"
+	statusPendingQueued      = "pending_queued"
+	statusActiveOngoing      = "active_ongoing"
+	statusActiveExiting      = "active_exiting"
+	statusActiveSlashed      = "active_slashed"
+	statusExitedUnslashed    = "exited_unslashed"
+	statusExitedSlashed      = "exited_slashed"
+	statusWithdrawalPossible = "withdrawal_possible"
+	statusWithdrawalDone     = "withdrawal_done"
+)
+
+var validatorStatuses = []string{
+	statusPendingInitialized,
+	statusPendingQueued,
+	statusActiveOngoing,
+	statusActiveExiting,
+	statusActiveSlashed,
+	statusExitedUnslashed,
+	statusExitedSlashed,
+	statusWithdrawalPossible,
+	statusWithdrawalDone,
+}
+
 // GetValidator returns a validator specified by state and id or public key along with status and balance.
 func (bs *Server) GetValidator(ctx context.Context, req *ethpb.StateValidatorRequest) (*ethpb.StateValidatorResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconV1.GetValidator")
+	defer span.End()
+
+	st, err := bs.stateFromRequest(ctx, req.StateId)
+	if err != nil {
+		return nil, err
+	}
+	idx, ok, err := validatorIndexFromID(st, req.ValidatorId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid validator ID: %v", err)
+	}
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "Could not find validator %s", req.ValidatorId)
+	}
+	container, err := validatorContainer(st, idx, helpers.CurrentEpoch(st))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get validator: %v", err)
+	}
+
+	return &ethpb.StateValidatorResponse{
+		Data: container,
+	}, nil
 }
 
 // ListValidators returns filterable list of validators with their balance, status and index.
 func (bs *Server) ListValidators(ctx context.Context, req *ethpb.StateValidatorsRequest) (*ethpb.StateValidatorsResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconV1.ListValidators")
+	defer span.End()
+
+	st, err := bs.stateFromRequest(ctx, req.StateId)
+	if err != nil {
+		return nil, err
+	}
+	statuses, err := parseStatusFilter(req.Status)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid status filter: %v", err)
+	}
+	ids := make([]string, len(req.Id))
+	for i, id := range req.Id {
+		ids[i] = string(id)
+	}
+	indices, err := validatorIndicesFromIDs(st, ids)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid validator ID: %v", err)
+	}
+
+	epoch := helpers.CurrentEpoch(st)
+	containers := make([]*ethpb.ValidatorContainer, 0, len(indices))
+	for _, idx := range indices {
+		container, err := validatorContainer(st, idx, epoch)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get validator: %v", err)
+		}
+		if len(statuses) != 0 && !statuses[container.Status] {
+			continue
+		}
+		containers = append(containers, container)
+	}
+
+	return &ethpb.StateValidatorsResponse{
+		Data: containers,
+	}, nil
 }
 
 // ListValidatorBalances returns a filterable list of validator balances.
 func (bs *Server) ListValidatorBalances(ctx context.Context, req *ethpb.ValidatorBalancesRequest) (*ethpb.ValidatorBalancesResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconV1.ListValidatorBalances")
+	defer span.End()
+
+	st, err := bs.stateFromRequest(ctx, req.StateId)
+	if err != nil {
+		return nil, err
+	}
+	indices, err := validatorIndicesFromIDs(st, req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid validator ID: %v", err)
+	}
+
+	balances := make([]*ethpb.ValidatorBalance, len(indices))
+	for i, idx := range indices {
+		balance, err := st.BalanceAtIndex(idx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get balance of validator %d: %v", idx, err)
+		}
+		balances[i] = &ethpb.ValidatorBalance{
+			Index:   idx,
+			Balance: balance,
+		}
+	}
+
+	return &ethpb.ValidatorBalancesResponse{
+		Data: balances,
+	}, nil
 }
 
 // ListCommittees retrieves the committees for the given state at the given epoch.
+// As proto3 cannot distinguish an unset field from its zero value, a zero index or
+// slot in the request means the committees are not filtered by that field.
 func (bs *Server) ListCommittees(ctx context.Context, req *ethpb.StateCommitteesRequest) (*ethpb.StateCommitteesResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "beaconV1.ListCommittees")
+	defer span.End()
+
+	st, err := bs.stateFromRequest(ctx, req.StateId)
+	if err != nil {
+		return nil, err
+	}
+	epoch := req.Epoch
+	currentEpoch := helpers.CurrentEpoch(st)
+	if epoch > currentEpoch+1 {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Cannot retrieve committees for epoch %d, which is more than one epoch after the state epoch %d",
+			epoch,
+			currentEpoch,
+		)
+	}
+	if req.Slot != 0 && helpers.SlotToEpoch(req.Slot) != epoch {
+		return nil, status.Errorf(codes.InvalidArgument, "Slot %d is not in epoch %d", req.Slot, epoch)
+	}
+
+	seed, err := helpers.Seed(st, epoch, params.BeaconConfig().DomainBeaconAttester)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get seed: %v", err)
+	}
+	activeIndices, err := helpers.ActiveValidatorIndices(st, epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get active validator indices: %v", err)
+	}
+	startSlot, err := helpers.StartSlot(epoch)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not get start slot of epoch %d: %v", epoch, err)
+	}
+	committeesPerSlot := helpers.SlotCommitteeCount(uint64(len(activeIndices)))
+
+	committees := make([]*ethpb.Committee, 0)
+	for slot := startSlot; slot < startSlot+params.BeaconConfig().SlotsPerEpoch; slot++ {
+		if req.Slot != 0 && slot != req.Slot {
+			continue
+		}
+		for idx := uint64(0); idx < committeesPerSlot; idx++ {
+			if req.Index != 0 && idx != req.Index {
+				continue
+			}
+			committee, err := helpers.BeaconCommittee(activeIndices, seed, slot, idx)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not compute committee %d at slot %d: %v", idx, slot, err)
+			}
+			committees = append(committees, &ethpb.Committee{
+				Index:      idx,
+				Slot:       slot,
+				Validators: committee,
+			})
+		}
+	}
+
+	return &ethpb.StateCommitteesResponse{
+		Data: committees,
+	}, nil
+}
+
+// validatorIndicesFromIDs resolves the given validator IDs into indices, skipping validators
+// unknown to the state. All validators are returned when no IDs are given.
+func validatorIndicesFromIDs(st *state.BeaconState, ids []string) ([]uint64, error) {
+	if len(ids) == 0 {
+		indices := make([]uint64, st.NumValidators())
+		for i := range indices {
+			indices[i] = uint64(i)
+		}
+		return indices, nil
+	}
+	indices := make([]uint64, 0, len(ids))
+	for _, id := range ids {
+		idx, ok, err := validatorIndexFromID(st, []byte(id))
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			indices = append(indices, idx)
+		}
+	}
+	return indices, nil
+}
+
+// validatorIndexFromID resolves a validator ID, which is either a decimal index, a 0x-prefixed
+// hex encoded public key or a raw 48 byte public key, into the validator's index in the state.
+func validatorIndexFromID(st *state.BeaconState, id []byte) (uint64, bool, error) {
+	var pubKey []byte
+	switch {
+	case len(id) == params.BeaconConfig().BLSPubkeyLength:
+		pubKey = id
+	case strings.HasPrefix(string(id), "0x"):
+		decoded, err := hex.DecodeString(string(id[2:]))
+		if err != nil || len(decoded) != params.BeaconConfig().BLSPubkeyLength {
+			return 0, false, errors.Errorf("could not decode public key %q", id)
+		}
+		pubKey = decoded
+	default:
+		idx, err := strconv.ParseUint(string(id), 10, 64)
+		if err != nil {
+			return 0, false, errors.Errorf("could not parse validator ID %q", id)
+		}
+		return idx, idx < uint64(st.NumValidators()), nil
+	}
+	idx, ok := st.ValidatorIndexByPubkey(bytesutil.ToBytes48(pubKey))
+	return idx, ok, nil
+}
+
+// parseStatusFilter parses a comma separated list of validator statuses. Top level statuses
+// such as "active" are expanded into all of their sub statuses.
+func parseStatusFilter(filter string) (map[string]bool, error) {
+	statuses := make(map[string]bool)
+	if filter == "" {
+		return statuses, nil
+	}
+	for _, s := range strings.Split(filter, ",") {
+		s = strings.ToLower(strings.TrimSpace(s))
+		matched := false
+		for _, candidate := range validatorStatuses {
+			if candidate == s || strings.HasPrefix(candidate, s+"_") {
+				statuses[candidate] = true
+				matched = true
+			}
+		}
+		if !matched {
+			return nil, errors.Errorf("unknown validator status %q", s)
+		}
+	}
+	return statuses, nil
+}
+
+func validatorContainer(st *state.BeaconState, idx, epoch uint64) (*ethpb.ValidatorContainer, error) {
+	val, err := st.ValidatorAtIndex(idx)
+	if err != nil {
+		return nil, err
+	}
+	balance, err := st.BalanceAtIndex(idx)
+	if err != nil {
+		return nil, err
+	}
+	return &ethpb.ValidatorContainer{
+		Index:   idx,
+		Balance: balance,
+		Status:  validatorStatus(val, epoch),
+		Validator: &ethpb.Validator{
+			PublicKey:                  val.PublicKey,
+			WithdrawalCredentials:      val.WithdrawalCredentials,
+			EffectiveBalance:           val.EffectiveBalance,
+			Slashed:                    val.Slashed,
+			ActivationEligibilityEpoch: val.ActivationEligibilityEpoch,
+			ActivationEpoch:            val.ActivationEpoch,
+			ExitEpoch:                  val.ExitEpoch,
+			WithdrawableEpoch:          val.WithdrawableEpoch,
+		},
+	}, nil
+}
+
+// validatorStatus computes the status of the validator at the given epoch, as described
+// by the API specification.
+func validatorStatus(val *ethpb_alpha.Validator, epoch uint64) string {
+	farFutureEpoch := params.BeaconConfig().FarFutureEpoch
+	switch {
+	case val.ActivationEpoch > epoch:
+		if val.ActivationEligibilityEpoch == farFutureEpoch {
+			return statusPendingInitialized
+		}
+		return statusPendingQueued
+	case epoch < val.ExitEpoch:
+		if val.ExitEpoch == farFutureEpoch {
+			return statusActiveOngoing
+		}
+		if val.Slashed {
+			return statusActiveSlashed
+		}
+		return statusActiveExiting
+	case epoch < val.WithdrawableEpoch:
+		if val.Slashed {
+			return statusExitedSlashed
+		}
+		return statusExitedUnslashed
+	case val.EffectiveBalance != 0:
+		return statusWithdrawalPossible
+	default:
+		return statusWithdrawalDone
+	}
 }
@@ -0,0 +1,198 @@
+package beaconv1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	ethpb_alpha "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	mockstatefetcher "github.com/prysmaticlabs/prysm/beacon-chain/rpc/statefetcher/testing"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_GetValidator(t *testing.T) {
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 16)
+	s := &Server{
+		StateFetcher: &mockstatefetcher.MockFetcher{BeaconState: st},
+	}
+	val, err := st.ValidatorAtIndex(5)
+	require.NoError(t, err)
+
+	ids := map[string][]byte{
+		"index":      []byte("5"),
+		"hex pubkey": []byte(fmt.Sprintf("%#x", val.PublicKey)),
+		"raw pubkey": val.PublicKey,
+	}
+	for name, id := range ids {
+		t.Run(name, func(t *testing.T) {
+			resp, err := s.GetValidator(ctx, &ethpb.StateValidatorRequest{StateId: []byte("head"), ValidatorId: id})
+			require.NoError(t, err)
+			assert.Equal(t, uint64(5), resp.Data.Index)
+			assert.Equal(t, params.BeaconConfig().MaxEffectiveBalance, resp.Data.Balance)
+			assert.Equal(t, statusActiveOngoing, resp.Data.Status)
+			assert.DeepEqual(t, val.PublicKey, resp.Data.Validator.PublicKey)
+		})
+	}
+
+	_, err = s.GetValidator(ctx, &ethpb.StateValidatorRequest{StateId: []byte("head"), ValidatorId: []byte("100")})
+	assert.ErrorContains(t, "Could not find validator", err)
+	_, err = s.GetValidator(ctx, &ethpb.StateValidatorRequest{StateId: []byte("head"), ValidatorId: []byte("0x12")})
+	assert.ErrorContains(t, "Invalid validator ID", err)
+}
+
+func TestServer_ListValidators(t *testing.T) {
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 8)
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	exiting, err := st.ValidatorAtIndex(1)
+	require.NoError(t, err)
+	exiting.ExitEpoch = 10
+	require.NoError(t, st.UpdateValidatorAtIndex(1, exiting))
+	pending, err := st.ValidatorAtIndex(2)
+	require.NoError(t, err)
+	pending.ActivationEpoch = farFuture
+	pending.ActivationEligibilityEpoch = farFuture
+	require.NoError(t, st.UpdateValidatorAtIndex(2, pending))
+	s := &Server{
+		StateFetcher: &mockstatefetcher.MockFetcher{BeaconState: st},
+	}
+
+	resp, err := s.ListValidators(ctx, &ethpb.StateValidatorsRequest{StateId: []byte("head")})
+	require.NoError(t, err)
+	assert.Equal(t, 8, len(resp.Data))
+
+	resp, err = s.ListValidators(ctx, &ethpb.StateValidatorsRequest{StateId: []byte("head"), Id: [][]byte{[]byte("1"), []byte("2"), []byte("50")}})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Data))
+	assert.Equal(t, statusActiveExiting, resp.Data[0].Status)
+	assert.Equal(t, statusPendingInitialized, resp.Data[1].Status)
+
+	resp, err = s.ListValidators(ctx, &ethpb.StateValidatorsRequest{StateId: []byte("head"), Status: "active"})
+	require.NoError(t, err)
+	assert.Equal(t, 7, len(resp.Data))
+
+	resp, err = s.ListValidators(ctx, &ethpb.StateValidatorsRequest{StateId: []byte("head"), Status: "active_exiting,pending"})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Data))
+	assert.Equal(t, uint64(1), resp.Data[0].Index)
+	assert.Equal(t, uint64(2), resp.Data[1].Index)
+
+	_, err = s.ListValidators(ctx, &ethpb.StateValidatorsRequest{StateId: []byte("head"), Status: "bogus"})
+	assert.ErrorContains(t, "Invalid status filter", err)
+}
+
+func TestServer_ListValidatorBalances(t *testing.T) {
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 8)
+	require.NoError(t, st.UpdateBalancesAtIndex(3, 42))
+	val, err := st.ValidatorAtIndex(3)
+	require.NoError(t, err)
+	s := &Server{
+		StateFetcher: &mockstatefetcher.MockFetcher{BeaconState: st},
+	}
+
+	resp, err := s.ListValidatorBalances(ctx, &ethpb.ValidatorBalancesRequest{StateId: []byte("head")})
+	require.NoError(t, err)
+	assert.Equal(t, 8, len(resp.Data))
+
+	resp, err = s.ListValidatorBalances(ctx, &ethpb.ValidatorBalancesRequest{
+		StateId: []byte("head"),
+		Id:      []string{fmt.Sprintf("%#x", val.PublicKey), "0"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Data))
+	assert.DeepEqual(t, &ethpb.ValidatorBalance{Index: 3, Balance: 42}, resp.Data[0])
+	assert.DeepEqual(t, &ethpb.ValidatorBalance{Index: 0, Balance: params.BeaconConfig().MaxEffectiveBalance}, resp.Data[1])
+}
+
+func TestServer_ListCommittees(t *testing.T) {
+	ctx := context.Background()
+	helpers.ClearCache()
+	st, _ := testutil.DeterministicGenesisState(t, 256)
+	s := &Server{
+		StateFetcher: &mockstatefetcher.MockFetcher{BeaconState: st},
+	}
+
+	resp, err := s.ListCommittees(ctx, &ethpb.StateCommitteesRequest{StateId: []byte("head")})
+	require.NoError(t, err)
+	committeesPerSlot := helpers.SlotCommitteeCount(256)
+	assert.Equal(t, int(params.BeaconConfig().SlotsPerEpoch*committeesPerSlot), len(resp.Data))
+	seen := make(map[uint64]bool)
+	for _, c := range resp.Data {
+		want, err := helpers.BeaconCommitteeFromState(st, c.Slot, c.Index)
+		require.NoError(t, err)
+		assert.DeepEqual(t, want, c.Validators)
+		for _, v := range c.Validators {
+			seen[v] = true
+		}
+	}
+	assert.Equal(t, 256, len(seen), "Every active validator should be in exactly one committee")
+
+	resp, err = s.ListCommittees(ctx, &ethpb.StateCommitteesRequest{StateId: []byte("head"), Slot: 3})
+	require.NoError(t, err)
+	assert.Equal(t, int(committeesPerSlot), len(resp.Data))
+	for _, c := range resp.Data {
+		assert.Equal(t, uint64(3), c.Slot)
+	}
+
+	_, err = s.ListCommittees(ctx, &ethpb.StateCommitteesRequest{StateId: []byte("head"), Epoch: 5})
+	assert.ErrorContains(t, "more than one epoch after", err)
+	_, err = s.ListCommittees(ctx, &ethpb.StateCommitteesRequest{StateId: []byte("head"), Epoch: 1, Slot: 3})
+	assert.ErrorContains(t, "is not in epoch", err)
+}
+
+func TestValidatorStatus(t *testing.T) {
+	farFuture := params.BeaconConfig().FarFutureEpoch
+	tests := []struct {
+		validator *ethpb_alpha.Validator
+		want      string
+	}{
+		{
+			validator: &ethpb_alpha.Validator{ActivationEligibilityEpoch: farFuture, ActivationEpoch: farFuture},
+			want:      statusPendingInitialized,
+		},
+		{
+			validator: &ethpb_alpha.Validator{ActivationEligibilityEpoch: 4, ActivationEpoch: farFuture},
+			want:      statusPendingQueued,
+		},
+		{
+			validator: &ethpb_alpha.Validator{ActivationEpoch: 1, ExitEpoch: farFuture},
+			want:      statusActiveOngoing,
+		},
+		{
+			validator: &ethpb_alpha.Validator{ActivationEpoch: 1, ExitEpoch: 10},
+			want:      statusActiveExiting,
+		},
+		{
+			validator: &ethpb_alpha.Validator{ActivationEpoch: 1, ExitEpoch: 10, Slashed: true},
+			want:      statusActiveSlashed,
+		},
+		{
+			validator: &ethpb_alpha.Validator{ActivationEpoch: 1, ExitEpoch: 2, WithdrawableEpoch: 10},
+			want:      statusExitedUnslashed,
+		},
+		{
+			validator: &ethpb_alpha.Validator{ActivationEpoch: 1, ExitEpoch: 2, WithdrawableEpoch: 10, Slashed: true},
+			want:      statusExitedSlashed,
+		},
+		{
+			validator: &ethpb_alpha.Validator{ActivationEpoch: 1, ExitEpoch: 2, WithdrawableEpoch: 3, EffectiveBalance: 1},
+			want:      statusWithdrawalPossible,
+		},
+		{
+			validator: &ethpb_alpha.Validator{ActivationEpoch: 1, ExitEpoch: 2, WithdrawableEpoch: 3},
+			want:      statusWithdrawalDone,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			assert.Equal(t, tt.want, validatorStatus(tt.validator, 5))
+		})
+	}
+}
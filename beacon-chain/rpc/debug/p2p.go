@@ -133,5 +133,6 @@ func (ds *Server) getPeer(pid peer.ID) (*pbrpc.DebugPeerResponse, error) {
 		PeerInfo:           peerInfo,
 		PeerStatus:         pStatus,
 		LastUpdated:        unixTime,
+		TrustedGroup:       peers.TrustedGroup(pid),
 	}, nil
 }
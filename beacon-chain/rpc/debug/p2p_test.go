@@ -19,6 +19,7 @@ func TestDebugServer_GetPeer(t *testing.T) {
 		PeerManager:  &mockP2p.MockPeerManager{BHost: mP2P.BHost},
 	}
 	firstPeer := peersProvider.Peers().All()[0]
+	peersProvider.Peers().SetTrustedGroup(firstPeer, "sentries")
 
 	res, err := ds.GetPeer(context.Background(), &ethpb.PeerRequest{PeerId: firstPeer.String()})
 	require.NoError(t, err)
@@ -26,6 +27,7 @@ func TestDebugServer_GetPeer(t *testing.T) {
 
 	assert.Equal(t, int(ethpb.PeerDirection_INBOUND), int(res.Direction), "Expected 1st peer to be an inbound connection")
 	assert.Equal(t, ethpb.ConnectionState_CONNECTED, res.ConnectionState, "Expected peer to be connected")
+	assert.Equal(t, "sentries", res.TrustedGroup, "Unexpected trusted peer group")
 }
 
 func TestDebugServer_ListPeers(t *testing.T) {
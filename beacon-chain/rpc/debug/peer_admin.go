@@ -0,0 +1,107 @@
+package debug
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AddPeer connects to the peer with the provided multiaddr or ENR.
+func (ds *Server) AddPeer(ctx context.Context, req *pbrpc.AddPeerRequest) (*pbrpc.AddPeerResponse, error) {
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "Peer address must be provided")
+	}
+	pid, err := ds.PeerAdmin.AddPeer(ctx, req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "Could not add peer: %v", err)
+	}
+	return &pbrpc.AddPeerResponse{PeerId: pid.String()}, nil
+}
+
+// DisconnectPeer sends a goodbye message with the provided code to the peer and disconnects from it.
+func (ds *Server) DisconnectPeer(ctx context.Context, req *pbrpc.DisconnectPeerRequest) (*types.Empty, error) {
+	pid, err := peer.Decode(req.PeerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to parse provided peer id: %v", err)
+	}
+	if err := ds.PeerAdmin.DisconnectPeer(ctx, pid, p2ptypes.RPCGoodbyeCode(req.GoodbyeCode)); err != nil {
+		if err == p2p.ErrNotConnected {
+			return nil, status.Errorf(codes.NotFound, "Could not disconnect from peer: %v", err)
+		}
+		return nil, status.Errorf(codes.Internal, "Could not disconnect from peer: %v", err)
+	}
+	return &types.Empty{}, nil
+}
+
+// BanPeer bans the provided peer id or CIDR subnet for the provided duration, disconnecting
+// from the banned peers.
+func (ds *Server) BanPeer(ctx context.Context, req *pbrpc.BanPeerRequest) (*types.Empty, error) {
+	if req.DurationSeconds == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Ban duration must be provided")
+	}
+	duration := time.Duration(req.DurationSeconds) * time.Second
+	if req.PeerId != "" && req.Cidr != "" {
+		return nil, status.Error(codes.InvalidArgument, "Only one of peer id and CIDR can be provided")
+	}
+	if req.Cidr != "" {
+		if err := ds.PeerAdmin.BanCIDR(ctx, req.Cidr, duration); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Could not ban subnet: %v", err)
+		}
+		return &types.Empty{}, nil
+	}
+	pid, err := peer.Decode(req.PeerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to parse provided peer id: %v", err)
+	}
+	if err := ds.PeerAdmin.BanPeer(ctx, pid, duration); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not ban peer: %v", err)
+	}
+	return &types.Empty{}, nil
+}
+
+// UnbanPeer lifts the ban of the provided peer id or CIDR subnet.
+func (ds *Server) UnbanPeer(_ context.Context, req *pbrpc.UnbanPeerRequest) (*types.Empty, error) {
+	if req.PeerId != "" && req.Cidr != "" {
+		return nil, status.Error(codes.InvalidArgument, "Only one of peer id and CIDR can be provided")
+	}
+	var err error
+	if req.Cidr != "" {
+		err = ds.PeerAdmin.UnbanCIDR(req.Cidr)
+	} else {
+		pid, decodeErr := peer.Decode(req.PeerId)
+		if decodeErr != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Unable to parse provided peer id: %v", decodeErr)
+		}
+		err = ds.PeerAdmin.UnbanPeer(pid)
+	}
+	if err == p2p.ErrNotBanned {
+		return nil, status.Errorf(codes.NotFound, "Could not lift ban: %v", err)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not lift ban: %v", err)
+	}
+	return &types.Empty{}, nil
+}
+
+// ListBans returns the peer ids and CIDR subnets currently banned, sorted by expiry.
+func (ds *Server) ListBans(_ context.Context, _ *types.Empty) (*pbrpc.PeerBans, error) {
+	bans := ds.PeerAdmin.Bans()
+	resp := &pbrpc.PeerBans{Bans: make([]*pbrpc.PeerBan, 0, len(bans))}
+	for _, ban := range bans {
+		pbBan := &pbrpc.PeerBan{ExpiresAt: uint64(ban.Until.Unix())}
+		if ban.CIDR != nil {
+			pbBan.Cidr = ban.CIDR.String()
+		} else {
+			pbBan.PeerId = ban.PeerID.String()
+		}
+		resp.Bans = append(resp.Bans, pbBan)
+	}
+	return resp, nil
+}
@@ -0,0 +1,141 @@
+package debug
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const testPeerID = "16Uiu2HAkxNEiiqQ2E8ruCcr8oAXdMnoGeidS6D3igrUDv3KqBHnE"
+
+func TestDebugServer_AddPeer(t *testing.T) {
+	admin := &mockPeerAdmin{}
+	ds := &Server{PeerAdmin: admin}
+
+	_, err := ds.AddPeer(context.Background(), &pbrpc.AddPeerRequest{})
+	assert.ErrorContains(t, "Peer address must be provided", err)
+
+	addr := "/ip4/10.0.0.1/tcp/13000/p2p/" + testPeerID
+	res, err := ds.AddPeer(context.Background(), &pbrpc.AddPeerRequest{Address: addr})
+	require.NoError(t, err)
+	assert.Equal(t, testPeerID, res.PeerId)
+	assert.Equal(t, addr, admin.added)
+}
+
+func TestDebugServer_DisconnectPeer(t *testing.T) {
+	admin := &mockPeerAdmin{}
+	ds := &Server{PeerAdmin: admin}
+
+	_, err := ds.DisconnectPeer(context.Background(), &pbrpc.DisconnectPeerRequest{PeerId: "foo"})
+	assert.ErrorContains(t, "Unable to parse provided peer id", err)
+
+	_, err = ds.DisconnectPeer(context.Background(), &pbrpc.DisconnectPeerRequest{PeerId: testPeerID, GoodbyeCode: 251})
+	require.NoError(t, err)
+	assert.Equal(t, p2ptypes.GoodbyeCodeBanned, admin.goodbyeCode)
+
+	admin.disconnectErr = p2p.ErrNotConnected
+	_, err = ds.DisconnectPeer(context.Background(), &pbrpc.DisconnectPeerRequest{PeerId: testPeerID})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestDebugServer_BanAndUnbanPeer(t *testing.T) {
+	admin := &mockPeerAdmin{}
+	ds := &Server{PeerAdmin: admin}
+
+	_, err := ds.BanPeer(context.Background(), &pbrpc.BanPeerRequest{PeerId: testPeerID})
+	assert.ErrorContains(t, "Ban duration must be provided", err)
+	_, err = ds.BanPeer(context.Background(), &pbrpc.BanPeerRequest{PeerId: testPeerID, Cidr: "10.0.0.0/8", DurationSeconds: 60})
+	assert.ErrorContains(t, "Only one of peer id and CIDR can be provided", err)
+	_, err = ds.UnbanPeer(context.Background(), &pbrpc.UnbanPeerRequest{PeerId: testPeerID, Cidr: "10.0.0.0/8"})
+	assert.ErrorContains(t, "Only one of peer id and CIDR can be provided", err)
+
+	_, err = ds.BanPeer(context.Background(), &pbrpc.BanPeerRequest{PeerId: testPeerID, DurationSeconds: 60})
+	require.NoError(t, err)
+	_, err = ds.BanPeer(context.Background(), &pbrpc.BanPeerRequest{Cidr: "10.0.0.0/8", DurationSeconds: 120})
+	require.NoError(t, err)
+
+	res, err := ds.ListBans(context.Background(), &ptypes.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Bans))
+	assert.Equal(t, testPeerID, res.Bans[0].PeerId)
+	assert.Equal(t, "", res.Bans[0].Cidr)
+	assert.Equal(t, "10.0.0.0/8", res.Bans[1].Cidr)
+	assert.Equal(t, "", res.Bans[1].PeerId)
+	assert.Equal(t, uint64(60), res.Bans[1].ExpiresAt-res.Bans[0].ExpiresAt)
+
+	_, err = ds.UnbanPeer(context.Background(), &pbrpc.UnbanPeerRequest{Cidr: "10.0.0.0/8"})
+	require.NoError(t, err)
+	_, err = ds.UnbanPeer(context.Background(), &pbrpc.UnbanPeerRequest{PeerId: testPeerID})
+	require.NoError(t, err)
+	_, err = ds.UnbanPeer(context.Background(), &pbrpc.UnbanPeerRequest{PeerId: testPeerID})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	res, err = ds.ListBans(context.Background(), &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(res.Bans))
+}
+
+// mockPeerAdmin records the bans in place of the p2p service.
+type mockPeerAdmin struct {
+	added         string
+	goodbyeCode   p2ptypes.RPCGoodbyeCode
+	disconnectErr error
+	bans          []*p2p.Ban
+}
+
+func (m *mockPeerAdmin) AddPeer(_ context.Context, addr string) (peer.ID, error) {
+	m.added = addr
+	return peer.Decode(testPeerID)
+}
+
+func (m *mockPeerAdmin) DisconnectPeer(_ context.Context, _ peer.ID, code p2ptypes.RPCGoodbyeCode) error {
+	m.goodbyeCode = code
+	return m.disconnectErr
+}
+
+func (m *mockPeerAdmin) BanPeer(_ context.Context, pid peer.ID, duration time.Duration) error {
+	m.bans = append(m.bans, &p2p.Ban{PeerID: pid, Until: time.Now().Add(duration)})
+	return nil
+}
+
+func (m *mockPeerAdmin) BanCIDR(_ context.Context, cidr string, duration time.Duration) error {
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return err
+	}
+	m.bans = append(m.bans, &p2p.Ban{CIDR: ipNet, Until: time.Now().Add(duration)})
+	return nil
+}
+
+func (m *mockPeerAdmin) UnbanPeer(pid peer.ID) error {
+	return m.unban(func(ban *p2p.Ban) bool { return ban.PeerID == pid })
+}
+
+func (m *mockPeerAdmin) UnbanCIDR(cidr string) error {
+	return m.unban(func(ban *p2p.Ban) bool { return ban.CIDR != nil && ban.CIDR.String() == cidr })
+}
+
+func (m *mockPeerAdmin) unban(match func(ban *p2p.Ban) bool) error {
+	for i, ban := range m.bans {
+		if match(ban) {
+			m.bans = append(m.bans[:i], m.bans[i+1:]...)
+			return nil
+		}
+	}
+	return p2p.ErrNotBanned
+}
+
+func (m *mockPeerAdmin) Bans() []*p2p.Ban {
+	return m.bans
+}
@@ -0,0 +1,44 @@
+package debugv1
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListForkChoiceHeads returns the leaves of the fork choice tree, which are the heads
+// of every chain the node currently tracks.
+func (ds *Server) ListForkChoiceHeads(ctx context.Context, _ *ptypes.Empty) (*ethpb.ForkChoiceHeadsResponse, error) {
+	_, span := trace.StartSpan(ctx, "debugV1.ListForkChoiceHeads")
+	defer span.End()
+
+	store := ds.HeadFetcher.ProtoArrayStore()
+	if store == nil {
+		return nil, status.Error(codes.Unavailable, "Fork choice store is not initialized")
+	}
+	nodes := store.Nodes()
+	hasChild := make([]bool, len(nodes))
+	for _, n := range nodes {
+		if parent := n.Parent(); parent != protoarray.NonExistentNode && parent < uint64(len(nodes)) {
+			hasChild[parent] = true
+		}
+	}
+
+	heads := make([]*ethpb.ForkChoiceHead, 0)
+	for i, n := range nodes {
+		if hasChild[i] {
+			continue
+		}
+		root := n.Root()
+		heads = append(heads, &ethpb.ForkChoiceHead{
+			Root: root[:],
+			Slot: n.Slot(),
+		})
+	}
+	return &ethpb.ForkChoiceHeadsResponse{Data: heads}, nil
+}
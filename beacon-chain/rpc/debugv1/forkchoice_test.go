@@ -0,0 +1,37 @@
+package debugv1
+
+import (
+	"context"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_ListForkChoiceHeads(t *testing.T) {
+	ctx := context.Background()
+	genesis, a, b, c := [32]byte{'g'}, [32]byte{'a'}, [32]byte{'b'}, [32]byte{'c'}
+	f := protoarray.New(0, 0, genesis)
+	require.NoError(t, f.ProcessBlock(ctx, 0, genesis, [32]byte{}, [32]byte{}, 0, 0))
+	require.NoError(t, f.ProcessBlock(ctx, 1, a, genesis, [32]byte{}, 0, 0))
+	require.NoError(t, f.ProcessBlock(ctx, 2, b, genesis, [32]byte{}, 0, 0))
+	require.NoError(t, f.ProcessBlock(ctx, 3, c, a, [32]byte{}, 0, 0))
+	ds := &Server{HeadFetcher: &mock.ChainService{ForkChoiceStore: f.Store()}}
+
+	resp, err := ds.ListForkChoiceHeads(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.Data))
+	assert.DeepEqual(t, b[:], resp.Data[0].Root)
+	assert.Equal(t, uint64(2), resp.Data[0].Slot)
+	assert.DeepEqual(t, c[:], resp.Data[1].Root)
+	assert.Equal(t, uint64(3), resp.Data[1].Slot)
+}
+
+func TestServer_ListForkChoiceHeads_NoStore(t *testing.T) {
+	ds := &Server{HeadFetcher: &mock.ChainService{}}
+	_, err := ds.ListForkChoiceHeads(context.Background(), &ptypes.Empty{})
+	assert.ErrorContains(t, "Fork choice store is not initialized", err)
+}
@@ -0,0 +1,129 @@
+package debugv1
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/status"
+)
+
+var log = logrus.WithField("prefix", "rpc/debugv1")
+
+const (
+	// StateRoutePrefix is the path under which StateHandler serves beacon states.
+	StateRoutePrefix = "/eth/v1/debug/beacon/states/"
+
+	jsonMediaType        = "application/json"
+	octetStreamMediaType = "application/octet-stream"
+)
+
+// StateHandler serves GET /eth/v1/debug/beacon/states/{state_id} over plain HTTP. The state is
+// SSZ encoded for clients which prefer application/octet-stream, and JSON encoded otherwise.
+// It is mounted on the gateway's HTTP mux by the beacon node, so that SSZ responses are
+// written straight from the state rather than travelling through the gRPC and JSON encodings.
+type StateHandler struct {
+	Server *Server
+}
+
+// ServeHTTP writes the state identified by the path's state ID.
+func (h *StateHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, fmt.Sprintf("Method %s not allowed", r.Method))
+		return
+	}
+	stateID := strings.TrimPrefix(r.URL.Path, StateRoutePrefix)
+	if stateID == "" || stateID == r.URL.Path || strings.Contains(stateID, "/") {
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+
+	if prefersSSZ(r.Header.Get("Accept")) {
+		h.writeSSZ(w, r, stateID)
+		return
+	}
+	resp, err := h.Server.GetBeaconState(r.Context(), &ethpb.StateRequest{StateId: []byte(stateID)})
+	if err != nil {
+		writeStatusError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", jsonMediaType)
+	marshaler := &gwruntime.JSONPb{OrigName: true, EmitDefaults: true}
+	if err := marshaler.NewEncoder(w).Encode(resp); err != nil {
+		log.WithError(err).Debug("Could not write state")
+	}
+}
+
+// writeSSZ encodes the state into a buffer of exactly its SSZ size, so the encoded state
+// is only ever held in memory once, and writes it out with a known content length.
+func (h *StateHandler) writeSSZ(w http.ResponseWriter, r *http.Request, stateID string) {
+	ctx, span := trace.StartSpan(r.Context(), "debugV1.GetBeaconStateSSZ")
+	defer span.End()
+
+	st, err := h.Server.stateFromRequest(ctx, []byte(stateID))
+	if err != nil {
+		writeStatusError(w, err)
+		return
+	}
+	inner := st.CloneInnerState()
+	encoded, err := inner.MarshalSSZTo(make([]byte, 0, inner.SizeSSZ()))
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, fmt.Sprintf("Could not encode state: %v", err))
+		return
+	}
+	w.Header().Set("Content-Type", octetStreamMediaType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(encoded)))
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(encoded); err != nil {
+		log.WithError(err).Debug("Could not write state")
+	}
+}
+
+// prefersSSZ reports whether the Accept header ranks application/octet-stream above JSON.
+// JSON is the default, and wins whenever both are equally acceptable.
+func prefersSSZ(accept string) bool {
+	var sszQuality, jsonQuality float64
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, params, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		quality := 1.0
+		if q, ok := params["q"]; ok {
+			if quality, err = strconv.ParseFloat(q, 64); err != nil {
+				continue
+			}
+		}
+		switch mediaType {
+		case octetStreamMediaType:
+			if quality > sszQuality {
+				sszQuality = quality
+			}
+		case jsonMediaType, "application/*", "*/*":
+			if quality > jsonQuality {
+				jsonQuality = quality
+			}
+		}
+	}
+	return sszQuality > jsonQuality
+}
+
+func writeStatusError(w http.ResponseWriter, err error) {
+	s := status.Convert(err)
+	writeError(w, gwruntime.HTTPStatusFromCode(s.Code()), s.Message())
+}
+
+func writeError(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", jsonMediaType)
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(map[string]interface{}{"code": code, "message": message}); err != nil {
+		log.WithError(err).Debug("Could not write error response")
+	}
+}
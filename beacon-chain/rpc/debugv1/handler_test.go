@@ -0,0 +1,119 @@
+package debugv1
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/statefetcher"
+	mockstatefetcher "github.com/prysmaticlabs/prysm/beacon-chain/rpc/statefetcher/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStateHandler_SSZ(t *testing.T) {
+	st, _ := testutil.DeterministicGenesisState(t, 16)
+	h := &StateHandler{Server: &Server{StateFetcher: &mockstatefetcher.MockFetcher{BeaconState: st}}}
+
+	req := httptest.NewRequest(http.MethodGet, StateRoutePrefix+"head", nil)
+	req.Header.Set("Accept", "application/octet-stream")
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/octet-stream", w.Header().Get("Content-Type"))
+	want, err := st.CloneInnerState().MarshalSSZ()
+	require.NoError(t, err)
+	assert.Equal(t, strconv.Itoa(len(want)), w.Header().Get("Content-Length"))
+	assert.DeepEqual(t, want, w.Body.Bytes())
+	decoded := &pb.BeaconState{}
+	require.NoError(t, decoded.UnmarshalSSZ(w.Body.Bytes()))
+	assert.Equal(t, st.GenesisTime(), decoded.GenesisTime)
+}
+
+func TestStateHandler_JSON(t *testing.T) {
+	st, _ := testutil.DeterministicGenesisState(t, 16)
+	require.NoError(t, st.SetSlot(3))
+	h := &StateHandler{Server: &Server{StateFetcher: &mockstatefetcher.MockFetcher{BeaconState: st}}}
+
+	req := httptest.NewRequest(http.MethodGet, StateRoutePrefix+"genesis", nil)
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, req)
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+	resp := &struct {
+		Data struct {
+			Slot       string            `json:"slot"`
+			Validators []json.RawMessage `json:"validators"`
+		} `json:"data"`
+	}{}
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), resp))
+	assert.Equal(t, "3", resp.Data.Slot)
+	assert.Equal(t, 16, len(resp.Data.Validators))
+}
+
+func TestStateHandler_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		err      error
+		wantCode int
+	}{
+		{name: "wrong method", method: http.MethodPost, path: StateRoutePrefix + "head", wantCode: http.StatusMethodNotAllowed},
+		{name: "missing state ID", method: http.MethodGet, path: StateRoutePrefix, wantCode: http.StatusNotFound},
+		{name: "nested path", method: http.MethodGet, path: StateRoutePrefix + "head/root", wantCode: http.StatusNotFound},
+		{name: "invalid state ID", method: http.MethodGet, path: StateRoutePrefix + "foo", err: statefetcher.ErrInvalidStateID, wantCode: http.StatusBadRequest},
+		{name: "state not found", method: http.MethodGet, path: StateRoutePrefix + "100", err: statefetcher.ErrStateNotFound, wantCode: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		for _, accept := range []string{"application/json", "application/octet-stream"} {
+			t.Run(tt.name+" "+accept, func(t *testing.T) {
+				h := &StateHandler{Server: &Server{StateFetcher: &mockstatefetcher.MockFetcher{Err: tt.err}}}
+				req := httptest.NewRequest(tt.method, tt.path, nil)
+				req.Header.Set("Accept", accept)
+				w := httptest.NewRecorder()
+				h.ServeHTTP(w, req)
+				assert.Equal(t, tt.wantCode, w.Code)
+				assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+			})
+		}
+	}
+}
+
+func TestPrefersSSZ(t *testing.T) {
+	tests := []struct {
+		accept string
+		want   bool
+	}{
+		{accept: "", want: false},
+		{accept: "*/*", want: false},
+		{accept: "application/json", want: false},
+		{accept: "application/octet-stream", want: true},
+		{accept: "application/octet-stream, application/json", want: false},
+		{accept: "application/octet-stream, application/json;q=0.9", want: true},
+		{accept: "application/octet-stream;q=0.5, */*", want: false},
+		{accept: "application/octet-stream, */*;q=0.1", want: true},
+		{accept: "application/octet-stream;q=foo", want: false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, prefersSSZ(tt.accept), tt.accept)
+	}
+}
+
+func TestStateHandler_MountedBesideGateway(t *testing.T) {
+	st, _ := testutil.DeterministicGenesisState(t, 16)
+	mux := http.NewServeMux()
+	mux.Handle("/eth/v1/", http.NotFoundHandler())
+	mux.Handle(StateRoutePrefix, &StateHandler{Server: &Server{StateFetcher: &mockstatefetcher.MockFetcher{BeaconState: st}}})
+
+	req := httptest.NewRequest(http.MethodGet, StateRoutePrefix+"head", nil)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code, "Expected the state route to take precedence over the gateway")
+}
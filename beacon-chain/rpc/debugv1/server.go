@@ -0,0 +1,36 @@
+// Package debugv1 defines a gRPC debug service implementation, following the
+// official API standards https://ethereum.github.io/eth2.0-APIs/#/Debug.
+package debugv1
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/statefetcher"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server defines a server implementation of the gRPC Beacon Debug service,
+// providing RPC endpoints to inspect full beacon states and fork choice.
+type Server struct {
+	HeadFetcher  blockchain.HeadFetcher
+	StateFetcher statefetcher.Fetcher
+}
+
+func (ds *Server) stateFromRequest(ctx context.Context, stateID []byte) (*state.BeaconState, error) {
+	st, err := ds.StateFetcher.State(ctx, stateID)
+	if err != nil {
+		switch {
+		case errors.Is(err, statefetcher.ErrInvalidStateID):
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid state ID: %v", err)
+		case errors.Is(err, statefetcher.ErrStateNotFound):
+			return nil, status.Errorf(codes.NotFound, "Could not find state: %v", err)
+		default:
+			return nil, status.Errorf(codes.Internal, "Could not get state: %v", err)
+		}
+	}
+	return st, nil
+}
@@ -0,0 +1,23 @@
+package debugv1
+
+import (
+	"context"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	"go.opencensus.io/trace"
+)
+
+// GetBeaconState returns the full beacon state for the given state ID.
+func (ds *Server) GetBeaconState(ctx context.Context, req *ethpb.StateRequest) (*ethpb.BeaconStateResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "debugV1.GetBeaconState")
+	defer span.End()
+
+	st, err := ds.stateFromRequest(ctx, req.StateId)
+	if err != nil {
+		return nil, err
+	}
+	return &ethpb.BeaconStateResponse{
+		Data: migration.V1Alpha1ToV1State(st.CloneInnerState()),
+	}, nil
+}
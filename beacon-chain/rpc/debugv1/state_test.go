@@ -0,0 +1,52 @@
+package debugv1
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/statefetcher"
+	mockstatefetcher "github.com/prysmaticlabs/prysm/beacon-chain/rpc/statefetcher/testing"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_GetBeaconState(t *testing.T) {
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisState(t, 16)
+	require.NoError(t, st.SetSlot(5))
+	ds := &Server{
+		StateFetcher: &mockstatefetcher.MockFetcher{BeaconState: st},
+	}
+
+	resp, err := ds.GetBeaconState(ctx, &ethpb.StateRequest{StateId: []byte("head")})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), resp.Data.Slot)
+	assert.Equal(t, st.GenesisTime(), resp.Data.GenesisTime)
+	require.Equal(t, st.NumValidators(), len(resp.Data.Validators))
+	assert.DeepEqual(t, st.Validators()[3].PublicKey, resp.Data.Validators[3].PublicKey)
+	assert.DeepEqual(t, st.Balances(), resp.Data.Balances)
+	assert.DeepEqual(t, st.Fork().CurrentVersion, resp.Data.Fork.CurrentVersion)
+	assert.DeepEqual(t, st.Eth1Data().DepositRoot, resp.Data.Eth1Data.DepositRoot)
+}
+
+func TestServer_GetBeaconState_Errors(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name    string
+		err     error
+		wantErr string
+	}{
+		{name: "invalid", err: statefetcher.ErrInvalidStateID, wantErr: "Invalid state ID"},
+		{name: "not found", err: statefetcher.ErrStateNotFound, wantErr: "Could not find state"},
+		{name: "internal", err: context.Canceled, wantErr: "Could not get state"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ds := &Server{StateFetcher: &mockstatefetcher.MockFetcher{Err: tt.err}}
+			_, err := ds.GetBeaconState(ctx, &ethpb.StateRequest{StateId: []byte("foo")})
+			assert.ErrorContains(t, tt.wantErr, err)
+		})
+	}
+}
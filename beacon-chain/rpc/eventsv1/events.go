@@ -0,0 +1,139 @@
+package eventsv1
+
+import (
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+)
+
+// Event topics supported by the events API.
+const (
+	headTopic                = "head"
+	blockTopic               = "block"
+	attestationTopic         = "attestation"
+	finalizedCheckpointTopic = "finalized_checkpoint"
+	chainReorgTopic          = "chain_reorg"
+)
+
+var supportedTopics = map[string]bool{
+	headTopic:                true,
+	blockTopic:               true,
+	attestationTopic:         true,
+	finalizedCheckpointTopic: true,
+	chainReorgTopic:          true,
+}
+
+// The event payloads below follow the JSON encoding of the API specification,
+// with integers as decimal strings and byte arrays as 0x-prefixed hex strings.
+
+type headEvent struct {
+	Slot            uint64        `json:"slot,string"`
+	Block           hexutil.Bytes `json:"block"`
+	State           hexutil.Bytes `json:"state"`
+	EpochTransition bool          `json:"epoch_transition"`
+}
+
+type blockEvent struct {
+	Slot  uint64        `json:"slot,string"`
+	Block hexutil.Bytes `json:"block"`
+}
+
+type finalizedCheckpointEvent struct {
+	Block hexutil.Bytes `json:"block"`
+	State hexutil.Bytes `json:"state"`
+	Epoch uint64        `json:"epoch,string"`
+}
+
+type chainReorgEvent struct {
+	Slot         uint64        `json:"slot,string"`
+	Depth        uint64        `json:"depth,string"`
+	OldHeadBlock hexutil.Bytes `json:"old_head_block"`
+	NewHeadBlock hexutil.Bytes `json:"new_head_block"`
+	OldHeadState hexutil.Bytes `json:"old_head_state"`
+	NewHeadState hexutil.Bytes `json:"new_head_state"`
+	Epoch        uint64        `json:"epoch,string"`
+}
+
+type checkpoint struct {
+	Epoch uint64        `json:"epoch,string"`
+	Root  hexutil.Bytes `json:"root"`
+}
+
+type attestationData struct {
+	Slot            uint64        `json:"slot,string"`
+	Index           uint64        `json:"index,string"`
+	BeaconBlockRoot hexutil.Bytes `json:"beacon_block_root"`
+	Source          *checkpoint   `json:"source"`
+	Target          *checkpoint   `json:"target"`
+}
+
+type attestationEvent struct {
+	AggregationBits hexutil.Bytes    `json:"aggregation_bits"`
+	Data            *attestationData `json:"data"`
+	Signature       hexutil.Bytes    `json:"signature"`
+}
+
+func newHeadEvent(data *statefeed.NewHeadData) *headEvent {
+	return &headEvent{
+		Slot:            data.Slot,
+		Block:           data.BlockRoot[:],
+		State:           data.StateRoot[:],
+		EpochTransition: data.EpochTransition,
+	}
+}
+
+func newFinalizedCheckpointEvent(data *statefeed.FinalizedCheckpointData) *finalizedCheckpointEvent {
+	return &finalizedCheckpointEvent{
+		Block: data.BlockRoot[:],
+		State: data.StateRoot[:],
+		Epoch: data.Epoch,
+	}
+}
+
+func newChainReorgEvent(data *statefeed.ReorgData) *chainReorgEvent {
+	return &chainReorgEvent{
+		Slot:         data.NewSlot,
+		Depth:        data.Depth,
+		OldHeadBlock: data.OldHeadBlockRoot[:],
+		NewHeadBlock: data.NewHeadBlockRoot[:],
+		OldHeadState: data.OldHeadStateRoot[:],
+		NewHeadState: data.NewHeadStateRoot[:],
+		Epoch:        helpers.SlotToEpoch(data.NewSlot),
+	}
+}
+
+func newBlockEvent(blk *ethpb.SignedBeaconBlock) (*blockEvent, error) {
+	root, err := blk.Block.HashTreeRoot()
+	if err != nil {
+		return nil, err
+	}
+	return &blockEvent{
+		Slot:  blk.Block.Slot,
+		Block: root[:],
+	}, nil
+}
+
+func newAttestationEvent(att *ethpb.Attestation) *attestationEvent {
+	return &attestationEvent{
+		AggregationBits: hexutil.Bytes(att.AggregationBits),
+		Data: &attestationData{
+			Slot:            att.Data.Slot,
+			Index:           att.Data.CommitteeIndex,
+			BeaconBlockRoot: att.Data.BeaconBlockRoot,
+			Source:          newCheckpoint(att.Data.Source),
+			Target:          newCheckpoint(att.Data.Target),
+		},
+		Signature: att.Signature,
+	}
+}
+
+func newCheckpoint(cp *ethpb.Checkpoint) *checkpoint {
+	if cp == nil {
+		return nil
+	}
+	return &checkpoint{
+		Epoch: cp.Epoch,
+		Root:  cp.Root,
+	}
+}
@@ -0,0 +1,215 @@
+// Package eventsv1 implements the server-sent events stream of the official
+// Ethereum 2.0 API https://ethereum.github.io/eth2.0-APIs/#/Events.
+package eventsv1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithField("prefix", "rpc/eventsv1")
+
+// eventBufferSize is the number of events buffered per client. Events are dropped
+// for clients which fall further behind, so that slow readers never block the feeds.
+const eventBufferSize = 256
+
+// Server serves the /eth/v1/events stream over HTTP. Unlike the other eth/v1 endpoints,
+// it is not proxied over gRPC: it subscribes to the beacon node's event feeds directly
+// and is mounted on the gateway's HTTP mux by the beacon node.
+type Server struct {
+	Ctx               context.Context
+	StateNotifier     statefeed.Notifier
+	BlockNotifier     blockfeed.Notifier
+	OperationNotifier opfeed.Notifier
+}
+
+type sseEvent struct {
+	topic string
+	data  []byte
+}
+
+// ServeHTTP streams the events of the topics requested with the "topics" query parameter
+// until the client disconnects or the node shuts down.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, fmt.Sprintf("Method %s not allowed", r.Method))
+		return
+	}
+	topics, err := parseTopics(r.URL.Query()["topics"])
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, http.StatusInternalServerError, "Streaming is not supported")
+		return
+	}
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	events := make(chan *sseEvent, eventBufferSize)
+	go s.forwardEvents(ctx, topics, events)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	for {
+		select {
+		case ev, ok := <-events:
+			if !ok {
+				return
+			}
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", ev.topic, ev.data); err != nil {
+				log.WithError(err).Debug("Could not write event")
+				return
+			}
+			flusher.Flush()
+		case <-ctx.Done():
+			return
+		case <-s.Ctx.Done():
+			return
+		}
+	}
+}
+
+// forwardEvents subscribes to the feeds backing the requested topics and forwards matching
+// events to the events channel, which is closed when a subscription fails.
+func (s *Server) forwardEvents(ctx context.Context, topics map[string]bool, events chan<- *sseEvent) {
+	defer close(events)
+
+	var (
+		stateChan   chan *feed.Event
+		blockChan   chan *feed.Event
+		opChan      chan *feed.Event
+		stateSubErr <-chan error
+		blockSubErr <-chan error
+		opSubErr    <-chan error
+	)
+	if topics[headTopic] || topics[finalizedCheckpointTopic] || topics[chainReorgTopic] {
+		stateChan = make(chan *feed.Event, 1)
+		sub := s.StateNotifier.StateFeed().Subscribe(stateChan)
+		defer sub.Unsubscribe()
+		stateSubErr = sub.Err()
+	}
+	if topics[blockTopic] {
+		blockChan = make(chan *feed.Event, 1)
+		sub := s.BlockNotifier.BlockFeed().Subscribe(blockChan)
+		defer sub.Unsubscribe()
+		blockSubErr = sub.Err()
+	}
+	if topics[attestationTopic] {
+		opChan = make(chan *feed.Event, 1)
+		sub := s.OperationNotifier.OperationFeed().Subscribe(opChan)
+		defer sub.Unsubscribe()
+		opSubErr = sub.Err()
+	}
+
+	send := func(topic string, payload interface{}) {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			log.WithError(err).Error("Could not marshal event")
+			return
+		}
+		select {
+		case events <- &sseEvent{topic: topic, data: data}:
+		default:
+			log.WithField("topic", topic).Debug("Dropping event for slow client")
+		}
+	}
+
+	for {
+		select {
+		case ev := <-stateChan:
+			switch data := ev.Data.(type) {
+			case *statefeed.NewHeadData:
+				if topics[headTopic] {
+					send(headTopic, newHeadEvent(data))
+				}
+			case *statefeed.FinalizedCheckpointData:
+				if topics[finalizedCheckpointTopic] {
+					send(finalizedCheckpointTopic, newFinalizedCheckpointEvent(data))
+				}
+			case *statefeed.ReorgData:
+				if topics[chainReorgTopic] {
+					send(chainReorgTopic, newChainReorgEvent(data))
+				}
+			}
+		case ev := <-blockChan:
+			data, ok := ev.Data.(*blockfeed.ReceivedBlockData)
+			if !ok || data.SignedBlock == nil || data.SignedBlock.Block == nil {
+				continue
+			}
+			blk, err := newBlockEvent(data.SignedBlock)
+			if err != nil {
+				log.WithError(err).Error("Could not hash block")
+				continue
+			}
+			send(blockTopic, blk)
+		case ev := <-opChan:
+			switch data := ev.Data.(type) {
+			case *opfeed.UnAggregatedAttReceivedData:
+				if data.Attestation != nil && data.Attestation.Data != nil {
+					send(attestationTopic, newAttestationEvent(data.Attestation))
+				}
+			case *opfeed.AggregatedAttReceivedData:
+				if data.Attestation != nil && data.Attestation.Aggregate != nil && data.Attestation.Aggregate.Data != nil {
+					send(attestationTopic, newAttestationEvent(data.Attestation.Aggregate))
+				}
+			}
+		case err := <-stateSubErr:
+			log.WithError(err).Debug("State feed subscription failed")
+			return
+		case err := <-blockSubErr:
+			log.WithError(err).Debug("Block feed subscription failed")
+			return
+		case err := <-opSubErr:
+			log.WithError(err).Debug("Operation feed subscription failed")
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// parseTopics parses the requested topics, given either as repeated query
+// parameters or as a comma separated list.
+func parseTopics(values []string) (map[string]bool, error) {
+	topics := make(map[string]bool)
+	for _, value := range values {
+		for _, topic := range strings.Split(value, ",") {
+			topic = strings.TrimSpace(topic)
+			if topic == "" {
+				continue
+			}
+			if !supportedTopics[topic] {
+				return nil, fmt.Errorf("invalid topic %q", topic)
+			}
+			topics[topic] = true
+		}
+	}
+	if len(topics) == 0 {
+		return nil, fmt.Errorf("no topics specified")
+	}
+	return topics, nil
+}
+
+func writeError(w http.ResponseWriter, code int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(map[string]interface{}{"code": code, "message": message}); err != nil {
+		log.WithError(err).Debug("Could not write error response")
+	}
+}
@@ -0,0 +1,135 @@
+package eventsv1
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_InvalidRequests(t *testing.T) {
+	s := &Server{Ctx: context.Background()}
+	tests := []struct {
+		name     string
+		method   string
+		url      string
+		wantCode int
+		wantMsg  string
+	}{
+		{name: "no topics", method: http.MethodGet, url: "/eth/v1/events", wantCode: http.StatusBadRequest, wantMsg: "no topics specified"},
+		{name: "unknown topic", method: http.MethodGet, url: "/eth/v1/events?topics=head,foo", wantCode: http.StatusBadRequest, wantMsg: "invalid topic"},
+		{name: "wrong method", method: http.MethodPost, url: "/eth/v1/events?topics=head", wantCode: http.StatusMethodNotAllowed, wantMsg: "not allowed"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			s.ServeHTTP(w, httptest.NewRequest(tt.method, tt.url, nil))
+			assert.Equal(t, tt.wantCode, w.Code)
+			assert.Equal(t, true, strings.Contains(w.Body.String(), tt.wantMsg), w.Body.String())
+		})
+	}
+}
+
+func TestServer_StreamsEvents(t *testing.T) {
+	stateNotifier := &mock.MockStateNotifier{}
+	blockNotifier := &mock.MockBlockNotifier{}
+	opNotifier := &mock.MockOperationNotifier{}
+	stateFeed, blockFeed, opFeed := stateNotifier.StateFeed(), blockNotifier.BlockFeed(), opNotifier.OperationFeed()
+	s := &Server{
+		Ctx:               context.Background(),
+		StateNotifier:     stateNotifier,
+		BlockNotifier:     blockNotifier,
+		OperationNotifier: opNotifier,
+	}
+	srv := httptest.NewServer(s)
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/eth/v1/events?topics=head,chain_reorg&topics=block,attestation")
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, resp.Body.Close())
+	}()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	sendWhenSubscribed(t, stateFeed, &feed.Event{
+		Type: statefeed.NewHead,
+		Data: &statefeed.NewHeadData{Slot: 10, BlockRoot: [32]byte{'a'}, StateRoot: [32]byte{'b'}, EpochTransition: true},
+	})
+	// Finalized checkpoints were not requested and must be filtered out.
+	stateFeed.Send(&feed.Event{
+		Type: statefeed.FinalizedCheckpoint,
+		Data: &statefeed.FinalizedCheckpointData{Epoch: 1},
+	})
+	stateFeed.Send(&feed.Event{
+		Type: statefeed.Reorg,
+		Data: &statefeed.ReorgData{NewSlot: 40, OldSlot: 41, Depth: 2},
+	})
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 7
+	blkRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	sendWhenSubscribed(t, blockFeed, &feed.Event{
+		Type: blockfeed.ReceivedBlock,
+		Data: &blockfeed.ReceivedBlockData{SignedBlock: blk},
+	})
+	att := testutil.NewAttestation()
+	att.Data.Slot = 3
+	sendWhenSubscribed(t, opFeed, &feed.Event{
+		Type: opfeed.UnaggregatedAttReceived,
+		Data: &opfeed.UnAggregatedAttReceivedData{Attestation: att},
+	})
+	sendWhenSubscribed(t, opFeed, &feed.Event{
+		Type: opfeed.AggregatedAttReceived,
+		Data: &opfeed.AggregatedAttReceivedData{Attestation: &ethpb.AggregateAttestationAndProof{Aggregate: att}},
+	})
+
+	reader := bufio.NewReader(resp.Body)
+	data := readEvent(t, reader, "head")
+	assert.Equal(t, fmt.Sprintf(`{"slot":"10","block":"%#x","state":"%#x","epoch_transition":true}`, [32]byte{'a'}, [32]byte{'b'}), data)
+	data = readEvent(t, reader, "chain_reorg")
+	assert.Equal(t, true, strings.Contains(data, `"slot":"40","depth":"2"`), data)
+	data = readEvent(t, reader, "block")
+	assert.Equal(t, fmt.Sprintf(`{"slot":"7","block":"%#x"}`, blkRoot), data)
+	data = readEvent(t, reader, "attestation")
+	assert.Equal(t, true, strings.Contains(data, `"data":{"slot":"3","index":"0"`), data)
+	readEvent(t, reader, "attestation")
+}
+
+// sendWhenSubscribed waits for the server to subscribe to the feed before sending the event.
+func sendWhenSubscribed(t *testing.T, f *event.Feed, ev *feed.Event) {
+	for i := 0; i < 100; i++ {
+		if f.Send(ev) > 0 {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("Server did not subscribe to feed")
+}
+
+// readEvent reads the next event from the stream, checks its topic and returns its data.
+func readEvent(t *testing.T, r *bufio.Reader, wantTopic string) string {
+	line, err := r.ReadString('\n')
+	require.NoError(t, err)
+	require.Equal(t, "event: "+wantTopic+"\n", line)
+	line, err = r.ReadString('\n')
+	require.NoError(t, err)
+	_, err = r.ReadString('\n')
+	require.NoError(t, err)
+	return strings.TrimSuffix(strings.TrimPrefix(line, "data: "), "\n")
+}
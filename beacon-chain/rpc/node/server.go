@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strconv"
 	"time"
 
 	ptypes "github.com/gogo/protobuf/types"
@@ -17,9 +18,11 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
 	"github.com/prysmaticlabs/prysm/shared/version"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
@@ -28,6 +31,7 @@ import (
 // version information, and services the node implements and runs.
 type Server struct {
 	SyncChecker        sync.Checker
+	BackfillChecker    sync.BackfillChecker
 	Server             *grpc.Server
 	BeaconDB           db.ReadOnlyDatabase
 	PeersFetcher       p2p.PeersProvider
@@ -36,8 +40,16 @@ type Server struct {
 	GenesisFetcher     blockchain.GenesisFetcher
 }
 
-// GetSyncStatus checks the current network sync status of the node.
-func (ns *Server) GetSyncStatus(_ context.Context, _ *ptypes.Empty) (*ethpb.SyncStatus, error) {
+// GetSyncStatus checks the current network sync status of the node. While the block history
+// of a node started from a checkpoint is being backfilled, the slot it has been backfilled down
+// to is returned in a response header.
+func (ns *Server) GetSyncStatus(ctx context.Context, _ *ptypes.Empty) (*ethpb.SyncStatus, error) {
+	if ns.BackfillChecker != nil && ns.BackfillChecker.Backfilling() {
+		md := metadata.Pairs(grpcutils.BackfilledSlotMetadataKey, strconv.FormatUint(ns.BackfillChecker.BackfilledSlot(), 10))
+		if err := grpc.SetHeader(ctx, md); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not set backfilled slot header: %v", err)
+		}
+	}
 	return &ethpb.SyncStatus{
 		Syncing: ns.SyncChecker.Syncing(),
 	}, nil
@@ -16,11 +16,13 @@ import (
 	mockP2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	"github.com/prysmaticlabs/prysm/shared/version"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -38,6 +40,44 @@ func TestNodeServer_GetSyncStatus(t *testing.T) {
 	assert.Equal(t, true, res.Syncing)
 }
 
+func TestNodeServer_GetSyncStatus_Backfilling(t *testing.T) {
+	stream := &headerCapturingStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	ns := &Server{
+		SyncChecker:     &mockSync.Sync{IsSynced: true},
+		BackfillChecker: &mockSync.Backfill{IsBackfilling: true, Slot: 100},
+	}
+	res, err := ns.GetSyncStatus(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, false, res.Syncing)
+	assert.DeepEqual(t, []string{"100"}, stream.md.Get(grpcutils.BackfilledSlotMetadataKey))
+
+	stream = &headerCapturingStream{}
+	ctx = grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	ns.BackfillChecker = &mockSync.Backfill{}
+	_, err = ns.GetSyncStatus(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(stream.md.Get(grpcutils.BackfilledSlotMetadataKey)))
+}
+
+// headerCapturingStream records the headers set by a server method.
+type headerCapturingStream struct {
+	md metadata.MD
+}
+
+func (s *headerCapturingStream) Method() string { return "" }
+
+func (s *headerCapturingStream) SetHeader(md metadata.MD) error {
+	s.md = metadata.Join(s.md, md)
+	return nil
+}
+
+func (s *headerCapturingStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+func (s *headerCapturingStream) SetTrailer(_ metadata.MD) error { return nil }
+
 func TestNodeServer_GetGenesis(t *testing.T) {
 	db, _ := dbutil.SetupDB(t)
 	ctx := context.Background()
@@ -2,37 +2,152 @@ package nodev1
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"runtime"
+	"strconv"
 
 	ptypes "github.com/gogo/protobuf/types"
-	"github.com/pkg/errors"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 // GetIdentity retrieves data about the node's network presence.
 func (ns *Server) GetIdentity(ctx context.Context, _ *ptypes.Empty) (*ethpb.IdentityResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "nodeV1.GetIdentity")
+	defer span.End()
+
+	peerID := ns.PeerManager.PeerID().String()
+
+	serializedEnr := ""
+	if record := ns.PeerManager.ENR(); record != nil {
+		var err error
+		serializedEnr, err = p2p.SerializeENR(record)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not obtain enr: %v", err)
+		}
+	}
+
+	p2pAddresses := make([]string, 0)
+	for _, addr := range ns.PeerManager.Host().Addrs() {
+		p2pAddresses = append(p2pAddresses, fmt.Sprintf("%s/p2p/%s", addr.String(), peerID))
+	}
+
+	discoveryAddresses := make([]string, 0)
+	discAddrs, err := ns.PeerManager.DiscoveryAddresses()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not obtain discovery address: %v", err)
+	}
+	for _, addr := range discAddrs {
+		discoveryAddresses = append(discoveryAddresses, addr.String())
+	}
+
+	meta := &ethpb.Metadata{
+		SeqNumber: ns.MetadataProvider.MetadataSeq(),
+	}
+	if md := ns.MetadataProvider.Metadata(); md != nil {
+		meta.Attnets = md.Attnets
+	}
+
+	return &ethpb.IdentityResponse{
+		Data: &ethpb.Identity{
+			PeerId:             peerID,
+			Enr:                serializedEnr,
+			P2PAddresses:       p2pAddresses,
+			DiscoveryAddresses: discoveryAddresses,
+			Metadata:           meta,
+		},
+	}, nil
 }
 
 // GetPeer retrieves data about the given peer.
 func (ns *Server) GetPeer(ctx context.Context, req *ethpb.PeerRequest) (*ethpb.PeerResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "nodeV1.GetPeer")
+	defer span.End()
+
+	pid, err := peer.Decode(req.PeerId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid peer ID: %v", err)
+	}
+	p, err := ns.peerInfo(pid)
+	if err != nil {
+		return nil, err
+	}
+	return &ethpb.PeerResponse{Data: p}, nil
 }
 
 // ListPeers retrieves data about the node's network peers.
 func (ns *Server) ListPeers(ctx context.Context, _ *ptypes.Empty) (*ethpb.PeersResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "nodeV1.ListPeers")
+	defer span.End()
+
+	allPeers := ns.PeersFetcher.Peers().All()
+	res := make([]*ethpb.Peer, 0, len(allPeers))
+	for _, pid := range allPeers {
+		if ctx.Err() != nil {
+			return nil, status.Errorf(codes.Canceled, "Request canceled: %v", ctx.Err())
+		}
+		p, err := ns.peerInfo(pid)
+		if err != nil {
+			// Peers may be pruned from the status tracker between listing and lookup.
+			if status.Code(err) == codes.NotFound {
+				continue
+			}
+			return nil, err
+		}
+		res = append(res, p)
+	}
+	return &ethpb.PeersResponse{Data: res}, nil
 }
 
 // GetVersion requests that the beacon node identify information about its implementation in a
 // format similar to a HTTP User-Agent field.
 func (ns *Server) GetVersion(ctx context.Context, _ *ptypes.Empty) (*ethpb.VersionResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "nodeV1.GetVersion")
+	defer span.End()
+
+	v := fmt.Sprintf("%s (%s %s)", version.GetBuildData(), runtime.GOOS, runtime.GOARCH)
+	return &ethpb.VersionResponse{
+		Data: &ethpb.Version{
+			Version: v,
+		},
+	}, nil
 }
 
 // GetSyncStatus requests the beacon node to describe if it's currently syncing or not, and
-// if it is, what block it is up to.
+// if it is, what block it is up to. While the block history of a node started from a checkpoint
+// is being backfilled, the slot it has been backfilled down to is returned in a response header.
 func (ns *Server) GetSyncStatus(ctx context.Context, _ *ptypes.Empty) (*ethpb.SyncingResponse, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "nodeV1.GetSyncStatus")
+	defer span.End()
+
+	headSlot := ns.HeadFetcher.HeadSlot()
+	currentSlot := ns.GenesisTimeFetcher.CurrentSlot()
+	syncDistance := uint64(0)
+	if currentSlot > headSlot {
+		syncDistance = currentSlot - headSlot
+	}
+	if ns.BackfillChecker != nil && ns.BackfillChecker.Backfilling() {
+		md := metadata.Pairs(grpcutils.BackfilledSlotMetadataKey, strconv.FormatUint(ns.BackfillChecker.BackfilledSlot(), 10))
+		if err := grpc.SetHeader(ctx, md); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not set backfilled slot header: %v", err)
+		}
+	}
+	return &ethpb.SyncingResponse{
+		Data: &ethpb.SyncInfo{
+			HeadSlot:     headSlot,
+			SyncDistance: syncDistance,
+		},
+	}, nil
 }
 
 // GetHealth returns node health status in http status codes. Useful for load balancers.
@@ -40,9 +155,73 @@ func (ns *Server) GetSyncStatus(ctx context.Context, _ *ptypes.Empty) (*ethpb.Sy
 //    "200":
 //      description: Node is ready
 //    "206":
-//      description: Node is syncing but can serve incomplete data
+//      description: Node is syncing, or backfilling its block history, but can serve incomplete data
 //    "503":
 //      description: Node not initialized or having issues
 func (ns *Server) GetHealth(ctx context.Context, _ *ptypes.Empty) (*ptypes.Empty, error) {
-	return nil, errors.New("unimplemented")
+	ctx, span := trace.StartSpan(ctx, "nodeV1.GetHealth")
+	defer span.End()
+
+	backfilling := ns.BackfillChecker != nil && ns.BackfillChecker.Backfilling()
+	if ns.SyncChecker.Synced() && !backfilling {
+		return &ptypes.Empty{}, nil
+	}
+	if ns.SyncChecker.Synced() || (ns.SyncChecker.Initialized() && ns.SyncChecker.Syncing()) {
+		md := metadata.Pairs(grpcutils.HTTPCodeMetadataKey, strconv.Itoa(http.StatusPartialContent))
+		if err := grpc.SetHeader(ctx, md); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not set custom success code header: %v", err)
+		}
+		return &ptypes.Empty{}, nil
+	}
+	return nil, status.Error(codes.Unavailable, "Node not initialized or having issues")
+}
+
+// peerInfo assembles the eth/v1 representation of a peer known to the peer status tracker.
+func (ns *Server) peerInfo(pid peer.ID) (*ethpb.Peer, error) {
+	peers := ns.PeersFetcher.Peers()
+	connState, err := peers.ConnectionState(pid)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Requested peer does not exist: %v", err)
+	}
+	dir, err := peers.Direction(pid)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Requested peer does not exist: %v", err)
+	}
+	addr, err := peers.Address(pid)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Requested peer does not exist: %v", err)
+	}
+	record, err := peers.ENR(pid)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "Requested peer does not exist: %v", err)
+	}
+	serializedEnr := ""
+	if record != nil {
+		serializedEnr, err = p2p.SerializeENR(record)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not serialize enr: %v", err)
+		}
+	}
+	address := ""
+	if addr != nil {
+		address = addr.String()
+	}
+	return &ethpb.Peer{
+		PeerId:    pid.String(),
+		Enr:       serializedEnr,
+		Address:   address,
+		State:     ethpb.ConnectionState(connState),
+		Direction: peerDirection(dir),
+	}, nil
+}
+
+func peerDirection(dir network.Direction) ethpb.PeerDirection {
+	switch dir {
+	case network.DirInbound:
+		return ethpb.PeerDirection_INBOUND
+	case network.DirOutbound:
+		return ethpb.PeerDirection_OUTBOUND
+	default:
+		return ethpb.PeerDirection_UNKNOWN
+	}
 }
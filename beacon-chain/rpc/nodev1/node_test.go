@@ -0,0 +1,248 @@
+package nodev1
+
+import (
+	"context"
+	"fmt"
+	"runtime"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	mockp2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	syncmock "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	"github.com/prysmaticlabs/prysm/shared/grpcutils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// headerCapturingStream is a grpc.ServerTransportStream recording the headers set by a handler.
+type headerCapturingStream struct {
+	md metadata.MD
+}
+
+func (s *headerCapturingStream) Method() string { return "" }
+
+func (s *headerCapturingStream) SetHeader(md metadata.MD) error {
+	s.md = metadata.Join(s.md, md)
+	return nil
+}
+
+func (s *headerCapturingStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+func (s *headerCapturingStream) SetTrailer(_ metadata.MD) error { return nil }
+
+func TestGetIdentity(t *testing.T) {
+	ctx := context.Background()
+	host := mockp2p.NewTestP2P(t).BHost
+	discAddr1, err := ma.NewMultiaddr("/ip4/7.7.7.7/udp/30303/p2p/QmYyQSo1c1Ym7orWxLYvCrM2EmxFTANf8wXmmE7DWjhx5N")
+	require.NoError(t, err)
+	discAddr2, err := ma.NewMultiaddr("/ip6/1:2:3:4:5:6:7:8/udp/20202/p2p/QmYyQSo1c1Ym7orWxLYvCrM2EmxFTANf8wXmmE7DWjhx5N")
+	require.NoError(t, err)
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	db, err := enode.OpenDB("")
+	require.NoError(t, err)
+	enrRecord := enode.NewLocalNode(db, key).Node().Record()
+	attnets := bitfield.NewBitvector64()
+	attnets.SetBitAt(1, true)
+	metadataProvider := &mockp2p.MockMetadataProvider{Data: &pb.MetaData{SeqNumber: 1, Attnets: attnets}}
+
+	t.Run("OK", func(t *testing.T) {
+		peerManager := &mockp2p.MockPeerManager{
+			Enr:           enrRecord,
+			PID:           host.ID(),
+			BHost:         host,
+			DiscoveryAddr: []ma.Multiaddr{discAddr1, discAddr2},
+		}
+		s := &Server{
+			PeerManager:      peerManager,
+			MetadataProvider: metadataProvider,
+		}
+
+		resp, err := s.GetIdentity(ctx, &ptypes.Empty{})
+		require.NoError(t, err)
+		assert.Equal(t, host.ID().String(), resp.Data.PeerId)
+		expectedEnr, err := p2p.SerializeENR(enrRecord)
+		require.NoError(t, err)
+		assert.Equal(t, expectedEnr, resp.Data.Enr)
+		require.Equal(t, len(host.Addrs()), len(resp.Data.P2PAddresses))
+		for i, addr := range host.Addrs() {
+			assert.Equal(t, addr.String()+"/p2p/"+host.ID().String(), resp.Data.P2PAddresses[i])
+		}
+		require.Equal(t, 2, len(resp.Data.DiscoveryAddresses))
+		assert.Equal(t, discAddr1.String(), resp.Data.DiscoveryAddresses[0])
+		assert.Equal(t, discAddr2.String(), resp.Data.DiscoveryAddresses[1])
+		assert.Equal(t, uint64(1), resp.Data.Metadata.SeqNumber)
+		assert.DeepEqual(t, attnets, resp.Data.Metadata.Attnets)
+	})
+
+	t.Run("Discovery addresses failure", func(t *testing.T) {
+		peerManager := &mockp2p.MockPeerManager{
+			Enr:               enrRecord,
+			PID:               host.ID(),
+			BHost:             host,
+			DiscoveryAddr:     []ma.Multiaddr{discAddr1, discAddr2},
+			FailDiscoveryAddr: true,
+		}
+		s := &Server{
+			PeerManager:      peerManager,
+			MetadataProvider: metadataProvider,
+		}
+
+		_, err := s.GetIdentity(ctx, &ptypes.Empty{})
+		require.NotNil(t, err)
+		assert.ErrorContains(t, "Could not obtain discovery address", err)
+	})
+}
+
+func TestGetPeer(t *testing.T) {
+	ctx := context.Background()
+	peersProvider := &mockp2p.MockPeersProvider{}
+	s := &Server{PeersFetcher: peersProvider}
+	firstPeer := peersProvider.Peers().All()[0]
+
+	t.Run("OK", func(t *testing.T) {
+		resp, err := s.GetPeer(ctx, &ethpb.PeerRequest{PeerId: firstPeer.String()})
+		require.NoError(t, err)
+		assert.Equal(t, firstPeer.String(), resp.Data.PeerId)
+		assert.Equal(t, "/ip4/213.202.254.180/tcp/13000", resp.Data.Address)
+		assert.Equal(t, ethpb.ConnectionState_CONNECTED, resp.Data.State)
+		assert.Equal(t, ethpb.PeerDirection_INBOUND, resp.Data.Direction)
+		assert.NotEqual(t, "", resp.Data.Enr)
+	})
+
+	t.Run("Invalid ID", func(t *testing.T) {
+		_, err := s.GetPeer(ctx, &ethpb.PeerRequest{PeerId: "foo"})
+		assert.ErrorContains(t, "Invalid peer ID", err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("Peer not found", func(t *testing.T) {
+		_, err := s.GetPeer(ctx, &ethpb.PeerRequest{PeerId: "16Uiu2HAmQqFdEcHbSmQTQuLoAhnMUrgoWoraKK4cUJT6FuuqHqTU"})
+		assert.ErrorContains(t, "Requested peer does not exist", err)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestListPeers(t *testing.T) {
+	ctx := context.Background()
+	peersProvider := &mockp2p.MockPeersProvider{}
+	connected := peersProvider.Peers().All()
+	disconnected, err := peer.Decode("16Uiu2HAmQqFdEcHbSmQTQuLoAhnMUrgoWoraKK4cUJT6FuuqHqTU")
+	require.NoError(t, err)
+	addr, err := ma.NewMultiaddr("/ip4/10.0.0.1/tcp/13000")
+	require.NoError(t, err)
+	peersProvider.Peers().Add(nil, disconnected, addr, network.DirOutbound)
+	peersProvider.Peers().SetConnectionState(disconnected, peers.PeerDisconnected)
+	s := &Server{PeersFetcher: peersProvider}
+
+	resp, err := s.ListPeers(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, len(connected)+1, len(resp.Data))
+	for _, p := range resp.Data {
+		if p.PeerId == disconnected.String() {
+			assert.Equal(t, ethpb.ConnectionState_DISCONNECTED, p.State)
+			assert.Equal(t, ethpb.PeerDirection_OUTBOUND, p.Direction)
+			assert.Equal(t, addr.String(), p.Address)
+			assert.Equal(t, "", p.Enr)
+			continue
+		}
+		assert.Equal(t, ethpb.ConnectionState_CONNECTED, p.State)
+	}
+}
+
+func TestGetVersion(t *testing.T) {
+	s := &Server{}
+	resp, err := s.GetVersion(context.Background(), &ptypes.Empty{})
+	require.NoError(t, err)
+	expected := fmt.Sprintf("%s (%s %s)", version.GetBuildData(), runtime.GOOS, runtime.GOARCH)
+	assert.Equal(t, expected, resp.Data.Version)
+}
+
+func TestGetSyncStatus(t *testing.T) {
+	headState := testutil.NewBeaconState()
+	require.NoError(t, headState.SetSlot(100))
+	genesis := time.Now().Add(-time.Duration(110*params.BeaconConfig().SecondsPerSlot) * time.Second)
+	chain := &mock.ChainService{State: headState, Genesis: genesis}
+	s := &Server{
+		HeadFetcher:        chain,
+		GenesisTimeFetcher: chain,
+	}
+
+	resp, err := s.GetSyncStatus(context.Background(), &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(100), resp.Data.HeadSlot)
+	assert.Equal(t, uint64(10), resp.Data.SyncDistance)
+
+	stream := &headerCapturingStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	s.BackfillChecker = &syncmock.Backfill{IsBackfilling: true, Slot: 64}
+	_, err = s.GetSyncStatus(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []string{"64"}, stream.md.Get(grpcutils.BackfilledSlotMetadataKey))
+}
+
+func TestGetHealth(t *testing.T) {
+	t.Run("Synced", func(t *testing.T) {
+		stream := &headerCapturingStream{}
+		ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+		s := &Server{SyncChecker: &syncmock.Sync{IsInitialized: true, IsSynced: true}}
+		_, err := s.GetHealth(ctx, &ptypes.Empty{})
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(stream.md.Get(grpcutils.HTTPCodeMetadataKey)))
+	})
+
+	t.Run("Syncing", func(t *testing.T) {
+		stream := &headerCapturingStream{}
+		ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+		s := &Server{SyncChecker: &syncmock.Sync{IsInitialized: true, IsSyncing: true}}
+		_, err := s.GetHealth(ctx, &ptypes.Empty{})
+		require.NoError(t, err)
+		codesHeader := stream.md.Get(grpcutils.HTTPCodeMetadataKey)
+		require.Equal(t, 1, len(codesHeader))
+		assert.Equal(t, strconv.Itoa(206), codesHeader[0])
+	})
+
+	t.Run("Backfilling", func(t *testing.T) {
+		stream := &headerCapturingStream{}
+		ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+		s := &Server{
+			SyncChecker:     &syncmock.Sync{IsInitialized: true, IsSynced: true},
+			BackfillChecker: &syncmock.Backfill{IsBackfilling: true, Slot: 100},
+		}
+		_, err := s.GetHealth(ctx, &ptypes.Empty{})
+		require.NoError(t, err)
+		codesHeader := stream.md.Get(grpcutils.HTTPCodeMetadataKey)
+		require.Equal(t, 1, len(codesHeader))
+		assert.Equal(t, strconv.Itoa(206), codesHeader[0])
+	})
+
+	t.Run("Not initialized", func(t *testing.T) {
+		stream := &headerCapturingStream{}
+		ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+		s := &Server{SyncChecker: &syncmock.Sync{IsSyncing: true}}
+		_, err := s.GetHealth(ctx, &ptypes.Empty{})
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+}
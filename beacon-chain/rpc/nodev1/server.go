@@ -16,10 +16,13 @@ import (
 // version information.
 type Server struct {
 	SyncChecker        sync.Checker
+	BackfillChecker    sync.BackfillChecker
 	Server             *grpc.Server
 	BeaconDB           db.ReadOnlyDatabase
 	PeersFetcher       p2p.PeersProvider
 	PeerManager        p2p.PeerManager
+	MetadataProvider   p2p.MetadataProvider
+	HeadFetcher        blockchain.HeadFetcher
 	GenesisTimeFetcher blockchain.TimeFetcher
 	GenesisFetcher     blockchain.GenesisFetcher
 }
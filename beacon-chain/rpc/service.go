@@ -30,7 +30,10 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/beacon"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/beaconv1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/debug"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/debugv1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/node"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/nodev1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/statefetcher"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/validator"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	chainSync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
@@ -76,6 +79,7 @@ type Service struct {
 	exitPool                *voluntaryexits.Pool
 	slashingsPool           *slashings.Pool
 	syncService             chainSync.Checker
+	backfillChecker         chainSync.BackfillChecker
 	host                    string
 	port                    string
 	listener                net.Listener
@@ -88,6 +92,8 @@ type Service struct {
 	p2p                     p2p.Broadcaster
 	peersFetcher            p2p.PeersProvider
 	peerManager             p2p.PeerManager
+	peerAdmin               p2p.PeerAdmin
+	metadataProvider        p2p.MetadataProvider
 	depositFetcher          depositcache.DepositFetcher
 	pendingDepositFetcher   depositcache.PendingDepositsFetcher
 	stateNotifier           statefeed.Notifier
@@ -122,9 +128,12 @@ type Config struct {
 	ExitPool                *voluntaryexits.Pool
 	SlashingsPool           *slashings.Pool
 	SyncService             chainSync.Checker
+	BackfillChecker         chainSync.BackfillChecker
 	Broadcaster             p2p.Broadcaster
 	PeersFetcher            p2p.PeersProvider
 	PeerManager             p2p.PeerManager
+	PeerAdmin               p2p.PeerAdmin
+	MetadataProvider        p2p.MetadataProvider
 	DepositFetcher          depositcache.DepositFetcher
 	PendingDepositFetcher   depositcache.PendingDepositsFetcher
 	StateNotifier           statefeed.Notifier
@@ -153,6 +162,8 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		p2p:                     cfg.Broadcaster,
 		peersFetcher:            cfg.PeersFetcher,
 		peerManager:             cfg.PeerManager,
+		peerAdmin:               cfg.PeerAdmin,
+		metadataProvider:        cfg.MetadataProvider,
 		powChainService:         cfg.POWChainService,
 		chainStartFetcher:       cfg.ChainStartFetcher,
 		mockEth1Votes:           cfg.MockEth1Votes,
@@ -160,6 +171,7 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		exitPool:                cfg.ExitPool,
 		slashingsPool:           cfg.SlashingsPool,
 		syncService:             cfg.SyncService,
+		backfillChecker:         cfg.BackfillChecker,
 		host:                    cfg.Host,
 		port:                    cfg.Port,
 		withCert:                cfg.CertFlag,
@@ -253,11 +265,24 @@ func (s *Service) Start() {
 		BeaconDB:           s.beaconDB,
 		Server:             s.grpcServer,
 		SyncChecker:        s.syncService,
+		BackfillChecker:    s.backfillChecker,
 		GenesisTimeFetcher: s.genesisTimeFetcher,
 		PeersFetcher:       s.peersFetcher,
 		PeerManager:        s.peerManager,
 		GenesisFetcher:     s.genesisFetcher,
 	}
+	nodeServerV1 := &nodev1.Server{
+		BeaconDB:           s.beaconDB,
+		Server:             s.grpcServer,
+		SyncChecker:        s.syncService,
+		BackfillChecker:    s.backfillChecker,
+		GenesisTimeFetcher: s.genesisTimeFetcher,
+		PeersFetcher:       s.peersFetcher,
+		PeerManager:        s.peerManager,
+		MetadataProvider:   s.metadataProvider,
+		HeadFetcher:        s.headFetcher,
+		GenesisFetcher:     s.genesisFetcher,
+	}
 	beaconChainServer := &beacon.Server{
 		Ctx:                         s.ctx,
 		BeaconDB:                    s.beaconDB,
@@ -295,9 +320,15 @@ func (s *Service) Start() {
 		AttestationNotifier: s.operationNotifier,
 		Broadcaster:         s.p2p,
 		StateGen:            s.stateGen,
-		SyncChecker:         s.syncService,
+		StateFetcher: &statefetcher.StateProvider{
+			ChainInfoFetcher:   s.chainInfoFetcher,
+			GenesisTimeFetcher: s.genesisTimeFetcher,
+			StateGen:           s.stateGen,
+		},
+		SyncChecker: s.syncService,
 	}
 	ethpb.RegisterNodeServer(s.grpcServer, nodeServer)
+	ethpbv1.RegisterBeaconNodeServer(s.grpcServer, nodeServerV1)
 	ethpb.RegisterBeaconChainServer(s.grpcServer, beaconChainServer)
 	ethpbv1.RegisterBeaconChainServer(s.grpcServer, beaconChainServerV1)
 	if s.enableDebugRPCEndpoints {
@@ -309,10 +340,17 @@ func (s *Service) Start() {
 			HeadFetcher:        s.headFetcher,
 			PeerManager:        s.peerManager,
 			PeersFetcher:       s.peersFetcher,
+			PeerAdmin:          s.peerAdmin,
 		}
 		pbrpc.RegisterDebugServer(s.grpcServer, debugServer)
+		debugServerV1 := &debugv1.Server{
+			HeadFetcher:  s.headFetcher,
+			StateFetcher: beaconChainServerV1.StateFetcher,
+		}
+		ethpbv1.RegisterBeaconDebugServer(s.grpcServer, debugServerV1)
 	}
 	ethpb.RegisterBeaconNodeValidatorServer(s.grpcServer, validatorServer)
+	pbrpc.RegisterLivenessServer(s.grpcServer, validatorServer)
 
 	// Register reflection service on gRPC server.
 	reflection.Register(s.grpcServer)
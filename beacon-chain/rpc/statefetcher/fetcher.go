@@ -0,0 +1,155 @@
+// Package statefetcher resolves the state_id parameter used throughout the
+// official Ethereum 2.0 API https://ethereum.github.io/eth2.0-APIs/#/ into a beacon state.
+package statefetcher
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+)
+
+var (
+	// ErrInvalidStateID is returned when a state ID is neither a supported
+	// keyword, a slot nor a 32 byte state root.
+	ErrInvalidStateID = errors.New("invalid state ID")
+	// ErrStateNotFound is returned when a well-formed state ID does not
+	// correspond to any state known to the node.
+	ErrStateNotFound = errors.New("state not found")
+)
+
+// Fetcher is responsible for retrieving the beacon state identified by a state ID.
+type Fetcher interface {
+	State(ctx context.Context, stateID []byte) (*state.BeaconState, error)
+}
+
+// StateProvider is the default implementation of Fetcher. States are regenerated
+// through the state generator, so any slot or root in the canonical chain can be served.
+type StateProvider struct {
+	ChainInfoFetcher   blockchain.ChainInfoFetcher
+	GenesisTimeFetcher blockchain.TimeFetcher
+	StateGen           *stategen.State
+}
+
+// State returns the beacon state corresponding to the given state ID. The state ID may be one of
+// "head", "genesis", "finalized", "justified", a decimal slot, or a 0x-prefixed hex encoded state root.
+// A raw 32 byte state root is accepted as well, for gRPC clients.
+func (p *StateProvider) State(ctx context.Context, stateID []byte) (*state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "statefetcher.State")
+	defer span.End()
+
+	var (
+		st  *state.BeaconState
+		err error
+	)
+	id := string(stateID)
+	switch id {
+	case "head":
+		st, err = p.ChainInfoFetcher.HeadState(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get head state")
+		}
+	case "genesis":
+		st, err = p.StateGen.StateBySlot(ctx, 0)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get genesis state")
+		}
+	case "finalized":
+		cp := p.ChainInfoFetcher.FinalizedCheckpt()
+		if cp == nil {
+			return nil, ErrStateNotFound
+		}
+		st, err = p.StateGen.StateByRoot(ctx, bytesutil.ToBytes32(cp.Root))
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get finalized state")
+		}
+	case "justified":
+		cp := p.ChainInfoFetcher.CurrentJustifiedCheckpt()
+		if cp == nil {
+			return nil, ErrStateNotFound
+		}
+		st, err = p.StateGen.StateByRoot(ctx, bytesutil.ToBytes32(cp.Root))
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get justified state")
+		}
+	default:
+		switch {
+		case len(stateID) == 32:
+			st, err = p.stateByStateRoot(ctx, stateID)
+		case strings.HasPrefix(id, "0x"):
+			root, decodeErr := hex.DecodeString(id[2:])
+			if decodeErr != nil || len(root) != 32 {
+				return nil, errors.Wrapf(ErrInvalidStateID, "could not decode state root %q", id)
+			}
+			st, err = p.stateByStateRoot(ctx, root)
+		default:
+			slot, parseErr := strconv.ParseUint(id, 10, 64)
+			if parseErr != nil {
+				return nil, errors.Wrapf(ErrInvalidStateID, "could not parse state ID %q", id)
+			}
+			st, err = p.stateBySlot(ctx, slot)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if st == nil {
+		return nil, ErrStateNotFound
+	}
+	return st, nil
+}
+
+func (p *StateProvider) stateBySlot(ctx context.Context, slot uint64) (*state.BeaconState, error) {
+	if slot > p.GenesisTimeFetcher.CurrentSlot() {
+		return nil, errors.Wrapf(ErrStateNotFound, "slot %d is in the future", slot)
+	}
+	st, err := p.StateGen.StateBySlot(ctx, slot)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not get state at slot %d", slot)
+	}
+	return st, nil
+}
+
+// stateByStateRoot looks for the state root in the head state and in its historical state roots,
+// which cover the last SLOTS_PER_HISTORICAL_ROOT slots, and regenerates the matching state by slot.
+func (p *StateProvider) stateByStateRoot(ctx context.Context, stateRoot []byte) (*state.BeaconState, error) {
+	headState, err := p.ChainInfoFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get head state")
+	}
+	if headState == nil {
+		return nil, ErrStateNotFound
+	}
+	headRoot, err := headState.HashTreeRoot(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not hash head state")
+	}
+	if bytes.Equal(headRoot[:], stateRoot) {
+		return headState, nil
+	}
+
+	headSlot := headState.Slot()
+	historyLength := params.BeaconConfig().SlotsPerHistoricalRoot
+	for i, root := range headState.StateRoots() {
+		if !bytes.Equal(root, stateRoot) {
+			continue
+		}
+		// Entry i holds the state root of the most recent slot before the head slot
+		// which is congruent to i modulo the history length.
+		distance := (headSlot - 1 + historyLength - uint64(i)) % historyLength
+		if headSlot == 0 || distance > headSlot-1 {
+			continue
+		}
+		return p.StateGen.StateBySlot(ctx, headSlot-1-distance)
+	}
+	return nil, errors.Wrapf(ErrStateNotFound, "no state with root %#x", stateRoot)
+}
@@ -0,0 +1,115 @@
+package statefetcher
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbTest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStateProvider_State(t *testing.T) {
+	ctx := context.Background()
+	db, sc := dbTest.SetupDB(t)
+
+	genesisState, _ := testutil.DeterministicGenesisState(t, 64)
+	genesisBlock := testutil.NewBeaconBlock()
+	require.NoError(t, db.SaveBlock(ctx, genesisBlock))
+	genesisRoot, err := genesisBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+	require.NoError(t, db.SaveState(ctx, genesisState, genesisRoot))
+
+	checkpointState := genesisState.Copy()
+	require.NoError(t, checkpointState.SetSlot(params.BeaconConfig().SlotsPerEpoch))
+	checkpointBlock := testutil.NewBeaconBlock()
+	checkpointBlock.Block.Slot = params.BeaconConfig().SlotsPerEpoch
+	require.NoError(t, db.SaveBlock(ctx, checkpointBlock))
+	checkpointRoot, err := checkpointBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, checkpointState, checkpointRoot))
+
+	headState := genesisState.Copy()
+	require.NoError(t, headState.SetSlot(2*params.BeaconConfig().SlotsPerEpoch))
+	historicalRoot := bytesutil.PadTo([]byte("historical"), 32)
+	require.NoError(t, headState.UpdateStateRootAtIndex(3, bytesutil.ToBytes32(historicalRoot)))
+	headRoot, err := headState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+
+	secondsPerEpoch := params.BeaconConfig().SecondsPerSlot * params.BeaconConfig().SlotsPerEpoch
+	chainService := &mock.ChainService{
+		State:                      headState,
+		Genesis:                    time.Now().Add(-time.Duration(3*secondsPerEpoch) * time.Second),
+		FinalizedCheckPoint:        &ethpb.Checkpoint{Epoch: 1, Root: checkpointRoot[:]},
+		CurrentJustifiedCheckPoint: &ethpb.Checkpoint{Epoch: 0, Root: genesisRoot[:]},
+	}
+	p := &StateProvider{
+		ChainInfoFetcher:   chainService,
+		GenesisTimeFetcher: chainService,
+		StateGen:           stategen.New(db, sc),
+	}
+
+	tests := []struct {
+		name     string
+		stateID  []byte
+		wantSlot uint64
+	}{
+		{name: "head", stateID: []byte("head"), wantSlot: headState.Slot()},
+		{name: "genesis", stateID: []byte("genesis"), wantSlot: 0},
+		{name: "finalized", stateID: []byte("finalized"), wantSlot: params.BeaconConfig().SlotsPerEpoch},
+		{name: "justified", stateID: []byte("justified"), wantSlot: 0},
+		{name: "slot", stateID: []byte("5"), wantSlot: 5},
+		{name: "hex head root", stateID: []byte(fmt.Sprintf("%#x", headRoot)), wantSlot: headState.Slot()},
+		{name: "raw head root", stateID: headRoot[:], wantSlot: headState.Slot()},
+		{name: "historical root", stateID: []byte(fmt.Sprintf("%#x", historicalRoot)), wantSlot: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			st, err := p.State(ctx, tt.stateID)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSlot, st.Slot())
+		})
+	}
+}
+
+func TestStateProvider_State_Errors(t *testing.T) {
+	ctx := context.Background()
+	db, sc := dbTest.SetupDB(t)
+	headState, _ := testutil.DeterministicGenesisState(t, 64)
+	chainService := &mock.ChainService{
+		State:   headState,
+		Genesis: time.Now(),
+	}
+	p := &StateProvider{
+		ChainInfoFetcher:   chainService,
+		GenesisTimeFetcher: chainService,
+		StateGen:           stategen.New(db, sc),
+	}
+
+	tests := []struct {
+		name    string
+		stateID []byte
+		wantErr error
+	}{
+		{name: "garbage", stateID: []byte("foo"), wantErr: ErrInvalidStateID},
+		{name: "short hex root", stateID: []byte("0x1234"), wantErr: ErrInvalidStateID},
+		{name: "future slot", stateID: []byte("1000"), wantErr: ErrStateNotFound},
+		{name: "unknown root", stateID: bytesutil.PadTo([]byte("unknown"), 32), wantErr: ErrStateNotFound},
+		{name: "no finalized checkpoint", stateID: []byte("finalized"), wantErr: ErrStateNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := p.State(ctx, tt.stateID)
+			assert.ErrorContains(t, tt.wantErr.Error(), err)
+		})
+	}
+}
@@ -0,0 +1,24 @@
+// Package testing includes useful mocks for writing unit
+// tests which depend on logic from the statefetcher package.
+package testing
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+)
+
+// MockFetcher is a fake implementation of statefetcher.Fetcher which returns
+// the same state for every state ID.
+type MockFetcher struct {
+	BeaconState *state.BeaconState
+	Err         error
+}
+
+// State returns the configured beacon state or error.
+func (m *MockFetcher) State(context.Context, []byte) (*state.BeaconState, error) {
+	if m.Err != nil {
+		return nil, m.Err
+	}
+	return m.BeaconState, nil
+}
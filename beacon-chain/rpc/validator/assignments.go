@@ -13,6 +13,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/rand"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"github.com/prysmaticlabs/prysm/shared/timeutils"
 	"google.golang.org/grpc/codes"
@@ -202,11 +203,18 @@ func assignValidatorToSubnet(pubkey []byte, status ethpb.ValidatorStatus) {
 		return
 	}
 	epochDuration := time.Duration(params.BeaconConfig().SlotsPerEpoch * params.BeaconConfig().SecondsPerSlot)
+	// Assign distinct subnets, a validator can not hold more subnets than there are.
+	numSubnets := params.BeaconNetworkConfig().RandomSubnetsPerValidator
+	if numSubnets > params.BeaconNetworkConfig().AttestationSubnetCount {
+		numSubnets = params.BeaconNetworkConfig().AttestationSubnetCount
+	}
 	var assignedIdxs []uint64
 	randGen := rand.NewGenerator()
-	for i := uint64(0); i < params.BeaconNetworkConfig().RandomSubnetsPerValidator; i++ {
-		assignedIdx := randGen.Intn(int(params.BeaconNetworkConfig().AttestationSubnetCount))
-		assignedIdxs = append(assignedIdxs, uint64(assignedIdx))
+	for uint64(len(assignedIdxs)) < numSubnets {
+		assignedIdx := uint64(randGen.Intn(int(params.BeaconNetworkConfig().AttestationSubnetCount)))
+		if !sliceutil.IsInUint64(assignedIdx, assignedIdxs) {
+			assignedIdxs = append(assignedIdxs, assignedIdx)
+		}
 	}
 
 	assignedDuration := uint64(randGen.Intn(int(params.BeaconNetworkConfig().EpochsPerRandomSubnetSubscription)))
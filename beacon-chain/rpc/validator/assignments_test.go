@@ -18,6 +18,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/mock"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/sliceutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
@@ -358,6 +359,21 @@ func TestAssignValidatorToSubnet(t *testing.T) {
 	}
 }
 
+func TestAssignValidatorToSubnet_DistinctSubnets(t *testing.T) {
+	resetCfg := params.BeaconNetworkConfig().Copy()
+	defer params.OverrideBeaconNetworkConfig(resetCfg)
+	cfg := params.BeaconNetworkConfig().Copy()
+	cfg.RandomSubnetsPerValidator = cfg.AttestationSubnetCount / 2
+	params.OverrideBeaconNetworkConfig(cfg)
+	k := pubKey(4)
+
+	assignValidatorToSubnet(k, ethpb.ValidatorStatus_ACTIVE)
+	coms, ok, _ := cache.SubnetIDs.GetPersistentSubnets(k)
+	require.Equal(t, true, ok, "No cache entry found for validator")
+	assert.Equal(t, cfg.RandomSubnetsPerValidator, uint64(len(coms)))
+	assert.Equal(t, len(coms), len(sliceutil.SetUint64(coms)), "Expected subnets of validator to be distinct")
+}
+
 func BenchmarkCommitteeAssignment(b *testing.B) {
 	db, _ := dbutil.SetupDB(b)
 
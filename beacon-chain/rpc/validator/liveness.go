@@ -0,0 +1,91 @@
+package validator
+
+import (
+	"context"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetValidatorLiveness returns whether the requested validators were live in the given epoch,
+// which is the case if an attestation of the validator targeting the epoch is included in the
+// head state or if the validator proposed a block in the epoch. Only the current and previous
+// epoch of the head state can be requested, as older pending attestations are not kept.
+func (vs *Server) GetValidatorLiveness(
+	ctx context.Context,
+	req *pbrpc.ValidatorLivenessRequest,
+) (*pbrpc.ValidatorLivenessResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "ValidatorServer.GetValidatorLiveness")
+	defer span.End()
+
+	headState, err := vs.HeadFetcher.HeadState(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get head state: %v", err)
+	}
+	if headState == nil {
+		return nil, status.Error(codes.Unavailable, "Head state is not available yet")
+	}
+	currentEpoch := helpers.CurrentEpoch(headState)
+	var pendingAtts []*pbp2p.PendingAttestation
+	switch {
+	case req.Epoch == currentEpoch:
+		pendingAtts = headState.CurrentEpochAttestations()
+	case req.Epoch+1 == currentEpoch:
+		pendingAtts = headState.PreviousEpochAttestations()
+	default:
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Cannot check liveness in epoch %d, only the current epoch %d and the previous epoch are supported",
+			req.Epoch,
+			currentEpoch,
+		)
+	}
+	numValidators := uint64(headState.NumValidators())
+	for _, idx := range req.Indices {
+		if idx >= numValidators {
+			return nil, status.Errorf(codes.InvalidArgument, "Validator index %d does not exist", idx)
+		}
+	}
+
+	live := make(map[uint64]bool, len(req.Indices))
+	for _, att := range pendingAtts {
+		if att.Data == nil {
+			continue
+		}
+		committee, err := helpers.BeaconCommitteeFromState(headState, att.Data.Slot, att.Data.CommitteeIndex)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not get attestation committee: %v", err)
+		}
+		for _, idx := range attestationutil.AttestingIndices(att.AggregationBits, committee) {
+			live[idx] = true
+		}
+	}
+	blocks, _, err := vs.BeaconDB.Blocks(ctx, filters.NewFilter().SetStartEpoch(req.Epoch).SetEndEpoch(req.Epoch))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get blocks: %v", err)
+	}
+	for _, blk := range blocks {
+		if blk == nil || blk.Block == nil {
+			continue
+		}
+		live[blk.Block.ProposerIndex] = true
+	}
+
+	liveness := make([]*pbrpc.ValidatorLivenessResponse_Liveness, len(req.Indices))
+	for i, idx := range req.Indices {
+		liveness[i] = &pbrpc.ValidatorLivenessResponse_Liveness{
+			Index:  idx,
+			IsLive: live[idx],
+		}
+	}
+	return &pbrpc.ValidatorLivenessResponse{
+		Epoch:    req.Epoch,
+		Liveness: liveness,
+	}, nil
+}
@@ -0,0 +1,92 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
+	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestGetValidatorLiveness(t *testing.T) {
+	ctx := context.Background()
+	db, _ := dbutil.SetupDB(t)
+	headState, _ := testutil.DeterministicGenesisState(t, 64)
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	require.NoError(t, headState.SetSlot(3*slotsPerEpoch+1))
+
+	pendingAtt := func(slot uint64) (*pbp2p.PendingAttestation, uint64) {
+		committee, err := helpers.BeaconCommitteeFromState(headState, slot, 0)
+		require.NoError(t, err)
+		bits := bitfield.NewBitlist(uint64(len(committee)))
+		bits.SetBitAt(0, true)
+		return &pbp2p.PendingAttestation{
+			AggregationBits: bits,
+			Data: &ethpb.AttestationData{
+				Slot:   slot,
+				Target: &ethpb.Checkpoint{Epoch: helpers.SlotToEpoch(slot)},
+			},
+		}, committee[0]
+	}
+	prevAtt, prevAttester := pendingAtt(2*slotsPerEpoch + 1)
+	curAtt, curAttester := pendingAtt(3 * slotsPerEpoch)
+	require.NoError(t, headState.SetPreviousEpochAttestations([]*pbp2p.PendingAttestation{prevAtt}))
+	require.NoError(t, headState.SetCurrentEpochAttestations([]*pbp2p.PendingAttestation{curAtt}))
+
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = 2*slotsPerEpoch + 2
+	blk.Block.ProposerIndex = 63
+	require.NoError(t, db.SaveBlock(ctx, blk))
+
+	vs := &Server{
+		BeaconDB:    db,
+		HeadFetcher: &mockChain.ChainService{State: headState},
+	}
+
+	res, err := vs.GetValidatorLiveness(ctx, &pbrpc.ValidatorLivenessRequest{
+		Epoch:   2,
+		Indices: []uint64{prevAttester, curAttester, 63},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), res.Epoch)
+	require.Equal(t, 3, len(res.Liveness))
+	assert.Equal(t, prevAttester, res.Liveness[0].Index)
+	assert.Equal(t, true, res.Liveness[0].IsLive)
+	assert.Equal(t, prevAttester == curAttester, res.Liveness[1].IsLive)
+	assert.Equal(t, true, res.Liveness[2].IsLive)
+
+	res, err = vs.GetValidatorLiveness(ctx, &pbrpc.ValidatorLivenessRequest{
+		Epoch:   3,
+		Indices: []uint64{curAttester, 63},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, true, res.Liveness[0].IsLive)
+	assert.Equal(t, curAttester == 63, res.Liveness[1].IsLive)
+}
+
+func TestGetValidatorLiveness_InvalidRequest(t *testing.T) {
+	ctx := context.Background()
+	db, _ := dbutil.SetupDB(t)
+	headState, _ := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, headState.SetSlot(3*params.BeaconConfig().SlotsPerEpoch))
+	vs := &Server{
+		BeaconDB:    db,
+		HeadFetcher: &mockChain.ChainService{State: headState},
+	}
+
+	_, err := vs.GetValidatorLiveness(ctx, &pbrpc.ValidatorLivenessRequest{Epoch: 1, Indices: []uint64{0}})
+	assert.ErrorContains(t, "Cannot check liveness in epoch 1", err)
+	_, err = vs.GetValidatorLiveness(ctx, &pbrpc.ValidatorLivenessRequest{Epoch: 4, Indices: []uint64{0}})
+	assert.ErrorContains(t, "Cannot check liveness in epoch 4", err)
+	_, err = vs.GetValidatorLiveness(ctx, &pbrpc.ValidatorLivenessRequest{Epoch: 3, Indices: []uint64{64}})
+	assert.ErrorContains(t, "Validator index 64 does not exist", err)
+}
@@ -0,0 +1,285 @@
+package initialsync
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	prysmsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/abool"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// backfillRetryInterval is the time to wait before retrying a failed backfill batch, or before
+// checking again whether initial sync has completed.
+const backfillRetryInterval = 5 * time.Second
+
+var _ shared.Service = (*BackfillService)(nil)
+
+var errBackfillChainMismatch = errors.New("block does not chain to the backfill anchor")
+
+// BackfillConfig to set up the backfill service.
+type BackfillConfig struct {
+	P2P         p2p.P2P
+	DB          db.NoHeadAccessDatabase
+	Chain       blockchainService
+	InitialSync prysmsync.Checker
+}
+
+// BackfillService fills in the block history below the origin block of a node started from a
+// checkpoint state, walking backwards to genesis. Blocks are requested from peers in descending
+// batches and only accepted if they chain by parent root to the lowest block already known,
+// the backfill anchor. The anchor is persisted after every batch, so backfill resumes after a restart.
+type BackfillService struct {
+	ctx         context.Context
+	cancel      context.CancelFunc
+	chain       blockchainService
+	p2p         p2p.P2P
+	db          db.NoHeadAccessDatabase
+	initialSync prysmsync.Checker
+	fetcher     *blocksFetcher
+	backfilling *abool.AtomicBool
+	lock        sync.RWMutex
+	anchorRoot  [32]byte
+	anchor      *eth.BeaconBlock
+	// cursor is the exclusive upper bound of the next requested batch of slots. It may be below
+	// the anchor slot, when the slots in between were found to be empty.
+	cursor uint64
+}
+
+// NewBackfillService configures the backfill service.
+func NewBackfillService(ctx context.Context, cfg *BackfillConfig) *BackfillService {
+	ctx, cancel := context.WithCancel(ctx)
+	return &BackfillService{
+		ctx:         ctx,
+		cancel:      cancel,
+		chain:       cfg.Chain,
+		p2p:         cfg.P2P,
+		db:          cfg.DB,
+		initialSync: cfg.InitialSync,
+		backfilling: abool.New(),
+	}
+}
+
+// Start backfilling once initial sync has completed. Nothing is done for a node started from genesis.
+func (s *BackfillService) Start() {
+	originRoot, err := s.db.OriginBlockRoot(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not get origin block root")
+		return
+	}
+	if originRoot == params.BeaconConfig().ZeroHash {
+		return
+	}
+	if err := s.resume(originRoot); err != nil {
+		log.WithError(err).Error("Could not resume backfill")
+		return
+	}
+	if s.complete() {
+		return
+	}
+	s.backfilling.Set()
+	defer s.backfilling.UnSet()
+
+	for !s.initialSync.Synced() {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-time.After(backfillRetryInterval):
+		}
+	}
+	s.fetcher = newBlocksFetcher(s.ctx, &blocksFetcherConfig{
+		chain: s.chain,
+		p2p:   s.p2p,
+		db:    s.db,
+		mode:  modeStopOnFinalizedEpoch,
+	})
+	log.WithField("slot", s.BackfilledSlot()).Info("Backfilling block history")
+	for !s.complete() {
+		if _, err := s.fetcher.waitForMinimumPeers(s.ctx); err != nil {
+			return
+		}
+		if err := s.backfillBatch(s.ctx); err != nil {
+			if s.ctx.Err() != nil {
+				return
+			}
+			backfillBatchFailures.Inc()
+			log.WithError(err).Debug("Could not backfill batch")
+			select {
+			case <-s.ctx.Done():
+				return
+			case <-time.After(backfillRetryInterval):
+			}
+		}
+	}
+	log.Info("Backfilled block history to genesis")
+}
+
+// Stop the backfill service.
+func (s *BackfillService) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the backfill service. An incomplete block history is not an error condition.
+func (s *BackfillService) Status() error {
+	return nil
+}
+
+// Backfilling returns true while the block history below the checkpoint sync origin is being backfilled.
+func (s *BackfillService) Backfilling() bool {
+	return s.backfilling.IsSet()
+}
+
+// BackfilledSlot returns the slot of the lowest block in the backfilled history. The history
+// is complete when it returns 0.
+func (s *BackfillService) BackfilledSlot() uint64 {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if s.anchor == nil {
+		return 0
+	}
+	return s.anchor.Slot
+}
+
+// resume loads the backfill anchor from the database, which is the origin block if nothing has been
+// backfilled yet.
+func (s *BackfillService) resume(originRoot [32]byte) error {
+	root, err := s.db.BackfillBlockRoot(s.ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get backfill block root")
+	}
+	if root == params.BeaconConfig().ZeroHash {
+		root = originRoot
+	}
+	blk, err := s.db.Block(s.ctx, root)
+	if err != nil {
+		return errors.Wrap(err, "could not get backfill anchor block")
+	}
+	if blk == nil || blk.Block == nil {
+		return errors.Errorf("backfill anchor block %#x not found", root)
+	}
+	s.setAnchor(root, blk.Block)
+	s.cursor = blk.Block.Slot
+	return s.extendToKnownHistory(s.ctx)
+}
+
+// backfillBatch requests the batch of slots below the cursor and saves the blocks which chain to the anchor.
+func (s *BackfillService) backfillBatch(ctx context.Context) error {
+	ctx, span := trace.StartSpan(ctx, "initialsync.backfillBatch")
+	defer span.End()
+
+	end := s.cursor
+	count := uint64(flags.Get().BlockBatchLimit)
+	if count > end {
+		count = end
+	}
+	start := end - count
+	resp := s.fetcher.handleRequest(ctx, start, count)
+	if resp.err != nil {
+		return resp.err
+	}
+
+	blocks := resp.blocks
+	sort.Slice(blocks, func(i, j int) bool {
+		return blocks[i].Block.Slot > blocks[j].Block.Slot
+	})
+	s.lock.RLock()
+	expected := bytesutil.ToBytes32(s.anchor.ParentRoot)
+	s.lock.RUnlock()
+	verified := make([]*eth.SignedBeaconBlock, 0, len(blocks))
+	var lowestRoot [32]byte
+	for _, blk := range blocks {
+		if blk.Block.Slot >= end {
+			continue
+		}
+		root, err := blk.Block.HashTreeRoot()
+		if err != nil {
+			return errors.Wrap(err, "could not hash block")
+		}
+		if root != expected {
+			// The peer does not serve the chain of the anchor, retry the range below the anchor.
+			s.cursor = s.BackfilledSlot()
+			return errors.Wrapf(errBackfillChainMismatch, "block %#x at slot %d from peer %s", root, blk.Block.Slot, resp.pid)
+		}
+		verified = append(verified, blk)
+		lowestRoot = root
+		expected = bytesutil.ToBytes32(blk.Block.ParentRoot)
+	}
+
+	if len(verified) == 0 {
+		if start == 0 {
+			s.cursor = s.BackfilledSlot()
+			return errors.Errorf("no peer served the parent %#x of the backfill anchor", expected)
+		}
+		// All of the slots in the batch are empty.
+		s.cursor = start
+		return nil
+	}
+	if err := s.db.SaveBlocks(ctx, verified); err != nil {
+		return errors.Wrap(err, "could not save backfilled blocks")
+	}
+	if err := s.db.SaveBackfillBlockRoot(ctx, lowestRoot); err != nil {
+		return errors.Wrap(err, "could not save backfill block root")
+	}
+	backfilledBlocks.Add(float64(len(verified)))
+	lowest := verified[len(verified)-1].Block
+	s.setAnchor(lowestRoot, lowest)
+	s.cursor = start
+	log.WithFields(logrus.Fields{
+		"blocks": len(verified),
+		"slot":   lowest.Slot,
+	}).Debug("Backfilled batch")
+	return s.extendToKnownHistory(ctx)
+}
+
+// extendToKnownHistory moves the anchor down through the blocks which are already in the database,
+// and records the genesis block once the history is complete.
+func (s *BackfillService) extendToKnownHistory(ctx context.Context) error {
+	for !s.complete() {
+		s.lock.RLock()
+		parentRoot := bytesutil.ToBytes32(s.anchor.ParentRoot)
+		s.lock.RUnlock()
+		if !s.db.HasBlock(ctx, parentRoot) {
+			return nil
+		}
+		parent, err := s.db.Block(ctx, parentRoot)
+		if err != nil {
+			return errors.Wrap(err, "could not get block")
+		}
+		if err := s.db.SaveBackfillBlockRoot(ctx, parentRoot); err != nil {
+			return errors.Wrap(err, "could not save backfill block root")
+		}
+		s.setAnchor(parentRoot, parent.Block)
+		if s.cursor > parent.Block.Slot {
+			s.cursor = parent.Block.Slot
+		}
+	}
+	s.lock.RLock()
+	genesisRoot := s.anchorRoot
+	s.lock.RUnlock()
+	return s.db.SaveGenesisBlockRoot(ctx, genesisRoot)
+}
+
+func (s *BackfillService) setAnchor(root [32]byte, blk *eth.BeaconBlock) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.anchorRoot = root
+	s.anchor = blk
+	backfillSlot.Set(float64(blk.Slot))
+}
+
+// complete returns true once the anchor is the genesis block.
+func (s *BackfillService) complete() bool {
+	return s.BackfilledSlot() == 0
+}
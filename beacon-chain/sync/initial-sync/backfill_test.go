@@ -0,0 +1,146 @@
+package initialsync
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	eth "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// setupBackfill seeds the database with an origin block at the given slot, chaining to the highest of the
+// given block slots, and returns a backfill service configured with peers serving those blocks.
+func setupBackfill(t *testing.T, originSlot uint64, blocks []uint64, peers []*peerData) (*BackfillService, db.Database, *mock.ChainService) {
+	chain, p, beaconDB := initializeTestServices(t, blocks, peers)
+	ctx := context.Background()
+
+	st := testutil.NewBeaconState()
+	require.NoError(t, st.SetSlot(originSlot))
+	require.NoError(t, st.SetGenesisValidatorRoot(bytesutil.PadTo([]byte("genesis"), 32)))
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	cache.RLock()
+	parentRoot := cache.rootCache[blocks[len(blocks)-1]]
+	cache.RUnlock()
+	origin := testutil.NewBeaconBlock()
+	origin.Block.Slot = originSlot
+	origin.Block.ParentRoot = parentRoot[:]
+	origin.Block.StateRoot = stateRoot[:]
+	require.NoError(t, beaconDB.SaveOrigin(ctx, st, origin))
+	chain.FinalizedCheckPoint = &eth.Checkpoint{Epoch: originSlot / params.BeaconConfig().SlotsPerEpoch}
+
+	s := NewBackfillService(ctx, &BackfillConfig{
+		P2P:         p,
+		DB:          beaconDB,
+		Chain:       chain,
+		InitialSync: &mockSync.Sync{IsSynced: true},
+	})
+	return s, beaconDB, chain
+}
+
+func TestBackfillService_Start(t *testing.T) {
+	originSlot := 4 * params.BeaconConfig().SlotsPerEpoch
+	var blocks []uint64
+	for _, slot := range makeSequence(1, originSlot-1) {
+		// Leave some slots empty, including a whole batch.
+		if (slot >= 40 && slot < 45) || (slot >= 65 && slot < 75) {
+			continue
+		}
+		blocks = append(blocks, slot)
+	}
+	peers := []*peerData{
+		{blocks: blocks, finalizedEpoch: 8, headSlot: 8 * params.BeaconConfig().SlotsPerEpoch},
+		{blocks: blocks, finalizedEpoch: 8, headSlot: 8 * params.BeaconConfig().SlotsPerEpoch},
+	}
+	s, beaconDB, _ := setupBackfill(t, originSlot, blocks, peers)
+	ctx := context.Background()
+
+	s.Start()
+	assert.Equal(t, false, s.Backfilling())
+	assert.Equal(t, uint64(0), s.BackfilledSlot())
+
+	cache.RLock()
+	defer cache.RUnlock()
+	for _, slot := range blocks {
+		root := cache.rootCache[slot]
+		assert.Equal(t, true, beaconDB.HasBlock(ctx, root), "Missing block at slot %d", slot)
+		assert.Equal(t, true, beaconDB.IsFinalizedBlock(ctx, root), "Block at slot %d is not finalized", slot)
+	}
+	genesis, err := beaconDB.GenesisBlock(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, genesis)
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, cache.rootCache[0], genesisRoot)
+	root, err := beaconDB.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, cache.rootCache[0], root)
+}
+
+func TestBackfillService_Resume(t *testing.T) {
+	originSlot := 4 * params.BeaconConfig().SlotsPerEpoch
+	blocks := makeSequence(1, originSlot-1)
+	s, beaconDB, _ := setupBackfill(t, originSlot, blocks, nil)
+	ctx := context.Background()
+	originRoot, err := beaconDB.OriginBlockRoot(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, s.resume(originRoot))
+	assert.Equal(t, originSlot, s.BackfilledSlot())
+	assert.Equal(t, originSlot, s.cursor)
+
+	// Blocks backfilled before a restart are picked up.
+	var saved []*eth.SignedBeaconBlock
+	cache.RLock()
+	for _, slot := range blocks[originSlot/2:] {
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = slot
+		parentRoot := cache.rootCache[cache.parentSlotCache[slot]]
+		blk.Block.ParentRoot = parentRoot[:]
+		saved = append(saved, blk)
+	}
+	lowestRoot := cache.rootCache[blocks[originSlot/2]]
+	cache.RUnlock()
+	require.NoError(t, beaconDB.SaveBlocks(ctx, saved))
+	require.NoError(t, beaconDB.SaveBackfillBlockRoot(ctx, lowestRoot))
+
+	s = NewBackfillService(ctx, &BackfillConfig{DB: beaconDB})
+	require.NoError(t, s.resume(originRoot))
+	assert.Equal(t, blocks[originSlot/2], s.BackfilledSlot())
+	assert.Equal(t, false, s.complete())
+}
+
+func TestBackfillService_BackfillBatch_ChainMismatch(t *testing.T) {
+	originSlot := 2 * params.BeaconConfig().SlotsPerEpoch
+	blocks := makeSequence(1, originSlot-1)
+	peers := []*peerData{
+		{blocks: blocks, finalizedEpoch: 8, headSlot: 8 * params.BeaconConfig().SlotsPerEpoch, forkedPeer: true},
+	}
+	s, beaconDB, chain := setupBackfill(t, originSlot, blocks, peers)
+	ctx := context.Background()
+	originRoot, err := beaconDB.OriginBlockRoot(ctx)
+	require.NoError(t, err)
+	require.NoError(t, s.resume(originRoot))
+	s.fetcher = newBlocksFetcher(ctx, &blocksFetcherConfig{
+		chain: chain,
+		p2p:   s.p2p,
+		db:    beaconDB,
+		mode:  modeStopOnFinalizedEpoch,
+	})
+
+	err = s.backfillBatch(ctx)
+	assert.Equal(t, true, errors.Is(err, errBackfillChainMismatch), "Unexpected error: %v", err)
+	assert.Equal(t, originSlot, s.BackfilledSlot())
+	assert.Equal(t, originSlot, s.cursor)
+	root, err := beaconDB.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, [32]byte{}, root)
+}
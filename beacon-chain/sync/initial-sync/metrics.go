@@ -0,0 +1,21 @@
+package initialsync
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	backfillSlot = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "backfill_slot",
+		Help: "Slot of the lowest block in the backfilled block history.",
+	})
+	backfilledBlocks = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "backfill_blocks_total",
+		Help: "Count of blocks backfilled below the checkpoint sync origin.",
+	})
+	backfillBatchFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "backfill_batch_failures_total",
+		Help: "Count of backfill batches which could not be fetched or verified.",
+	})
+)
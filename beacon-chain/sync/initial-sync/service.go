@@ -84,6 +84,7 @@ func (s *Service) Start() {
 		log.Debug("Exiting Initial Sync Service")
 		return
 	}
+	s.chainStarted.Set()
 	if flags.Get().DisableSync {
 		s.markSynced(genesis)
 		log.WithField("genesisTime", genesis).Info("Due to Sync Being Disabled, entering regular sync immediately.")
@@ -100,7 +101,6 @@ func (s *Service) Start() {
 		s.markSynced(genesis)
 		return
 	}
-	s.chainStarted.Set()
 	log.Info("Starting initial chain sync...")
 	// Are we already in sync, or close to it?
 	if helpers.SlotToEpoch(s.chain.HeadSlot()) == helpers.SlotToEpoch(currentSlot) {
@@ -138,6 +138,16 @@ func (s *Service) Syncing() bool {
 	return s.synced.IsNotSet()
 }
 
+// Initialized returns true if initial sync has been started.
+func (s *Service) Initialized() bool {
+	return s.chainStarted.IsSet()
+}
+
+// Synced returns true if initial sync has been completed.
+func (s *Service) Synced() bool {
+	return s.synced.IsSet()
+}
+
 // Resync allows a node to start syncing again if it has fallen
 // behind the current network head.
 func (s *Service) Resync() error {
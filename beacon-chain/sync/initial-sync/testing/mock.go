@@ -4,7 +4,9 @@ package testing
 
 // Sync defines a mock for the sync service.
 type Sync struct {
-	IsSyncing bool
+	IsSyncing     bool
+	IsInitialized bool
+	IsSynced      bool
 }
 
 // Syncing --
@@ -12,6 +14,16 @@ func (s *Sync) Syncing() bool {
 	return s.IsSyncing
 }
 
+// Initialized --
+func (s *Sync) Initialized() bool {
+	return s.IsInitialized
+}
+
+// Synced --
+func (s *Sync) Synced() bool {
+	return s.IsSynced
+}
+
 // Status --
 func (s *Sync) Status() error {
 	return nil
@@ -21,3 +33,19 @@ func (s *Sync) Status() error {
 func (s *Sync) Resync() error {
 	return nil
 }
+
+// Backfill defines a mock for the backfill service.
+type Backfill struct {
+	IsBackfilling bool
+	Slot          uint64
+}
+
+// Backfilling --
+func (b *Backfill) Backfilling() bool {
+	return b.IsBackfilling
+}
+
+// BackfilledSlot --
+func (b *Backfill) BackfilledSlot() uint64 {
+	return b.Slot
+}
@@ -250,6 +250,10 @@ func (s *Service) retrieveGenesisBlock(ctx context.Context) (*ethpb.SignedBeacon
 	if err != nil {
 		return nil, [32]byte{}, err
 	}
+	// A node started from a checkpoint does not have the genesis block until it has been backfilled.
+	if genBlock == nil || genBlock.Block == nil {
+		return nil, [32]byte{}, errors.New("genesis block not found")
+	}
 	genRoot, err := genBlock.Block.HashTreeRoot()
 	if err != nil {
 		return nil, [32]byte{}, err
@@ -115,6 +115,8 @@ type Service struct {
 	badBlockLock              sync.RWMutex
 	stateSummaryCache         *cache.StateSummaryCache
 	stateGen                  *stategen.State
+	subnetSearchLock          sync.Mutex
+	subnetSearches            map[uint64]bool
 }
 
 // NewService initializes new regular sync service.
@@ -138,6 +140,7 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		slotToPendingBlocks:  c,
 		seenPendingBlocks:    make(map[[32]byte]bool),
 		blkRootToPendingAtts: make(map[[32]byte][]*ethpb.SignedAggregateAttestationAndProof),
+		subnetSearches:       make(map[uint64]bool),
 		stateNotifier:        cfg.StateNotifier,
 		blockNotifier:        cfg.BlockNotifier,
 		stateSummaryCache:    cfg.StateSummaryCache,
@@ -298,7 +301,16 @@ func (s *Service) markForChainStart() {
 // Checker defines a struct which can verify whether a node is currently
 // synchronizing a chain with the rest of peers in the network.
 type Checker interface {
+	Initialized() bool
 	Syncing() bool
+	Synced() bool
 	Status() error
 	Resync() error
 }
+
+// BackfillChecker defines a struct which reports the progress of backfilling the block
+// history of a node started from a checkpoint state.
+type BackfillChecker interface {
+	Backfilling() bool
+	BackfilledSlot() uint64
+}
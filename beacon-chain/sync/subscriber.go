@@ -217,19 +217,12 @@ func (s *Service) subscribeStaticWithSubnets(topic string, validator pubsub.Vali
 				if s.chainStarted.IsSet() && s.initialSync.Syncing() {
 					continue
 				}
-				// Check every slot that there are enough peers
+				// Check every slot that there are enough peers, searching
+				// for the peers of one subnet at a time.
 				for i := uint64(0); i < params.BeaconNetworkConfig().AttestationSubnetCount; i++ {
-					if !s.validPeersExist(topic, i) {
-						log.Debugf("No peers found subscribed to attestation gossip subnet with "+
-							"committee index %d. Searching network for peers subscribed to the subnet.", i)
-						go func(idx uint64) {
-							_, err := s.p2p.FindPeersWithSubnet(s.ctx, idx)
-							if err != nil {
-								log.Debugf("Could not search for peers: %v", err)
-								return
-							}
-						}(i)
-						return
+					if !s.validPeersExist(s.addDigestAndIndexToTopic(topic, i), i) {
+						s.searchForPeers(i)
+						break
 					}
 				}
 			}
@@ -331,16 +324,7 @@ func (s *Service) subscribeAggregatorSubnet(
 		subscriptions[idx] = s.subscribeWithBase(subnetTopic, validate, handle)
 	}
 	if !s.validPeersExist(subnetTopic, idx) {
-		log.Debugf("No peers found subscribed to attestation gossip subnet with "+
-			"committee index %d. Searching network for peers subscribed to the subnet.", idx)
-		go func(idx uint64) {
-			_, err := s.p2p.FindPeersWithSubnet(s.ctx, idx)
-			if err != nil {
-				log.Debugf("Could not search for peers: %v", err)
-				return
-			}
-		}(idx)
-		return
+		s.searchForPeers(idx)
 	}
 }
 
@@ -349,23 +333,51 @@ func (s *Service) lookupAttesterSubnets(digest [4]byte, idx uint64) {
 	topic := p2p.GossipTypeMapping[reflect.TypeOf(&pb.Attestation{})]
 	subnetTopic := fmt.Sprintf(topic, digest, idx)
 	if !s.validPeersExist(subnetTopic, idx) {
-		log.Debugf("No peers found subscribed to attestation gossip subnet with "+
-			"committee index %d. Searching network for peers subscribed to the subnet.", idx)
-		go func(idx uint64) {
-			// perform a search for peers with the desired committee index.
-			_, err := s.p2p.FindPeersWithSubnet(s.ctx, idx)
-			if err != nil {
-				log.Debugf("Could not search for peers: %v", err)
-				return
-			}
-		}(idx)
+		s.searchForPeers(idx)
+	}
+}
+
+// searchForPeers searches the network in the background for peers subscribed to the attestation
+// subnet with the given index, unless a search for the subnet is still running. Returns whether a
+// search was started.
+func (s *Service) searchForPeers(idx uint64) bool {
+	s.subnetSearchLock.Lock()
+	defer s.subnetSearchLock.Unlock()
+	if s.subnetSearches[idx] {
+		return false
 	}
+	s.subnetSearches[idx] = true
+	log.Debugf("Not enough peers found subscribed to attestation gossip subnet with "+
+		"committee index %d. Searching network for peers subscribed to the subnet.", idx)
+	go func() {
+		defer func() {
+			s.subnetSearchLock.Lock()
+			delete(s.subnetSearches, idx)
+			s.subnetSearchLock.Unlock()
+		}()
+		if _, err := s.p2p.FindPeersWithSubnet(s.ctx, idx, minimumPeersPerSubnet()); err != nil {
+			log.Debugf("Could not search for peers: %v", err)
+		}
+	}()
+	return true
 }
 
-// find if we have peers who are subscribed to the same subnet
+// find if we have enough peers who are subscribed to the same subnet
 func (s *Service) validPeersExist(subnetTopic string, idx uint64) bool {
-	numOfPeers := s.p2p.PubSub().ListPeers(subnetTopic + s.p2p.Encoding().ProtocolSuffix())
-	return len(s.p2p.Peers().SubscribedToSubnet(idx)) > 0 || len(numOfPeers) > 0
+	numOfPeers := len(s.p2p.PubSub().ListPeers(subnetTopic + s.p2p.Encoding().ProtocolSuffix()))
+	if subscribed := len(s.p2p.Peers().SubscribedToSubnet(idx)); subscribed > numOfPeers {
+		numOfPeers = subscribed
+	}
+	return numOfPeers >= minimumPeersPerSubnet()
+}
+
+// minimumPeersPerSubnet returns the number of peers to maintain on every subscribed
+// attestation subnet, at least one.
+func minimumPeersPerSubnet() int {
+	if min := flags.Get().MinimumPeersPerSubnet; min > 1 {
+		return min
+	}
+	return 1
 }
 
 // Add fork digest to topic.
@@ -10,16 +10,21 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	lru "github.com/hashicorp/golang-lru"
+	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
 	pb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/go-bitfield"
 	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	db "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
 	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 	"github.com/prysmaticlabs/prysm/shared/abool"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -242,8 +247,9 @@ func TestStaticSubnets(t *testing.T) {
 			Genesis:        time.Now(),
 			ValidatorsRoot: [32]byte{'A'},
 		},
-		p2p:          p,
-		chainStarted: abool.New(),
+		p2p:            p,
+		chainStarted:   abool.New(),
+		subnetSearches: make(map[uint64]bool),
 	}
 	defaultTopic := "/eth2/%x/beacon_attestation_%d"
 	r.subscribeStaticWithSubnets(defaultTopic, r.noopValidator, func(_ context.Context, msg proto.Message) error {
@@ -257,6 +263,92 @@ func TestStaticSubnets(t *testing.T) {
 	cancel()
 }
 
+func TestValidPeersExist_MinimumPeersPerSubnet(t *testing.T) {
+	resetCfg := flags.Get()
+	flags.Init(&flags.GlobalFlags{MinimumPeersPerSubnet: 2})
+	defer flags.Init(resetCfg)
+
+	p := p2ptest.NewTestP2P(t)
+	r := Service{
+		ctx: context.Background(),
+		chain: &mockChain.ChainService{
+			Genesis:        time.Now(),
+			ValidatorsRoot: [32]byte{'A'},
+		},
+		p2p: p,
+	}
+	bitV := bitfield.NewBitvector64()
+	bitV.SetBitAt(5, true)
+	addSubscribedPeer := func() {
+		pid := peer.ID(fmt.Sprintf("peer-%d", len(p.Peers().All())))
+		p.Peers().Add(nil, pid, nil, network.DirOutbound)
+		p.Peers().SetConnectionState(pid, peers.PeerConnected)
+		p.Peers().SetMetadata(pid, &pbp2p.MetaData{Attnets: bitV})
+	}
+	topic := "/eth2/%x/beacon_attestation_%d"
+	subnetTopic := r.addDigestAndIndexToTopic(topic, 5)
+
+	addSubscribedPeer()
+	assert.Equal(t, false, r.validPeersExist(subnetTopic, 5), "Expected a single peer to be below the minimum")
+	addSubscribedPeer()
+	assert.Equal(t, true, r.validPeersExist(subnetTopic, 5), "Expected the minimum number of peers to be reached")
+	assert.Equal(t, false, r.validPeersExist(r.addDigestAndIndexToTopic(topic, 6), 6), "Expected no peer on another subnet")
+}
+
+// subnetSearchP2P blocks every subnet search until it is released.
+type subnetSearchP2P struct {
+	*p2ptest.TestP2P
+	searches chan uint64
+	release  chan struct{}
+}
+
+func (p *subnetSearchP2P) FindPeersWithSubnet(_ context.Context, idx uint64, _ int) (bool, error) {
+	p.searches <- idx
+	<-p.release
+	return true, nil
+}
+
+func TestSearchForPeers_DeduplicatesRunningSearches(t *testing.T) {
+	p := &subnetSearchP2P{
+		TestP2P:  p2ptest.NewTestP2P(t),
+		searches: make(chan uint64, 3),
+		release:  make(chan struct{}),
+	}
+	r := Service{
+		ctx:            context.Background(),
+		p2p:            p,
+		subnetSearches: make(map[uint64]bool),
+	}
+	receiveSearches := func(want ...uint64) {
+		searched := make(map[uint64]bool)
+		for range want {
+			select {
+			case idx := <-p.searches:
+				searched[idx] = true
+			case <-time.After(time.Second):
+				t.Fatalf("Expected searches for subnets %v, got %v", want, searched)
+			}
+		}
+		for _, idx := range want {
+			assert.Equal(t, true, searched[idx], "No search started for subnet %d", idx)
+		}
+	}
+
+	assert.Equal(t, true, r.searchForPeers(1), "Expected a search to be started")
+	assert.Equal(t, true, r.searchForPeers(2), "Expected a search to be started for another subnet")
+	receiveSearches(1, 2)
+	assert.Equal(t, false, r.searchForPeers(1), "Expected no search while one is running for the subnet")
+
+	close(p.release)
+	started := false
+	for deadline := time.Now().Add(time.Second); !started && time.Now().Before(deadline); {
+		started = r.searchForPeers(1)
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.Equal(t, true, started, "Expected a new search once the previous one finished")
+	receiveSearches(1)
+}
+
 func Test_wrapAndReportValidation(t *testing.T) {
 	type args struct {
 		topic        string
@@ -112,6 +112,17 @@ var appHelpFlagGroups = []flagGroup{
 			flags.WeakSubjectivityCheckpt,
 			flags.EnableBackupWebhookFlag,
 			flags.BackupWebhookOutputDir,
+			flags.BackupWebhookRetentionFlag,
+			flags.ExportFileFlag,
+			flags.ExportFileEncodingFlag,
+			flags.ExportFileMaxSizeFlag,
+			flags.ExportFileMaxBackupsFlag,
+			flags.ExportWebhookFlag,
+			flags.ExportTypesFlag,
+			flags.CheckpointStateFlag,
+			flags.CheckpointBlockFlag,
+			flags.HistoryRetentionEpochsFlag,
+			flags.CompactDBFlag,
 		},
 	},
 	{
@@ -128,6 +139,11 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.StaticPeers,
 			cmd.EnableUPnPFlag,
 			flags.MinSyncPeers,
+			flags.MinPeersPerSubnet,
+			flags.GossipBadResponsesWeightFlag,
+			flags.GossipPeerStatusWeightFlag,
+			flags.GossipBlockProviderWeightFlag,
+			flags.TrustedPeersFileFlag,
 		},
 	},
 	{
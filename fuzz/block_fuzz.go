@@ -91,9 +91,15 @@ func setupDB() {
 
 type fakeChecker struct{}
 
+func (fakeChecker) Initialized() bool {
+	return false
+}
 func (fakeChecker) Syncing() bool {
 	return false
 }
+func (fakeChecker) Synced() bool {
+	return false
+}
 func (fakeChecker) Status() error {
 	return nil
 }
@@ -6,6 +6,7 @@ import (
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1"
 	ethpb_alpha "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	pbp2p "github.com/prysmaticlabs/prysm/proto/beacon/p2p/v1"
 )
 
 // V1Alpha1BlockToV1BlockHeader converts a v1alpha1 SignedBeaconBlock proto to a v1 SignedBeaconBlockHeader proto.
@@ -51,3 +52,107 @@ func V1ToV1Alpha1Block(alphaBlk *ethpb.SignedBeaconBlock) (*ethpb_alpha.SignedBe
 	}
 	return v1alpha1Block, nil
 }
+
+// V1Alpha1ToV1State converts a beacon state proto, as stored by the beacon node, to a v1 BeaconState proto.
+// The converted state shares its byte slices with the given state, which must not be mutated afterwards.
+func V1Alpha1ToV1State(st *pbp2p.BeaconState) *ethpb.BeaconState {
+	if st == nil {
+		return nil
+	}
+	v1State := &ethpb.BeaconState{
+		GenesisTime:                 st.GenesisTime,
+		GenesisValidatorsRoot:       st.GenesisValidatorsRoot,
+		Slot:                        st.Slot,
+		BlockRoots:                  st.BlockRoots,
+		StateRoots:                  st.StateRoots,
+		HistoricalRoots:             st.HistoricalRoots,
+		Eth1Data:                    v1Eth1Data(st.Eth1Data),
+		Eth1DepositIndex:            st.Eth1DepositIndex,
+		Balances:                    st.Balances,
+		RandaoMixes:                 st.RandaoMixes,
+		Slashings:                   st.Slashings,
+		JustificationBits:           st.JustificationBits,
+		PreviousJustifiedCheckpoint: v1Checkpoint(st.PreviousJustifiedCheckpoint),
+		CurrentJustifiedCheckpoint:  v1Checkpoint(st.CurrentJustifiedCheckpoint),
+		FinalizedCheckpoint:         v1Checkpoint(st.FinalizedCheckpoint),
+	}
+	if st.Fork != nil {
+		v1State.Fork = &ethpb.Fork{
+			PreviousVersion: st.Fork.PreviousVersion,
+			CurrentVersion:  st.Fork.CurrentVersion,
+			Epoch:           st.Fork.Epoch,
+		}
+	}
+	if hdr := st.LatestBlockHeader; hdr != nil {
+		v1State.LatestBlockHeader = &ethpb.BeaconBlockHeader{
+			Slot:          hdr.Slot,
+			ProposerIndex: hdr.ProposerIndex,
+			ParentRoot:    hdr.ParentRoot,
+			StateRoot:     hdr.StateRoot,
+			BodyRoot:      hdr.BodyRoot,
+		}
+	}
+	v1State.Eth1DataVotes = make([]*ethpb.Eth1Data, len(st.Eth1DataVotes))
+	for i, vote := range st.Eth1DataVotes {
+		v1State.Eth1DataVotes[i] = v1Eth1Data(vote)
+	}
+	v1State.Validators = make([]*ethpb.Validator, len(st.Validators))
+	for i, val := range st.Validators {
+		v1State.Validators[i] = &ethpb.Validator{
+			PublicKey:                  val.PublicKey,
+			WithdrawalCredentials:      val.WithdrawalCredentials,
+			EffectiveBalance:           val.EffectiveBalance,
+			Slashed:                    val.Slashed,
+			ActivationEligibilityEpoch: val.ActivationEligibilityEpoch,
+			ActivationEpoch:            val.ActivationEpoch,
+			ExitEpoch:                  val.ExitEpoch,
+			WithdrawableEpoch:          val.WithdrawableEpoch,
+		}
+	}
+	v1State.PreviousEpochAttestations = v1PendingAttestations(st.PreviousEpochAttestations)
+	v1State.CurrentEpochAttestations = v1PendingAttestations(st.CurrentEpochAttestations)
+	return v1State
+}
+
+func v1Eth1Data(data *ethpb_alpha.Eth1Data) *ethpb.Eth1Data {
+	if data == nil {
+		return nil
+	}
+	return &ethpb.Eth1Data{
+		DepositRoot:  data.DepositRoot,
+		DepositCount: data.DepositCount,
+		BlockHash:    data.BlockHash,
+	}
+}
+
+func v1Checkpoint(cp *ethpb_alpha.Checkpoint) *ethpb.Checkpoint {
+	if cp == nil {
+		return nil
+	}
+	return &ethpb.Checkpoint{
+		Epoch: cp.Epoch,
+		Root:  cp.Root,
+	}
+}
+
+func v1PendingAttestations(atts []*pbp2p.PendingAttestation) []*ethpb.PendingAttestation {
+	v1Atts := make([]*ethpb.PendingAttestation, len(atts))
+	for i, att := range atts {
+		v1Att := &ethpb.PendingAttestation{
+			AggregationBits: att.AggregationBits,
+			InclusionDelay:  att.InclusionDelay,
+			ProposerIndex:   att.ProposerIndex,
+		}
+		if att.Data != nil {
+			v1Att.Data = &ethpb.AttestationData{
+				Slot:            att.Data.Slot,
+				CommitteeIndex:  att.Data.CommitteeIndex,
+				BeaconBlockRoot: att.Data.BeaconBlockRoot,
+				Source:          v1Checkpoint(att.Data.Source),
+				Target:          v1Checkpoint(att.Data.Target),
+			}
+		}
+		v1Atts[i] = v1Att
+	}
+	return v1Atts
+}
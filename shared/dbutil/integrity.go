@@ -0,0 +1,31 @@
+package dbutil
+
+import (
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+// IntegrityIssue describes an inconsistency found while checking the integrity of a database.
+type IntegrityIssue struct {
+	Bucket string
+	Key    []byte
+	Reason string
+}
+
+func (i *IntegrityIssue) String() string {
+	return fmt.Sprintf("%s %#x: %s", i.Bucket, i.Key, i.Reason)
+}
+
+// IntegrityIssues collects the issues found while checking the integrity of a database.
+type IntegrityIssues []*IntegrityIssue
+
+// Report records an issue with the given key of the given bucket. The key is copied, so that it
+// can be read after the transaction it was read in is closed.
+func (is *IntegrityIssues) Report(bucket, key []byte, format string, args ...interface{}) {
+	*is = append(*is, &IntegrityIssue{
+		Bucket: string(bucket),
+		Key:    bytesutil.SafeCopyBytes(key),
+		Reason: fmt.Sprintf(format, args...),
+	})
+}
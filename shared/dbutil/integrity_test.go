@@ -0,0 +1,18 @@
+package dbutil
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestIntegrityIssues_Report(t *testing.T) {
+	var issues IntegrityIssues
+	key := []byte{0xaa, 0xbb}
+	issues.Report([]byte("blocks"), key, "missing parent %d", 5)
+	key[0] = 0
+	require.Equal(t, 1, len(issues))
+	assert.DeepEqual(t, []byte{0xaa, 0xbb}, issues[0].Key)
+	assert.Equal(t, "blocks 0xaabb: missing parent 5", issues[0].String())
+}
@@ -0,0 +1,91 @@
+// Package dbutil contains helpers shared by the bolt databases of the beacon node, the validator
+// client and the slasher.
+package dbutil
+
+import (
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+)
+
+// VerifyBackupFunc checks a backup against the database it replaces before it is restored. The
+// existing transaction is nil if there is no database to replace.
+type VerifyBackupFunc func(backup, existing *bolt.Tx) error
+
+// OpenReadOnly opens the database file at the given path without taking the write lock, failing
+// if another process holds it.
+func OpenReadOnly(dbPath string) (*bolt.DB, error) {
+	db, err := bolt.Open(dbPath, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{
+		Timeout:  params.BeaconIoConfig().BoltTimeout,
+		ReadOnly: true,
+	})
+	if err != nil {
+		if errors.Is(err, bolt.ErrTimeout) {
+			return nil, errors.New("cannot obtain database lock, database may be in use by another process")
+		}
+		return nil, err
+	}
+	return db, nil
+}
+
+// Restore replaces the database file at the given path with a backup of it. The backup must contain
+// the required buckets and pass the given check. Restoring fails if a running process holds the
+// database it replaces.
+func Restore(backupPath, dbPath string, requiredBuckets [][]byte, verify VerifyBackupFunc) error {
+	if !fileutil.FileExists(backupPath) {
+		return errors.Errorf("no backup at %s", backupPath)
+	}
+	if err := verifyBackup(backupPath, dbPath, requiredBuckets, verify); err != nil {
+		return err
+	}
+	if !fileutil.FileExists(dbPath) {
+		if err := fileutil.MkdirAll(filepath.Dir(dbPath)); err != nil {
+			return err
+		}
+	}
+	if err := fileutil.ReplaceFile(backupPath, dbPath); err != nil {
+		return errors.Wrap(err, "could not replace database with backup")
+	}
+	return nil
+}
+
+// verifyBackup opens the backup and the existing database, if any, read-only and checks the backup.
+func verifyBackup(backupPath, dbPath string, requiredBuckets [][]byte, verify VerifyBackupFunc) error {
+	backup, err := OpenReadOnly(backupPath)
+	if err != nil {
+		return errors.Wrap(err, "could not open backup")
+	}
+	defer closeDB(backup)
+	var existing *bolt.DB
+	if fileutil.FileExists(dbPath) {
+		existing, err = OpenReadOnly(dbPath)
+		if err != nil {
+			return errors.Wrap(err, "could not open existing database")
+		}
+		defer closeDB(existing)
+	}
+
+	return backup.View(func(backupTx *bolt.Tx) error {
+		for _, name := range requiredBuckets {
+			if backupTx.Bucket(name) == nil {
+				return errors.Errorf("could not validate backup: missing bucket %s", name)
+			}
+		}
+		if existing == nil {
+			return verify(backupTx, nil)
+		}
+		return existing.View(func(existingTx *bolt.Tx) error {
+			return verify(backupTx, existingTx)
+		})
+	})
+}
+
+func closeDB(db *bolt.DB) {
+	if err := db.Close(); err != nil {
+		logrus.WithError(err).Error("Failed to close database")
+	}
+}
@@ -0,0 +1,82 @@
+package dbutil
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func createDB(t *testing.T, dbPath string, value []byte) {
+	db, err := bolt.Open(dbPath, params.BeaconIoConfig().ReadWritePermissions, nil)
+	require.NoError(t, err)
+	require.NoError(t, db.Update(func(tx *bolt.Tx) error {
+		bkt, err := tx.CreateBucket([]byte("bucket"))
+		if err != nil {
+			return err
+		}
+		return bkt.Put([]byte("key"), value)
+	}))
+	require.NoError(t, db.Close())
+}
+
+func readValue(tx *bolt.Tx) []byte {
+	return tx.Bucket([]byte("bucket")).Get([]byte("key"))
+}
+
+func TestRestore(t *testing.T) {
+	backupPath := filepath.Join(t.TempDir(), "backup.db")
+	createDB(t, backupPath, []byte("backup"))
+	dbPath := filepath.Join(t.TempDir(), "node", "beaconchain.db")
+
+	var verified [][]byte
+	verify := func(backup, existing *bolt.Tx) error {
+		if existing != nil {
+			verified = append(verified, append([]byte{}, readValue(existing)...))
+		}
+		return nil
+	}
+	required := [][]byte{[]byte("bucket")}
+	require.NoError(t, Restore(backupPath, dbPath, required, verify))
+	assert.Equal(t, 0, len(verified))
+	require.NoError(t, Restore(backupPath, dbPath, required, verify))
+	assert.DeepEqual(t, [][]byte{[]byte("backup")}, verified)
+
+	db, err := OpenReadOnly(dbPath)
+	require.NoError(t, err)
+	require.NoError(t, db.View(func(tx *bolt.Tx) error {
+		assert.DeepEqual(t, []byte("backup"), readValue(tx))
+		return nil
+	}))
+	require.NoError(t, db.Close())
+}
+
+func TestRestore_Refused(t *testing.T) {
+	backupPath := filepath.Join(t.TempDir(), "backup.db")
+	createDB(t, backupPath, []byte("backup"))
+	dbPath := filepath.Join(t.TempDir(), "beaconchain.db")
+	createDB(t, dbPath, []byte("existing"))
+	noCheck := func(_, _ *bolt.Tx) error { return nil }
+
+	err := Restore(backupPath, dbPath, [][]byte{[]byte("other")}, noCheck)
+	assert.ErrorContains(t, "missing bucket other", err)
+	err = Restore(backupPath, dbPath, nil, func(_, _ *bolt.Tx) error { return errors.New("bad backup") })
+	assert.ErrorContains(t, "bad backup", err)
+
+	db, err := bolt.Open(dbPath, params.BeaconIoConfig().ReadWritePermissions, nil)
+	require.NoError(t, err)
+	assert.ErrorContains(t, "cannot obtain database lock", Restore(backupPath, dbPath, nil, noCheck))
+	require.NoError(t, db.View(func(tx *bolt.Tx) error {
+		assert.DeepEqual(t, []byte("existing"), readValue(tx))
+		return nil
+	}))
+	require.NoError(t, db.Close())
+
+	assert.ErrorContains(t, "no backup", Restore(filepath.Join(t.TempDir(), "missing.db"), dbPath, nil, noCheck))
+	assert.Equal(t, true, fileutil.FileExists(dbPath))
+}
@@ -31,7 +31,7 @@ var (
 	}
 	kafkaBootstrapServersFlag = &cli.StringFlag{
 		Name:  "kafka-url",
-		Usage: "Stream attestations and blocks to specified kafka servers. This field is used for bootstrap.servers kafka config field.",
+		Usage: "Export blocks, slashings, exits and checkpoints to the specified kafka servers. This field is used for bootstrap.servers kafka config field.",
 	}
 	enableExternalSlasherProtectionFlag = &cli.BoolFlag{
 		Name: "enable-external-slasher-protection",
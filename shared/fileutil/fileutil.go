@@ -1,6 +1,7 @@
 package fileutil
 
 import (
+	"io"
 	"io/ioutil"
 	"os"
 	"os/user"
@@ -139,3 +140,52 @@ func CopyFile(src, dst string) error {
 	}
 	return nil
 }
+
+// ReplaceFile atomically replaces the destination file with a copy of the source file. The source is
+// streamed into a temporary file next to the destination, synced to disk and renamed over the
+// destination, so the destination is either left untouched or fully replaced.
+func ReplaceFile(src, dst string) error {
+	input, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := input.Close(); err != nil {
+			log.WithError(err).Error("Could not close source file")
+		}
+	}()
+	tmp, err := ioutil.TempFile(filepath.Dir(dst), filepath.Base(dst)+".tmp")
+	if err != nil {
+		return errors.Wrapf(err, "error creating temporary file for %s", dst)
+	}
+	tmpPath := tmp.Name()
+	if err := copyAndSync(tmp, input); err != nil {
+		if rmErr := os.Remove(tmpPath); rmErr != nil {
+			log.WithError(rmErr).Error("Could not remove temporary file")
+		}
+		return errors.Wrapf(err, "error copying %s", src)
+	}
+	if err := os.Rename(tmpPath, dst); err != nil {
+		if rmErr := os.Remove(tmpPath); rmErr != nil {
+			log.WithError(rmErr).Error("Could not remove temporary file")
+		}
+		return errors.Wrapf(err, "error replacing file %s", dst)
+	}
+	return nil
+}
+
+func copyAndSync(dst *os.File, src io.Reader) error {
+	if err := dst.Chmod(params.BeaconIoConfig().ReadWritePermissions); err != nil {
+		_ = dst.Close()
+		return err
+	}
+	if _, err := io.Copy(dst, src); err != nil {
+		_ = dst.Close()
+		return err
+	}
+	if err := dst.Sync(); err != nil {
+		_ = dst.Close()
+		return err
+	}
+	return dst.Close()
+}
@@ -114,6 +114,30 @@ func TestCopyFile(t *testing.T) {
 	assert.Equal(t, true, deepCompare(t, fName, fName+"copy"))
 }
 
+func TestReplaceFile(t *testing.T) {
+	dir := t.TempDir()
+	src := filepath.Join(dir, "src")
+	dst := filepath.Join(dir, "dst")
+	require.NoError(t, ioutil.WriteFile(src, []byte{1, 2, 3}, params.BeaconIoConfig().ReadWritePermissions))
+	require.NoError(t, ioutil.WriteFile(dst, []byte{4, 5}, params.BeaconIoConfig().ReadWritePermissions))
+
+	require.NoError(t, fileutil.ReplaceFile(src, dst))
+	got, err := ioutil.ReadFile(dst)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{1, 2, 3}, got)
+	info, err := os.Stat(dst)
+	require.NoError(t, err)
+	assert.Equal(t, params.BeaconIoConfig().ReadWritePermissions, info.Mode())
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(files), "Temporary file was not cleaned up")
+
+	assert.ErrorContains(t, "no such file", fileutil.ReplaceFile(filepath.Join(dir, "missing"), dst))
+	got, err = ioutil.ReadFile(dst)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{1, 2, 3}, got)
+}
+
 func deepCompare(t *testing.T, file1, file2 string) bool {
 	sf, err := os.Open(file1)
 	assert.NoError(t, err)
@@ -9,6 +9,15 @@ import (
 	"google.golang.org/grpc/metadata"
 )
 
+// HTTPCodeMetadataKey is the gRPC header key under which a server can request a custom
+// HTTP status code to be returned by the gateway for a successful response.
+const HTTPCodeMetadataKey = "x-http-code"
+
+// BackfilledSlotMetadataKey is the gRPC header key under which the sync status endpoints of a node
+// started from a checkpoint report the slot its block history has been backfilled down to, the
+// blocks from genesis up to that slot are yet to be backfilled.
+const BackfilledSlotMetadataKey = "x-backfilled-slot"
+
 // LogGRPCRequests this method logs the gRPC backend as well as request duration when the log level is set to debug
 // or higher.
 func LogGRPCRequests(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
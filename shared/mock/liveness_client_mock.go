@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1 (interfaces: LivenessClient)
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	ethereum_beacon_rpc_v1 "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	grpc "google.golang.org/grpc"
+)
+
+// MockLivenessClient is a mock of LivenessClient interface
+type MockLivenessClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockLivenessClientMockRecorder
+}
+
+// MockLivenessClientMockRecorder is the mock recorder for MockLivenessClient
+type MockLivenessClientMockRecorder struct {
+	mock *MockLivenessClient
+}
+
+// NewMockLivenessClient creates a new mock instance
+func NewMockLivenessClient(ctrl *gomock.Controller) *MockLivenessClient {
+	mock := &MockLivenessClient{ctrl: ctrl}
+	mock.recorder = &MockLivenessClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockLivenessClient) EXPECT() *MockLivenessClientMockRecorder {
+	return m.recorder
+}
+
+// GetValidatorLiveness mocks base method
+func (m *MockLivenessClient) GetValidatorLiveness(arg0 context.Context, arg1 *ethereum_beacon_rpc_v1.ValidatorLivenessRequest, arg2 ...grpc.CallOption) (*ethereum_beacon_rpc_v1.ValidatorLivenessResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetValidatorLiveness", varargs...)
+	ret0, _ := ret[0].(*ethereum_beacon_rpc_v1.ValidatorLivenessResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetValidatorLiveness indicates an expected call of GetValidatorLiveness
+func (mr *MockLivenessClientMockRecorder) GetValidatorLiveness(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValidatorLiveness", reflect.TypeOf((*MockLivenessClient)(nil).GetValidatorLiveness), varargs...)
+}
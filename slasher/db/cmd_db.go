@@ -0,0 +1,50 @@
+package db
+
+import (
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/slasher/db/kv"
+	"github.com/urfave/cli/v2"
+)
+
+// DirName is the name of the slasher database directory inside the data directory.
+const DirName = "slasherdata"
+
+// Commands for maintaining the slasher database while the slasher is stopped.
+var Commands = &cli.Command{
+	Name:     "db",
+	Category: "db",
+	Usage:    "defines commands for maintaining the slasher database while the slasher is stopped",
+	Subcommands: []*cli.Command{
+		{
+			Name:  "restore",
+			Usage: `restores the slasher database from a backup file`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.RestoreSourceFileFlag,
+				cmd.RestoreTargetDirFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				return cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags)
+			},
+			Action: restoreCli,
+		},
+	},
+}
+
+func restoreCli(cliCtx *cli.Context) error {
+	sourceFile, err := fileutil.ExpandPath(cliCtx.String(cmd.RestoreSourceFileFlag.Name))
+	if err != nil {
+		return err
+	}
+	targetDir, err := fileutil.ExpandPath(cliCtx.String(cmd.RestoreTargetDirFlag.Name))
+	if err != nil {
+		return err
+	}
+	if err := kv.Restore(cliCtx.Context, sourceFile, filepath.Join(targetDir, DirName)); err != nil {
+		return errors.Wrap(err, "could not restore database")
+	}
+	return nil
+}
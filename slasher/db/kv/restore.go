@@ -0,0 +1,50 @@
+package kv
+
+import (
+	"context"
+	"path"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/dbutil"
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// restoreRequiredBuckets are the buckets a backup must contain to be restored.
+var restoreRequiredBuckets = [][]byte{
+	historicIndexedAttestationsBucket,
+	historicBlockHeadersBucket,
+	highestAttestationBucket,
+	slashingBucket,
+	chainDataBucket,
+	validatorsMinMaxSpanBucketNew,
+}
+
+// Restore replaces the slasher database in the given directory with a backup of it. The backup
+// must contain the buckets of the current schema and a readable chain head, if any. The database
+// must not be opened by a running slasher while restoring.
+func Restore(ctx context.Context, backupPath, dirPath string) error {
+	ctx, span := trace.StartSpan(ctx, "slasherDB.Restore")
+	defer span.End()
+
+	dbPath := path.Join(dirPath, databaseFileName)
+	err := dbutil.Restore(backupPath, dbPath, restoreRequiredBuckets, func(backupTx, _ *bolt.Tx) error {
+		if enc := backupTx.Bucket(chainDataBucket).Get([]byte(chainHeadKey)); enc != nil {
+			if err := proto.Unmarshal(enc, &ethpb.ChainHead{}); err != nil {
+				return errors.Wrap(err, "could not decode chain head of backup")
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	logrus.WithFields(logrus.Fields{
+		"backup": backupPath,
+		"path":   dbPath,
+	}).Info("Restored slasher database from backup")
+	return nil
+}
@@ -0,0 +1,52 @@
+package kv
+
+import (
+	"context"
+	"path"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestRestore(t *testing.T) {
+	ctx := context.Background()
+	backupDir := t.TempDir()
+	backup, err := NewKVStore(backupDir, &Config{})
+	require.NoError(t, err)
+	head := &ethpb.ChainHead{HeadSlot: 20, HeadEpoch: 1}
+	require.NoError(t, backup.SaveChainHead(ctx, head))
+	require.NoError(t, backup.Close())
+	backupPath := path.Join(backupDir, databaseFileName)
+
+	targetDir := path.Join(t.TempDir(), "slasherdata")
+	require.NoError(t, Restore(ctx, backupPath, targetDir))
+	restored, err := NewKVStore(targetDir, &Config{})
+	require.NoError(t, err)
+	got, err := restored.ChainHead(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, head.HeadSlot, got.HeadSlot)
+
+	assert.ErrorContains(t, "cannot obtain database lock", Restore(ctx, backupPath, targetDir))
+	require.NoError(t, restored.Close())
+	require.NoError(t, Restore(ctx, backupPath, targetDir))
+}
+
+func TestRestore_MissingBucket(t *testing.T) {
+	backupPath := path.Join(t.TempDir(), databaseFileName)
+	db, err := bolt.Open(backupPath, params.BeaconIoConfig().ReadWritePermissions, nil)
+	require.NoError(t, err)
+	require.NoError(t, db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucket(chainDataBucket)
+		return err
+	}))
+	require.NoError(t, db.Close())
+
+	targetDir := t.TempDir()
+	assert.ErrorContains(t, "missing bucket", Restore(context.Background(), backupPath, targetDir))
+	assert.Equal(t, false, fileutil.FileExists(path.Join(targetDir, databaseFileName)))
+}
@@ -6,6 +6,7 @@ import (
 	"io"
 	"strings"
 
+	ptypes "github.com/gogo/protobuf/types"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
@@ -33,7 +34,7 @@ const exitPassphrase = "Exit my validator"
 
 // ExitAccountsCli performs a voluntary exit on one or more accounts.
 func ExitAccountsCli(cliCtx *cli.Context, r io.Reader) error {
-	validatingPublicKeys, keymanager, err := prepareWallet(cliCtx)
+	validatingPublicKeys, km, err := prepareWallet(cliCtx)
 	if err != nil {
 		return err
 	}
@@ -47,14 +48,22 @@ func ExitAccountsCli(cliCtx *cli.Context, r io.Reader) error {
 		return nil
 	}
 
-	validatorClient, nodeClient, err := prepareClients(cliCtx)
+	validatorClient, nodeClient, _, err := prepareClients(cliCtx)
 	if err != nil {
 		return err
 	}
+	// Remote signers need the genesis validators root to compute the signing root of the exit.
+	if setter, ok := km.(keymanager.GenesisValidatorsRootSetter); ok {
+		genesis, err := (*nodeClient).GetGenesis(cliCtx.Context, &ptypes.Empty{})
+		if err != nil {
+			return errors.Wrap(err, "could not get genesis info from beacon node")
+		}
+		setter.SetGenesisValidatorsRoot(genesis.GenesisValidatorsRoot)
+	}
 	cfg := performExitCfg{
 		*validatorClient,
 		*nodeClient,
-		keymanager,
+		km,
 		rawPubKeys,
 		formattedPubKeys,
 	}
@@ -169,7 +178,12 @@ func interact(cliCtx *cli.Context, r io.Reader, validatingPublicKeys [][48]byte)
 	return rawPubKeys, formattedPubKeys, nil
 }
 
-func prepareClients(cliCtx *cli.Context) (*ethpb.BeaconNodeValidatorClient, *ethpb.NodeClient, error) {
+func prepareClients(cliCtx *cli.Context) (
+	*ethpb.BeaconNodeValidatorClient,
+	*ethpb.NodeClient,
+	*ethpb.BeaconChainClient,
+	error,
+) {
 	dialOpts := client.ConstructDialOptions(
 		cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name),
 		cliCtx.String(flags.CertFlag.Name),
@@ -177,16 +191,17 @@ func prepareClients(cliCtx *cli.Context) (*ethpb.BeaconNodeValidatorClient, *eth
 		cliCtx.Duration(flags.GrpcRetryDelayFlag.Name),
 	)
 	if dialOpts == nil {
-		return nil, nil, errors.New("failed to construct dial options")
+		return nil, nil, nil, errors.New("failed to construct dial options")
 	}
 	conn, err := grpc.DialContext(cliCtx.Context, cliCtx.String(flags.BeaconRPCProviderFlag.Name), dialOpts...)
 	if err != nil {
-		return nil, nil, errors.Wrapf(err, "could not dial endpoint %s", flags.BeaconRPCProviderFlag.Name)
+		return nil, nil, nil, errors.Wrapf(err, "could not dial endpoint %s", flags.BeaconRPCProviderFlag.Name)
 	}
 	validatorClient := ethpb.NewBeaconNodeValidatorClient(conn)
 	nodeClient := ethpb.NewNodeClient(conn)
+	beaconChainClient := ethpb.NewBeaconChainClient(conn)
 
-	return &validatorClient, &nodeClient, nil
+	return &validatorClient, &nodeClient, &beaconChainClient, nil
 }
 
 func performExit(cliCtx *cli.Context, cfg performExitCfg) ([]string, error) {
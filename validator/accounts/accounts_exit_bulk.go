@@ -0,0 +1,440 @@
+package accounts
+
+import (
+	"bufio"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/promptutil"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/client"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// BulkExitConfig specifies the accounts to exit in bulk, the clients and keymanager
+// used to sign and submit their voluntary exits and the database in which the exits are kept.
+type BulkExitConfig struct {
+	ValidatorClient    ethpb.BeaconNodeValidatorClient
+	NodeClient         ethpb.NodeClient
+	Keymanager         keymanager.IKeymanager
+	DB                 db.Database
+	PublicKeys         [][]byte
+	Epoch              uint64
+	SubmissionInterval time.Duration
+}
+
+// ExitStatus describes the progress of a voluntary exit kept in the validator database
+// through the exit queue of the beacon chain.
+type ExitStatus struct {
+	PublicKey         [48]byte
+	ValidatorIndex    uint64
+	Epoch             uint64
+	Submitted         bool
+	Status            ethpb.ValidatorStatus
+	ExitEpoch         uint64
+	WithdrawableEpoch uint64
+}
+
+// BulkExitAccountsCli performs a voluntary exit on all accounts listed in a public keys file,
+// without selecting them interactively. This function uses the CLI to extract necessary values.
+func BulkExitAccountsCli(cliCtx *cli.Context, r io.Reader) error {
+	validatingPublicKeys, km, err := prepareWallet(cliCtx)
+	if err != nil {
+		return err
+	}
+	pubKeys, err := readExitPublicKeysFile(cliCtx.String(flags.ExitPublicKeysFileFlag.Name))
+	if err != nil {
+		return err
+	}
+	walletKeys := make(map[[48]byte]bool, len(validatingPublicKeys))
+	for _, pubKey := range validatingPublicKeys {
+		walletKeys[pubKey] = true
+	}
+	for _, pubKey := range pubKeys {
+		if !walletKeys[bytesutil.ToBytes48(pubKey)] {
+			return fmt.Errorf("public key %#x is not an enabled account in the wallet", pubKey)
+		}
+	}
+	if !cliCtx.Bool(flags.ForceExitFlag.Name) {
+		promptText := fmt.Sprintf(
+			"You are about to perform a voluntary exit on %d accounts, which cannot be undone. "+
+				"If you want to continue, please input the phrase \"%s\"",
+			len(pubKeys), au.BrightRed(exitPassphrase),
+		)
+		if _, err := promptutil.ValidatePrompt(r, promptText, func(input string) error {
+			return promptutil.ValidatePhrase(input, exitPassphrase)
+		}); err != nil {
+			return err
+		}
+	}
+
+	validatorClient, nodeClient, _, err := prepareClients(cliCtx)
+	if err != nil {
+		return err
+	}
+	// Remote signers need the genesis validators root to compute the signing root of the exits.
+	if setter, ok := km.(keymanager.GenesisValidatorsRootSetter); ok {
+		genesis, err := (*nodeClient).GetGenesis(cliCtx.Context, &ptypes.Empty{})
+		if err != nil {
+			return errors.Wrap(err, "could not get genesis info from beacon node")
+		}
+		setter.SetGenesisValidatorsRoot(genesis.GenesisValidatorsRoot)
+	}
+	dataDir, err := exitDataDir(cliCtx)
+	if err != nil {
+		return err
+	}
+	valDB, err := openValidatorDB(dataDir, true /* create */)
+	if err != nil {
+		return err
+	}
+	defer closeValidatorDB(valDB)
+
+	submitted, err := BulkExit(cliCtx.Context, &BulkExitConfig{
+		ValidatorClient:    *validatorClient,
+		NodeClient:         *nodeClient,
+		Keymanager:         km,
+		DB:                 valDB,
+		PublicKeys:         pubKeys,
+		Epoch:              cliCtx.Uint64(flags.ExitEpochFlag.Name),
+		SubmissionInterval: cliCtx.Duration(flags.ExitSubmissionIntervalFlag.Name),
+	})
+	if err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"submitted": len(submitted),
+		"requested": len(pubKeys),
+	}).Info("Bulk voluntary exit finished, use the exit-status command to track the exits")
+	return nil
+}
+
+// ExitStatusCli displays the progress of the voluntary exits kept in the validator database.
+// This function uses the CLI to extract necessary values.
+func ExitStatusCli(cliCtx *cli.Context) error {
+	dataDir, err := exitDataDir(cliCtx)
+	if err != nil {
+		return err
+	}
+	valDB, err := openValidatorDB(dataDir, false /* create */)
+	if err != nil {
+		return err
+	}
+	if valDB == nil {
+		log.Info("No voluntary exits found, the validator database does not exist")
+		return nil
+	}
+	defer closeValidatorDB(valDB)
+	validatorClient, _, beaconChainClient, err := prepareClients(cliCtx)
+	if err != nil {
+		return err
+	}
+	statuses, err := VoluntaryExitStatuses(cliCtx.Context, *validatorClient, *beaconChainClient, valDB)
+	if err != nil {
+		return err
+	}
+	if len(statuses) == 0 {
+		log.Info("No voluntary exits found in the validator database")
+		return nil
+	}
+	farFutureEpoch := params.BeaconConfig().FarFutureEpoch
+	for _, st := range statuses {
+		fields := logrus.Fields{
+			"publicKey":      fmt.Sprintf("%#x", bytesutil.Trunc(st.PublicKey[:])),
+			"validatorIndex": st.ValidatorIndex,
+			"signedEpoch":    st.Epoch,
+			"submitted":      st.Submitted,
+			"status":         st.Status.String(),
+		}
+		if st.ExitEpoch != farFutureEpoch {
+			fields["exitEpoch"] = st.ExitEpoch
+		}
+		if st.WithdrawableEpoch != farFutureEpoch {
+			fields["withdrawableEpoch"] = st.WithdrawableEpoch
+		}
+		log.WithFields(fields).Info("Voluntary exit status")
+	}
+	return nil
+}
+
+// BulkExit signs voluntary exits for the specified accounts and submits them to the beacon
+// node, waiting the submission interval between two submissions. Exits are signed for the
+// configured epoch, or the current epoch if it has already passed, and kept in the validator
+// database before they are submitted. Exits scheduled for a future epoch are submitted once
+// the chain reaches that epoch, blocking until then, and exits which could not be submitted
+// are submitted by a later run. Accounts which are not active are skipped. It returns the
+// public keys of the accounts whose exits were submitted.
+func BulkExit(ctx context.Context, cfg *BulkExitConfig) ([][]byte, error) {
+	currentEpoch, err := client.CurrentEpoch(ctx, cfg.NodeClient)
+	if err != nil {
+		return nil, err
+	}
+	exitEpoch := cfg.Epoch
+	if exitEpoch < currentEpoch {
+		exitEpoch = currentEpoch
+	}
+	storedExits, err := cfg.DB.VoluntaryExits(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get voluntary exits from database")
+	}
+	storedExitsByKey := make(map[[48]byte]*kv.VoluntaryExit, len(storedExits))
+	for _, exit := range storedExits {
+		storedExitsByKey[exit.PublicKey] = exit
+	}
+	statusResp, err := cfg.ValidatorClient.MultipleValidatorStatus(ctx, &ethpb.MultipleValidatorStatusRequest{
+		PublicKeys: cfg.PublicKeys,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "gRPC call to get validator statuses failed")
+	}
+	statuses := make(map[[48]byte]*ethpb.ValidatorStatusResponse, len(statusResp.PublicKeys))
+	indices := make(map[[48]byte]uint64, len(statusResp.PublicKeys))
+	for i, pubKey := range statusResp.PublicKeys {
+		statuses[bytesutil.ToBytes48(pubKey)] = statusResp.Statuses[i]
+		indices[bytesutil.ToBytes48(pubKey)] = statusResp.Indices[i]
+	}
+
+	pending := make([]*kv.VoluntaryExit, 0, len(cfg.PublicKeys))
+	signed := make([]*kv.VoluntaryExit, 0, len(cfg.PublicKeys))
+	for _, pubKey := range cfg.PublicKeys {
+		key := bytesutil.ToBytes48(pubKey)
+		formattedKey := fmt.Sprintf("%#x", bytesutil.Trunc(pubKey))
+		if stored, ok := storedExitsByKey[key]; ok {
+			if stored.Submitted {
+				log.WithField("publicKey", formattedKey).Info("Voluntary exit was already submitted")
+				continue
+			}
+			pending = append(pending, stored)
+			continue
+		}
+		st, ok := statuses[key]
+		if !ok || st.Status != ethpb.ValidatorStatus_ACTIVE {
+			status := ethpb.ValidatorStatus_UNKNOWN_STATUS
+			if ok {
+				status = st.Status
+			}
+			log.WithFields(logrus.Fields{
+				"publicKey": formattedKey,
+				"status":    status.String(),
+			}).Warn("Skipping voluntary exit of account which is not active")
+			continue
+		}
+		signedExit, err := client.CreateSignedVoluntaryExit(
+			ctx, cfg.ValidatorClient, cfg.Keymanager.Sign, pubKey, indices[key], exitEpoch,
+		)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not sign voluntary exit for account %s", formattedKey)
+		}
+		exit := &kv.VoluntaryExit{PublicKey: key, SignedExit: signedExit}
+		signed = append(signed, exit)
+		pending = append(pending, exit)
+	}
+	if err := cfg.DB.SaveVoluntaryExits(ctx, signed); err != nil {
+		return nil, errors.Wrap(err, "could not save voluntary exits to database")
+	}
+
+	sort.SliceStable(pending, func(i, j int) bool {
+		return pending[i].SignedExit.Exit.Epoch < pending[j].SignedExit.Exit.Epoch
+	})
+	submitted := make([][]byte, 0, len(pending))
+	for i, exit := range pending {
+		if i > 0 && cfg.SubmissionInterval > 0 {
+			select {
+			case <-ctx.Done():
+				return submitted, ctx.Err()
+			case <-time.After(cfg.SubmissionInterval):
+			}
+		}
+		if err := waitForEpoch(ctx, cfg.NodeClient, exit.SignedExit.Exit.Epoch); err != nil {
+			return submitted, err
+		}
+		formattedKey := fmt.Sprintf("%#x", bytesutil.Trunc(exit.PublicKey[:]))
+		if _, err := cfg.ValidatorClient.ProposeExit(ctx, exit.SignedExit); err != nil {
+			msg := err.Error()
+			if !strings.Contains(msg, blocks.ValidatorAlreadyExitedMsg) {
+				log.WithError(err).Errorf("Voluntary exit failed for account %s, it is submitted again by the next run", formattedKey)
+				continue
+			}
+			log.Warningf("Could not perform voluntary exit for account %s: %s", formattedKey, msg)
+		}
+		exit.Submitted = true
+		if err := cfg.DB.SaveVoluntaryExits(ctx, []*kv.VoluntaryExit{exit}); err != nil {
+			return submitted, errors.Wrap(err, "could not save voluntary exit to database")
+		}
+		submitted = append(submitted, exit.PublicKey[:])
+		log.WithFields(logrus.Fields{
+			"publicKey": formattedKey,
+			"epoch":     exit.SignedExit.Exit.Epoch,
+		}).Info("Submitted voluntary exit")
+	}
+	return submitted, nil
+}
+
+// VoluntaryExitStatuses retrieves the status of the validators of all voluntary exits kept in
+// the validator database, as well as the epochs at which they exit and become withdrawable.
+func VoluntaryExitStatuses(
+	ctx context.Context,
+	validatorClient ethpb.BeaconNodeValidatorClient,
+	beaconChainClient ethpb.BeaconChainClient,
+	valDB db.Database,
+) ([]*ExitStatus, error) {
+	exits, err := valDB.VoluntaryExits(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get voluntary exits from database")
+	}
+	if len(exits) == 0 {
+		return []*ExitStatus{}, nil
+	}
+	pubKeys := make([][]byte, len(exits))
+	for i, exit := range exits {
+		pubKeys[i] = exit.PublicKey[:]
+	}
+	statusResp, err := validatorClient.MultipleValidatorStatus(ctx, &ethpb.MultipleValidatorStatusRequest{
+		PublicKeys: pubKeys,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "gRPC call to get validator statuses failed")
+	}
+	statuses := make(map[[48]byte]ethpb.ValidatorStatus, len(statusResp.PublicKeys))
+	for i, pubKey := range statusResp.PublicKeys {
+		statuses[bytesutil.ToBytes48(pubKey)] = statusResp.Statuses[i].Status
+	}
+	validators := make(map[[48]byte]*ethpb.Validator, len(exits))
+	req := &ethpb.ListValidatorsRequest{PublicKeys: pubKeys}
+	for {
+		resp, err := beaconChainClient.ListValidators(ctx, req)
+		if err != nil {
+			return nil, errors.Wrap(err, "gRPC call to list validators failed")
+		}
+		for _, container := range resp.ValidatorList {
+			validators[bytesutil.ToBytes48(container.Validator.PublicKey)] = container.Validator
+		}
+		if resp.NextPageToken == "" || len(resp.ValidatorList) == 0 {
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+
+	farFutureEpoch := params.BeaconConfig().FarFutureEpoch
+	exitStatuses := make([]*ExitStatus, len(exits))
+	for i, exit := range exits {
+		exitStatuses[i] = &ExitStatus{
+			PublicKey:         exit.PublicKey,
+			ValidatorIndex:    exit.SignedExit.Exit.ValidatorIndex,
+			Epoch:             exit.SignedExit.Exit.Epoch,
+			Submitted:         exit.Submitted,
+			Status:            statuses[exit.PublicKey],
+			ExitEpoch:         farFutureEpoch,
+			WithdrawableEpoch: farFutureEpoch,
+		}
+		if validator, ok := validators[exit.PublicKey]; ok {
+			exitStatuses[i].ExitEpoch = validator.ExitEpoch
+			exitStatuses[i].WithdrawableEpoch = validator.WithdrawableEpoch
+		}
+	}
+	return exitStatuses, nil
+}
+
+// Waits until the chain reaches the given epoch, checking the current epoch every slot.
+func waitForEpoch(ctx context.Context, nodeClient ethpb.NodeClient, epoch uint64) error {
+	for {
+		currentEpoch, err := client.CurrentEpoch(ctx, nodeClient)
+		if err != nil {
+			return err
+		}
+		if currentEpoch >= epoch {
+			return nil
+		}
+		log.WithFields(logrus.Fields{
+			"currentEpoch": currentEpoch,
+			"exitEpoch":    epoch,
+		}).Info("Waiting for the epoch of scheduled voluntary exits")
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second):
+		}
+	}
+}
+
+// Reads the public keys listed in a file, one hex string per line, ignoring
+// empty lines and lines starting with #.
+func readExitPublicKeysFile(path string) ([][]byte, error) {
+	if path == "" {
+		return nil, fmt.Errorf("no public keys file specified, use the --%s flag", flags.ExitPublicKeysFileFlag.Name)
+	}
+	path, err := fileutil.ExpandPath(path)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open public keys file")
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Error("Could not close public keys file")
+		}
+	}()
+	pubKeys := make([][]byte, 0)
+	seen := make(map[[48]byte]bool)
+	scanner := bufio.NewScanner(f)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		pubKey, err := hex.DecodeString(strings.TrimPrefix(line, "0x"))
+		if err != nil || len(pubKey) != 48 {
+			return nil, fmt.Errorf("invalid public key %q on line %d", line, lineNum)
+		}
+		if seen[bytesutil.ToBytes48(pubKey)] {
+			continue
+		}
+		seen[bytesutil.ToBytes48(pubKey)] = true
+		pubKeys = append(pubKeys, pubKey)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "could not read public keys file")
+	}
+	if len(pubKeys) == 0 {
+		return nil, errors.New("public keys file does not list any public keys")
+	}
+	return pubKeys, nil
+}
+
+// Returns the directory of the validator database keeping the voluntary exits, which is
+// the accounts directory of the wallet unless a data directory is specified.
+func exitDataDir(cliCtx *cli.Context) (string, error) {
+	if cliCtx.IsSet(cmd.DataDirFlag.Name) {
+		return cliCtx.String(cmd.DataDirFlag.Name), nil
+	}
+	walletDir, err := fileutil.ExpandPath(cliCtx.String(flags.WalletDirFlag.Name))
+	if err != nil {
+		return "", err
+	}
+	w, err := wallet.OpenWallet(cliCtx.Context, &wallet.Config{WalletDir: walletDir})
+	if err != nil {
+		return "", errors.Wrap(err, "could not open wallet")
+	}
+	return w.AccountsDir(), nil
+}
@@ -0,0 +1,237 @@
+package accounts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+)
+
+func TestBulkExit_SubmitsAndPersistsExits(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockValidatorClient := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	mockNodeClient := mock.NewMockNodeClient(ctrl)
+	_, km, _, pubKeys := setupMigrationWallets(t)
+	valDB := dbtest.SetupDB(t, pubKeys)
+
+	// Any time in the past will suffice.
+	mockNodeClient.EXPECT().
+		GetGenesis(gomock.Any(), gomock.Any()).
+		Return(&ethpb.Genesis{GenesisTime: &types.Timestamp{Seconds: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC).Unix()}}, nil).
+		AnyTimes()
+	mockValidatorClient.EXPECT().
+		MultipleValidatorStatus(gomock.Any(), gomock.Any()).
+		Return(&ethpb.MultipleValidatorStatusResponse{
+			PublicKeys: [][]byte{pubKeys[0][:], pubKeys[1][:], pubKeys[2][:]},
+			Statuses: []*ethpb.ValidatorStatusResponse{
+				{Status: ethpb.ValidatorStatus_ACTIVE},
+				{Status: ethpb.ValidatorStatus_EXITED},
+				{Status: ethpb.ValidatorStatus_ACTIVE},
+			},
+			Indices: []uint64{1, 2, 3},
+		}, nil).
+		Times(2)
+	// Only active accounts are signed for, and only once.
+	mockValidatorClient.EXPECT().
+		DomainData(gomock.Any(), gomock.Any()).
+		Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil).
+		Times(2)
+	gomock.InOrder(
+		mockValidatorClient.EXPECT().
+			ProposeExit(gomock.Any(), gomock.Any()).
+			Return(&ethpb.ProposeExitResponse{}, nil),
+		mockValidatorClient.EXPECT().
+			ProposeExit(gomock.Any(), gomock.Any()).
+			Return(nil, errors.New("beacon node unavailable")),
+		mockValidatorClient.EXPECT().
+			ProposeExit(gomock.Any(), gomock.Any()).
+			Return(&ethpb.ProposeExitResponse{}, nil),
+	)
+
+	cfg := &BulkExitConfig{
+		ValidatorClient: mockValidatorClient,
+		NodeClient:      mockNodeClient,
+		Keymanager:      km,
+		DB:              valDB,
+		PublicKeys:      [][]byte{pubKeys[0][:], pubKeys[1][:], pubKeys[2][:]},
+	}
+	submitted, err := BulkExit(ctx, cfg)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][]byte{pubKeys[0][:]}, submitted)
+	exits, err := valDB.VoluntaryExits(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(exits))
+	submittedByKey := make(map[[48]byte]bool)
+	for _, exit := range exits {
+		submittedByKey[exit.PublicKey] = exit.Submitted
+	}
+	assert.Equal(t, true, submittedByKey[pubKeys[0]])
+	assert.Equal(t, false, submittedByKey[pubKeys[2]])
+
+	// The exit which could not be submitted is submitted again without signing it again.
+	submitted, err = BulkExit(ctx, cfg)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][]byte{pubKeys[2][:]}, submitted)
+	exits, err = valDB.VoluntaryExits(ctx)
+	require.NoError(t, err)
+	for _, exit := range exits {
+		assert.Equal(t, true, exit.Submitted)
+	}
+}
+
+func TestBulkExit_ScheduledExitIsKeptUntilEpoch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockValidatorClient := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	mockNodeClient := mock.NewMockNodeClient(ctrl)
+	_, km, _, pubKeys := setupMigrationWallets(t)
+	valDB := dbtest.SetupDB(t, pubKeys)
+
+	mockNodeClient.EXPECT().
+		GetGenesis(gomock.Any(), gomock.Any()).
+		Return(&ethpb.Genesis{GenesisTime: &types.Timestamp{Seconds: timeutils.Now().Unix()}}, nil).
+		AnyTimes()
+	mockValidatorClient.EXPECT().
+		MultipleValidatorStatus(gomock.Any(), gomock.Any()).
+		Return(&ethpb.MultipleValidatorStatusResponse{
+			PublicKeys: [][]byte{pubKeys[0][:]},
+			Statuses:   []*ethpb.ValidatorStatusResponse{{Status: ethpb.ValidatorStatus_ACTIVE}},
+			Indices:    []uint64{1},
+		}, nil)
+	mockValidatorClient.EXPECT().
+		DomainData(gomock.Any(), gomock.Any()).
+		Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	submitted, err := BulkExit(ctx, &BulkExitConfig{
+		ValidatorClient: mockValidatorClient,
+		NodeClient:      mockNodeClient,
+		Keymanager:      km,
+		DB:              valDB,
+		PublicKeys:      [][]byte{pubKeys[0][:]},
+		Epoch:           100,
+	})
+	assert.ErrorContains(t, context.DeadlineExceeded.Error(), err)
+	assert.Equal(t, 0, len(submitted))
+
+	exits, err := valDB.VoluntaryExits(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(exits))
+	assert.Equal(t, uint64(100), exits[0].SignedExit.Exit.Epoch)
+	assert.Equal(t, uint64(1), exits[0].SignedExit.Exit.ValidatorIndex)
+	assert.Equal(t, false, exits[0].Submitted)
+}
+
+func TestVoluntaryExitStatuses(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockValidatorClient := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	mockBeaconChainClient := mock.NewMockBeaconChainClient(ctrl)
+	pubKeys := [][48]byte{{1}, {2}}
+	valDB := dbtest.SetupDB(t, pubKeys)
+
+	statuses, err := VoluntaryExitStatuses(ctx, mockValidatorClient, mockBeaconChainClient, valDB)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(statuses))
+
+	require.NoError(t, valDB.SaveVoluntaryExits(ctx, []*kv.VoluntaryExit{
+		{PublicKey: pubKeys[0], SignedExit: &ethpb.SignedVoluntaryExit{Exit: &ethpb.VoluntaryExit{Epoch: 5, ValidatorIndex: 1}}, Submitted: true},
+		{PublicKey: pubKeys[1], SignedExit: &ethpb.SignedVoluntaryExit{Exit: &ethpb.VoluntaryExit{Epoch: 7, ValidatorIndex: 2}}},
+	}))
+	mockValidatorClient.EXPECT().
+		MultipleValidatorStatus(gomock.Any(), gomock.Any()).
+		Return(&ethpb.MultipleValidatorStatusResponse{
+			PublicKeys: [][]byte{pubKeys[0][:], pubKeys[1][:]},
+			Statuses: []*ethpb.ValidatorStatusResponse{
+				{Status: ethpb.ValidatorStatus_EXITING},
+				{Status: ethpb.ValidatorStatus_ACTIVE},
+			},
+			Indices: []uint64{1, 2},
+		}, nil)
+	farFutureEpoch := params.BeaconConfig().FarFutureEpoch
+	gomock.InOrder(
+		mockBeaconChainClient.EXPECT().
+			ListValidators(gomock.Any(), gomock.Any()).
+			Return(&ethpb.Validators{
+				ValidatorList: []*ethpb.Validators_ValidatorContainer{
+					{Index: 1, Validator: &ethpb.Validator{PublicKey: pubKeys[0][:], ExitEpoch: 10, WithdrawableEpoch: 266}},
+				},
+				NextPageToken: "1",
+			}, nil),
+		mockBeaconChainClient.EXPECT().
+			ListValidators(gomock.Any(), gomock.Any()).
+			Return(&ethpb.Validators{
+				ValidatorList: []*ethpb.Validators_ValidatorContainer{
+					{Index: 2, Validator: &ethpb.Validator{PublicKey: pubKeys[1][:], ExitEpoch: farFutureEpoch, WithdrawableEpoch: farFutureEpoch}},
+				},
+			}, nil),
+	)
+
+	statuses, err = VoluntaryExitStatuses(ctx, mockValidatorClient, mockBeaconChainClient, valDB)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*ExitStatus{
+		{
+			PublicKey:         pubKeys[0],
+			ValidatorIndex:    1,
+			Epoch:             5,
+			Submitted:         true,
+			Status:            ethpb.ValidatorStatus_EXITING,
+			ExitEpoch:         10,
+			WithdrawableEpoch: 266,
+		},
+		{
+			PublicKey:         pubKeys[1],
+			ValidatorIndex:    2,
+			Epoch:             7,
+			Status:            ethpb.ValidatorStatus_ACTIVE,
+			ExitEpoch:         farFutureEpoch,
+			WithdrawableEpoch: farFutureEpoch,
+		},
+	}, statuses)
+}
+
+func TestReadExitPublicKeysFile(t *testing.T) {
+	dir := t.TempDir()
+	pubKey1 := fmt.Sprintf("%#x", [48]byte{1})
+	pubKey2 := fmt.Sprintf("%x", [48]byte{2})
+	path := filepath.Join(dir, "keys.txt")
+	content := fmt.Sprintf("# Keys to exit\n%s\n\n  %s  \n%s\n", pubKey1, pubKey2, pubKey1)
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+	pubKeys, err := readExitPublicKeysFile(path)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(pubKeys))
+	assert.DeepEqual(t, [48]byte{1}, bytesutil.ToBytes48(pubKeys[0]))
+	assert.DeepEqual(t, [48]byte{2}, bytesutil.ToBytes48(pubKeys[1]))
+
+	invalidPath := filepath.Join(dir, "invalid.txt")
+	require.NoError(t, ioutil.WriteFile(invalidPath, []byte(pubKey1+"\n0x1234\n"), 0600))
+	_, err = readExitPublicKeysFile(invalidPath)
+	assert.ErrorContains(t, "invalid public key \"0x1234\" on line 2", err)
+
+	emptyPath := filepath.Join(dir, "empty.txt")
+	require.NoError(t, ioutil.WriteFile(emptyPath, []byte("# nothing\n"), 0600))
+	_, err = readExitPublicKeysFile(emptyPath)
+	assert.ErrorContains(t, "does not list any public keys", err)
+
+	_, err = readExitPublicKeysFile("")
+	assert.ErrorContains(t, "no public keys file specified", err)
+}
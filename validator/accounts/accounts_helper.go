@@ -3,10 +3,14 @@ package accounts
 import (
 	"encoding/hex"
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
 	"github.com/urfave/cli/v2"
 )
 
@@ -44,3 +48,26 @@ func filterPublicKeysFromUserInput(
 	}
 	return selectAccounts(selectionPrompt, validatingPublicKeys)
 }
+
+// openValidatorDB opens the validator database in the given data directory. If create is false
+// and there is no database yet, it returns nil instead of creating one.
+func openValidatorDB(dataDir string, create bool) (db.Database, error) {
+	dataDir, err := fileutil.ExpandPath(dataDir)
+	if err != nil {
+		return nil, err
+	}
+	if !create && !fileutil.FileExists(filepath.Join(dataDir, kv.ProtectionDbFileName)) {
+		return nil, nil
+	}
+	valDB, err := kv.NewKVStore(dataDir, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not open validator database in %s", dataDir)
+	}
+	return valDB, nil
+}
+
+func closeValidatorDB(valDB db.Database) {
+	if err := valDB.Close(); err != nil {
+		log.WithError(err).Error("Could not close validator database")
+	}
+}
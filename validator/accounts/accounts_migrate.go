@@ -0,0 +1,384 @@
+package accounts
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/promptutil"
+	"github.com/prysmaticlabs/prysm/validator/accounts/prompt"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
+	interchangeformat "github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format"
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// MigrateAccountsConfig specifies the accounts to migrate and the wallets, as well as
+// the validator databases holding their slashing protection history, to migrate them between.
+type MigrateAccountsConfig struct {
+	SourceWallet     *wallet.Wallet
+	SourceKeymanager keymanager.IKeymanager
+	SourceDB         db.Database
+	TargetWallet     *wallet.Wallet
+	TargetDB         db.Database
+	PublicKeys       [][]byte
+}
+
+// Keymanagers holding the secret keys of their accounts, from which accounts can be migrated.
+type migratableKeymanager interface {
+	ExtractKeystores(ctx context.Context, publicKeys []bls.PublicKey, password string) ([]*keymanager.Keystore, error)
+	DisableAccounts(ctx context.Context, pubKeys [][]byte) error
+	EnableAccounts(ctx context.Context, pubKeys [][]byte) error
+	DisabledPublicKeys() [][]byte
+}
+
+// MigrateAccountsCli migrates the accounts that the user selects from the wallet to
+// a target wallet of another keymanager kind, along with their slashing protection history.
+// This function uses the CLI to extract necessary values.
+func MigrateAccountsCli(cliCtx *cli.Context) error {
+	sourceWallet, err := wallet.OpenWalletOrElseCli(cliCtx, func(cliCtx *cli.Context) (*wallet.Wallet, error) {
+		return nil, wallet.ErrNoWalletFound
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not open wallet")
+	}
+	km, err := sourceWallet.InitializeKeymanager(cliCtx.Context)
+	if err != nil {
+		return errors.Wrap(err, "could not initialize keymanager")
+	}
+	validatingPublicKeys, err := km.FetchAllValidatingPublicKeys(cliCtx.Context)
+	if err != nil {
+		return err
+	}
+	if len(validatingPublicKeys) == 0 {
+		return errors.New("wallet is empty, no accounts to migrate")
+	}
+	targetWallet, err := openMigrationTargetWallet(cliCtx)
+	if err != nil {
+		return err
+	}
+	// Allow the user to interactively select the accounts to migrate or optionally
+	// provide them via cli flags as a string of comma-separated, hex strings.
+	filteredPubKeys, err := filterPublicKeysFromUserInput(
+		cliCtx,
+		flags.MigratePublicKeysFlag,
+		validatingPublicKeys,
+		prompt.SelectAccountsMigratePromptText,
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not filter public keys for migration")
+	}
+	rawPublicKeys := make([][]byte, len(filteredPubKeys))
+	formattedPubKeys := make([]string, len(filteredPubKeys))
+	for i, pk := range filteredPubKeys {
+		pubKeyBytes := pk.Marshal()
+		rawPublicKeys[i] = pubKeyBytes
+		formattedPubKeys[i] = fmt.Sprintf("%#x", bytesutil.Trunc(pubKeyBytes))
+	}
+	allAccountStr := strings.Join(formattedPubKeys, ", ")
+	if !cliCtx.IsSet(flags.MigratePublicKeysFlag.Name) {
+		promptText := fmt.Sprintf(
+			"Are you sure you want to migrate %d account(s) to the %s wallet at %s? (%s) Y/N",
+			len(filteredPubKeys), targetWallet.KeymanagerKind(), targetWallet.AccountsDir(), au.BrightGreen(allAccountStr),
+		)
+		resp, err := promptutil.ValidatePrompt(os.Stdin, promptText, promptutil.ValidateYesOrNo)
+		if err != nil {
+			return err
+		}
+		if strings.ToLower(resp) == "n" {
+			return nil
+		}
+	}
+
+	sourceDataDir := sourceWallet.AccountsDir()
+	if cliCtx.IsSet(cmd.DataDirFlag.Name) {
+		sourceDataDir = cliCtx.String(cmd.DataDirFlag.Name)
+	}
+	sourceDB, err := openMigrationDB(sourceDataDir, false /* create */)
+	if err != nil {
+		return err
+	}
+	if sourceDB != nil {
+		defer closeMigrationDB(sourceDB)
+	}
+	targetDataDir := targetWallet.AccountsDir()
+	if cliCtx.IsSet(flags.MigrateTargetDataDirFlag.Name) {
+		targetDataDir = cliCtx.String(flags.MigrateTargetDataDirFlag.Name)
+	}
+	targetDB, err := openMigrationDB(targetDataDir, true /* create */)
+	if err != nil {
+		return err
+	}
+	defer closeMigrationDB(targetDB)
+
+	if err := MigrateAccounts(cliCtx.Context, &MigrateAccountsConfig{
+		SourceWallet:     sourceWallet,
+		SourceKeymanager: km,
+		SourceDB:         sourceDB,
+		TargetWallet:     targetWallet,
+		TargetDB:         targetDB,
+		PublicKeys:       rawPublicKeys,
+	}); err != nil {
+		return err
+	}
+	log.WithFields(logrus.Fields{
+		"publicKeys":   allAccountStr,
+		"targetWallet": targetWallet.AccountsDir(),
+	}).Info("Accounts migrated, they are disabled in the source wallet")
+	return nil
+}
+
+// MigrateAccounts moves the specified accounts from the source wallet to the target wallet and
+// carries their slashing protection history along in the EIP-3076 interchange format. Accounts
+// migrated to an imported wallet are imported with their secret keys, while remote signing
+// wallets must already hold the keys. The accounts are disabled in the source wallet before they
+// are made available in the target wallet, and enabled again if the migration fails, so that the
+// accounts are never enabled in both wallets. Accounts disabled in the source wallet are also
+// disabled in an imported target wallet.
+func MigrateAccounts(ctx context.Context, cfg *MigrateAccountsConfig) error {
+	if len(cfg.PublicKeys) == 0 {
+		return errors.New("no public keys specified to migrate")
+	}
+	if cfg.SourceWallet.AccountsDir() == cfg.TargetWallet.AccountsDir() {
+		return errors.New("cannot migrate accounts to the same wallet")
+	}
+	var sourceKM migratableKeymanager
+	switch cfg.SourceWallet.KeymanagerKind() {
+	case keymanager.Imported, keymanager.Derived:
+		km, ok := cfg.SourceKeymanager.(migratableKeymanager)
+		if !ok {
+			return errors.New("source keymanager does not support extracting accounts")
+		}
+		sourceKM = km
+	default:
+		return fmt.Errorf(
+			"cannot migrate accounts from a %s wallet, as it does not hold the secret keys",
+			cfg.SourceWallet.KeymanagerKind(),
+		)
+	}
+	switch cfg.TargetWallet.KeymanagerKind() {
+	case keymanager.Imported, keymanager.Remote, keymanager.Web3Signer:
+	case keymanager.Derived:
+		return errors.New("cannot migrate accounts to a derived wallet, as its keys are derived from its mnemonic")
+	default:
+		return fmt.Errorf("keymanager kind %s not supported", cfg.TargetWallet.KeymanagerKind())
+	}
+
+	allPublicKeys, err := cfg.SourceKeymanager.FetchAllValidatingPublicKeys(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not fetch source wallet accounts")
+	}
+	inSource := make(map[[48]byte]bool, len(allPublicKeys))
+	for _, pubKey := range allPublicKeys {
+		inSource[pubKey] = true
+	}
+	disabledInSource := make(map[[48]byte]bool)
+	for _, pubKey := range sourceKM.DisabledPublicKeys() {
+		disabledInSource[bytesutil.ToBytes48(pubKey)] = true
+	}
+	blsPublicKeys := make([]bls.PublicKey, len(cfg.PublicKeys))
+	protectedPublicKeys := make([][48]byte, len(cfg.PublicKeys))
+	var toDisable, alreadyDisabled [][]byte
+	for i, pubKey := range cfg.PublicKeys {
+		pubKey48 := bytesutil.ToBytes48(pubKey)
+		if !inSource[pubKey48] {
+			return fmt.Errorf("public key %#x not found in source wallet", pubKey)
+		}
+		blsPublicKeys[i], err = bls.PublicKeyFromBytes(pubKey)
+		if err != nil {
+			return errors.Wrapf(err, "%#x is not a valid BLS public key", pubKey)
+		}
+		protectedPublicKeys[i] = pubKey48
+		if disabledInSource[pubKey48] {
+			alreadyDisabled = append(alreadyDisabled, pubKey)
+		} else {
+			toDisable = append(toDisable, pubKey)
+		}
+	}
+
+	// Everything is read from the source wallet before the target keymanager is initialized,
+	// as the secret keys of imported keymanagers are cached per process.
+	slashingProtectionJSON, err := exportMigratedSlashingProtection(ctx, cfg.SourceDB, protectedPublicKeys)
+	if err != nil {
+		return errors.Wrap(err, "could not export slashing protection history")
+	}
+	var keystores []*keymanager.Keystore
+	if cfg.TargetWallet.KeymanagerKind() == keymanager.Imported {
+		keystores, err = sourceKM.ExtractKeystores(ctx, blsPublicKeys, cfg.TargetWallet.Password())
+		if err != nil {
+			return errors.Wrap(err, "could not extract keystores from source wallet")
+		}
+	}
+
+	if len(toDisable) > 0 {
+		if err := sourceKM.DisableAccounts(ctx, toDisable); err != nil {
+			return errors.Wrap(err, "could not disable accounts in source wallet")
+		}
+	}
+	if err := migrateToTarget(ctx, cfg, slashingProtectionJSON, keystores, alreadyDisabled); err != nil {
+		if len(toDisable) > 0 {
+			if enableErr := sourceKM.EnableAccounts(ctx, toDisable); enableErr != nil {
+				log.WithError(enableErr).Error("Could not enable accounts in source wallet again")
+			}
+		}
+		return errors.Wrap(err, "could not migrate accounts to target wallet")
+	}
+	return nil
+}
+
+func migrateToTarget(
+	ctx context.Context,
+	cfg *MigrateAccountsConfig,
+	slashingProtectionJSON []byte,
+	keystores []*keymanager.Keystore,
+	disabledPublicKeys [][]byte,
+) error {
+	targetKM, err := cfg.TargetWallet.InitializeKeymanager(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not initialize target keymanager")
+	}
+	// The slashing protection history is imported first, as protecting keys which
+	// end up not being migrated cannot cause any harm.
+	if slashingProtectionJSON != nil {
+		if cfg.TargetDB == nil {
+			return errors.New("no target validator database to import slashing protection history into")
+		}
+		if err := interchangeformat.ImportStandardProtectionJSON(
+			ctx, cfg.TargetDB, bytes.NewReader(slashingProtectionJSON),
+		); err != nil {
+			return errors.Wrap(err, "could not import slashing protection history")
+		}
+	}
+	switch cfg.TargetWallet.KeymanagerKind() {
+	case keymanager.Imported:
+		km, ok := targetKM.(*imported.Keymanager)
+		if !ok {
+			return errors.New("not a imported keymanager")
+		}
+		if err := km.ImportKeystores(ctx, keystores, cfg.TargetWallet.Password()); err != nil {
+			return errors.Wrap(err, "could not import keystores")
+		}
+		if len(disabledPublicKeys) > 0 {
+			if err := km.DisableAccounts(ctx, disabledPublicKeys); err != nil {
+				return errors.Wrap(err, "could not disable accounts in target wallet")
+			}
+		}
+	default:
+		// Remote signers hold the secret keys themselves, so we can only check that
+		// they were loaded with the migrated accounts.
+		targetPublicKeys, err := targetKM.FetchAllValidatingPublicKeys(ctx)
+		if err != nil {
+			return errors.Wrap(err, "could not fetch target wallet accounts")
+		}
+		inTarget := make(map[[48]byte]bool, len(targetPublicKeys))
+		for _, pubKey := range targetPublicKeys {
+			inTarget[pubKey] = true
+		}
+		var missing []string
+		for _, pubKey := range cfg.PublicKeys {
+			if !inTarget[bytesutil.ToBytes48(pubKey)] {
+				missing = append(missing, fmt.Sprintf("%#x", pubKey))
+			}
+		}
+		if len(missing) > 0 {
+			return fmt.Errorf(
+				"remote signer of the target wallet does not hold the keys %s, please import them into it first",
+				strings.Join(missing, ", "),
+			)
+		}
+	}
+	return nil
+}
+
+// The slashing protection history of the migrated keys is exported from the source validator
+// database, if there is one with a history.
+func exportMigratedSlashingProtection(ctx context.Context, sourceDB db.Database, publicKeys [][48]byte) ([]byte, error) {
+	if sourceDB == nil {
+		log.Warn("No validator database found for the source wallet, no slashing protection history is migrated")
+		return nil, nil
+	}
+	genesisValidatorsRoot, err := sourceDB.GenesisValidatorsRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(genesisValidatorsRoot) == 0 {
+		log.Warn("Source validator database has not been used yet, no slashing protection history is migrated")
+		return nil, nil
+	}
+	eipJSON, err := interchangeformat.ExportStandardProtectionJSONWithOptions(
+		ctx, sourceDB, &interchangeformat.ExportOptions{PublicKeys: publicKeys},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(eipJSON)
+}
+
+func openMigrationTargetWallet(cliCtx *cli.Context) (*wallet.Wallet, error) {
+	walletDir, err := fileutil.ExpandPath(cliCtx.String(flags.MigrateTargetWalletDirFlag.Name))
+	if err != nil {
+		return nil, err
+	}
+	if walletDir == "" {
+		return nil, fmt.Errorf("no target wallet specified, please use the --%s flag", flags.MigrateTargetWalletDirFlag.Name)
+	}
+	w, err := wallet.OpenWallet(cliCtx.Context, &wallet.Config{WalletDir: walletDir})
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open target wallet")
+	}
+	if w.KeymanagerKind() != keymanager.Imported {
+		return w, nil
+	}
+	var walletPassword string
+	if cliCtx.IsSet(flags.MigrateTargetWalletPasswordFileFlag.Name) {
+		data, err := fileutil.ReadFileAsBytes(cliCtx.String(flags.MigrateTargetWalletPasswordFileFlag.Name))
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read target wallet password file")
+		}
+		walletPassword = strings.TrimRight(string(data), "\r\n")
+	} else {
+		walletPassword, err = promptutil.PasswordPrompt("Target wallet password", wallet.ValidateExistingPass)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read target wallet password")
+		}
+	}
+	return wallet.OpenWallet(cliCtx.Context, &wallet.Config{
+		WalletDir:      walletDir,
+		WalletPassword: walletPassword,
+	})
+}
+
+func openMigrationDB(dataDir string, create bool) (db.Database, error) {
+	dataDir, err := fileutil.ExpandPath(dataDir)
+	if err != nil {
+		return nil, err
+	}
+	if !create && !fileutil.FileExists(filepath.Join(dataDir, kv.ProtectionDbFileName)) {
+		return nil, nil
+	}
+	valDB, err := kv.NewKVStore(dataDir, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not open validator database in %s", dataDir)
+	}
+	return valDB, nil
+}
+
+func closeMigrationDB(valDB db.Database) {
+	if err := valDB.Close(); err != nil {
+		log.WithError(err).Error("Could not close validator database")
+	}
+}
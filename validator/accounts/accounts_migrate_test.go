@@ -0,0 +1,162 @@
+package accounts
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
+)
+
+func setupMigrationWallets(t *testing.T) (*wallet.Wallet, *derived.Keymanager, *wallet.Wallet, [][48]byte) {
+	ctx := context.Background()
+	sourceWallet, err := CreateWalletWithKeymanager(ctx, &CreateWalletConfig{
+		WalletCfg: &wallet.Config{
+			WalletDir:      filepath.Join(t.TempDir(), "source"),
+			KeymanagerKind: keymanager.Derived,
+			WalletPassword: password,
+		},
+	})
+	require.NoError(t, err)
+	sourceKM, err := derived.NewKeymanager(ctx, &derived.SetupConfig{Wallet: sourceWallet})
+	require.NoError(t, err)
+	require.NoError(t, sourceKM.RecoverAccountsFromMnemonic(ctx, testMnemonic, "", 3))
+	pubKeys, err := sourceKM.FetchAllValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(pubKeys))
+
+	targetWallet, err := CreateWalletWithKeymanager(ctx, &CreateWalletConfig{
+		WalletCfg: &wallet.Config{
+			WalletDir:      filepath.Join(t.TempDir(), "target"),
+			KeymanagerKind: keymanager.Imported,
+			WalletPassword: password,
+		},
+	})
+	require.NoError(t, err)
+	return sourceWallet, sourceKM, targetWallet, pubKeys
+}
+
+func TestMigrateAccounts_DerivedToImported(t *testing.T) {
+	ctx := context.Background()
+	sourceWallet, sourceKM, targetWallet, pubKeys := setupMigrationWallets(t)
+	// The third account is already disabled in the source wallet.
+	require.NoError(t, sourceKM.DisableAccounts(ctx, [][]byte{pubKeys[2][:]}))
+
+	sourceDB := dbtest.SetupDB(t, pubKeys)
+	require.NoError(t, sourceDB.SaveGenesisValidatorsRoot(ctx, make([]byte, 32)))
+	require.NoError(t, sourceDB.SaveProposalHistoryForSlot(ctx, pubKeys[0], 10, make([]byte, 32)))
+	require.NoError(t, sourceDB.SaveProposalHistoryForSlot(ctx, pubKeys[1], 11, make([]byte, 32)))
+	targetDB := dbtest.SetupDB(t, [][48]byte{})
+
+	require.NoError(t, MigrateAccounts(ctx, &MigrateAccountsConfig{
+		SourceWallet:     sourceWallet,
+		SourceKeymanager: sourceKM,
+		SourceDB:         sourceDB,
+		TargetWallet:     targetWallet,
+		TargetDB:         targetDB,
+		PublicKeys:       [][]byte{pubKeys[0][:], pubKeys[2][:]},
+	}))
+
+	// The migrated accounts are imported into the target wallet, keeping their disabled state.
+	targetKM, err := targetWallet.InitializeKeymanager(ctx)
+	require.NoError(t, err)
+	targetKeys, err := targetKM.FetchAllValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(targetKeys))
+	enabledTargetKeys, err := targetKM.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][48]byte{pubKeys[0]}, enabledTargetKeys)
+
+	// Only the slashing protection history of the migrated accounts is carried along.
+	_, exists, err := targetDB.ProposalHistoryForSlot(ctx, pubKeys[0], 10)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	proposedKeys, err := targetDB.ProposedPublicKeys(ctx)
+	require.NoError(t, err)
+	for _, pubKey := range proposedKeys {
+		assert.NotEqual(t, pubKeys[1], pubKey)
+	}
+
+	// The migrated accounts are disabled in the source wallet.
+	sourceKM, err = derived.NewKeymanager(ctx, &derived.SetupConfig{Wallet: sourceWallet})
+	require.NoError(t, err)
+	enabledSourceKeys, err := sourceKM.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][48]byte{pubKeys[1]}, enabledSourceKeys)
+}
+
+func TestMigrateAccounts_FailureKeepsSourceAccountsEnabled(t *testing.T) {
+	ctx := context.Background()
+	sourceWallet, sourceKM, targetWallet, pubKeys := setupMigrationWallets(t)
+	targetKM, err := imported.NewKeymanager(ctx, &imported.SetupConfig{Wallet: targetWallet})
+	require.NoError(t, err)
+	secretKey, err := bls.RandKey()
+	require.NoError(t, err)
+	require.NoError(t, targetKM.ImportKeypairs(ctx, [][]byte{secretKey.Marshal()}, [][]byte{secretKey.PublicKey().Marshal()}))
+	imported.ResetCaches()
+	sourceKM, err = derived.NewKeymanager(ctx, &derived.SetupConfig{Wallet: sourceWallet})
+	require.NoError(t, err)
+
+	// The target wallet cannot be unlocked with a wrong password.
+	wrongPasswordWallet, err := wallet.OpenWallet(ctx, &wallet.Config{
+		WalletDir:      filepath.Dir(targetWallet.AccountsDir()),
+		WalletPassword: "wrong password",
+	})
+	require.NoError(t, err)
+	err = MigrateAccounts(ctx, &MigrateAccountsConfig{
+		SourceWallet:     sourceWallet,
+		SourceKeymanager: sourceKM,
+		TargetWallet:     wrongPasswordWallet,
+		PublicKeys:       [][]byte{pubKeys[0][:]},
+	})
+	assert.ErrorContains(t, "could not migrate accounts to target wallet", err)
+
+	sourceKM, err = derived.NewKeymanager(ctx, &derived.SetupConfig{Wallet: sourceWallet})
+	require.NoError(t, err)
+	enabledSourceKeys, err := sourceKM.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(enabledSourceKeys))
+}
+
+func TestMigrateAccounts_InvalidWallets(t *testing.T) {
+	ctx := context.Background()
+	sourceWallet, sourceKM, _, pubKeys := setupMigrationWallets(t)
+
+	err := MigrateAccounts(ctx, &MigrateAccountsConfig{
+		SourceWallet:     sourceWallet,
+		SourceKeymanager: sourceKM,
+		TargetWallet:     sourceWallet,
+		PublicKeys:       [][]byte{pubKeys[0][:]},
+	})
+	assert.ErrorContains(t, "cannot migrate accounts to the same wallet", err)
+
+	derivedTarget := wallet.New(&wallet.Config{
+		WalletDir:      filepath.Join(t.TempDir(), "derived"),
+		KeymanagerKind: keymanager.Derived,
+	})
+	err = MigrateAccounts(ctx, &MigrateAccountsConfig{
+		SourceWallet:     sourceWallet,
+		SourceKeymanager: sourceKM,
+		TargetWallet:     derivedTarget,
+		PublicKeys:       [][]byte{pubKeys[0][:]},
+	})
+	assert.ErrorContains(t, "cannot migrate accounts to a derived wallet", err)
+
+	remoteSource := wallet.New(&wallet.Config{
+		WalletDir:      filepath.Join(t.TempDir(), "remote"),
+		KeymanagerKind: keymanager.Remote,
+	})
+	err = MigrateAccounts(ctx, &MigrateAccountsConfig{
+		SourceWallet: remoteSource,
+		TargetWallet: derivedTarget,
+		PublicKeys:   [][]byte{pubKeys[0][:]},
+	})
+	assert.ErrorContains(t, "cannot migrate accounts from a remote wallet", err)
+}
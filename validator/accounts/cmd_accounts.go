@@ -128,6 +128,40 @@ var AccountCommands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name: "migrate",
+			Description: "migrates selected accounts from an imported or derived wallet to a wallet of another " +
+				"keymanager kind, such as an imported or remote signing wallet, carrying their slashing protection " +
+				"history along and disabling them in the source wallet. Accounts to migrate can also be specified " +
+				"programmatically via a --migrate-public-keys flag which specifies a comma-separated list of hex " +
+				"string public keys",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				flags.WalletPasswordFileFlag,
+				cmd.DataDirFlag,
+				flags.MigrateTargetWalletDirFlag,
+				flags.MigrateTargetWalletPasswordFileFlag,
+				flags.MigrateTargetDataDirFlag,
+				flags.MigratePublicKeysFlag,
+				featureconfig.Mainnet,
+				featureconfig.PyrmontTestnet,
+				featureconfig.ToledoTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				featureconfig.ConfigureValidator(cliCtx)
+				if err := MigrateAccountsCli(cliCtx); err != nil {
+					log.Fatalf("Could not migrate accounts: %v", err)
+				}
+				return nil
+			},
+		},
 		{
 			Name:        "voluntary-exit",
 			Description: "Performs a voluntary exit on selected accounts",
@@ -161,5 +195,76 @@ var AccountCommands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name: "bulk-voluntary-exit",
+			Description: "Performs a voluntary exit on all accounts listed in a public keys file without selecting " +
+				"them interactively. Exits are submitted at a configurable interval, can be scheduled for a future " +
+				"epoch, in which case the command waits for that epoch, and are kept in the validator database to " +
+				"track their progress",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				flags.WalletPasswordFileFlag,
+				cmd.DataDirFlag,
+				flags.ExitPublicKeysFileFlag,
+				flags.ExitEpochFlag,
+				flags.ExitSubmissionIntervalFlag,
+				flags.ForceExitFlag,
+				flags.BeaconRPCProviderFlag,
+				cmd.GrpcMaxCallRecvMsgSizeFlag,
+				flags.CertFlag,
+				flags.GrpcHeadersFlag,
+				flags.GrpcRetriesFlag,
+				flags.GrpcRetryDelayFlag,
+				featureconfig.Mainnet,
+				featureconfig.PyrmontTestnet,
+				featureconfig.ToledoTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				featureconfig.ConfigureValidator(cliCtx)
+				if err := BulkExitAccountsCli(cliCtx, os.Stdin); err != nil {
+					log.Fatalf("Could not perform bulk voluntary exit: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name: "voluntary-exit-status",
+			Description: "Displays the progress of the voluntary exits kept in the validator database through " +
+				"the exit queue until the accounts are withdrawable",
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				cmd.DataDirFlag,
+				flags.BeaconRPCProviderFlag,
+				cmd.GrpcMaxCallRecvMsgSizeFlag,
+				flags.CertFlag,
+				flags.GrpcHeadersFlag,
+				flags.GrpcRetriesFlag,
+				flags.GrpcRetryDelayFlag,
+				featureconfig.Mainnet,
+				featureconfig.PyrmontTestnet,
+				featureconfig.ToledoTestnet,
+				cmd.AcceptTosFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				if err := cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags); err != nil {
+					return err
+				}
+				return tos.VerifyTosAcceptedOrPrompt(cliCtx)
+			},
+			Action: func(cliCtx *cli.Context) error {
+				featureconfig.ConfigureValidator(cliCtx)
+				if err := ExitStatusCli(cliCtx); err != nil {
+					log.Fatalf("Could not get voluntary exit status: %v", err)
+				}
+				return nil
+			},
+		},
 	},
 }
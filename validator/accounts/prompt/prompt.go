@@ -2,6 +2,7 @@ package prompt
 
 import (
 	"fmt"
+	"net/url"
 	"os"
 	"strings"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/promptutil"
 	"github.com/prysmaticlabs/prysm/validator/flags"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/remote"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/web3signer"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 )
@@ -31,6 +33,8 @@ const (
 	SelectAccountsDisablePromptText = "Select the account(s) you would like to disable"
 	// SelectAccountsEnablePromptText --
 	SelectAccountsEnablePromptText = "Select the account(s) you would like to enable"
+	// SelectAccountsMigratePromptText --
+	SelectAccountsMigratePromptText = "Select the account(s) you would like to migrate to the target wallet"
 )
 
 var (
@@ -134,6 +138,47 @@ func InputRemoteKeymanagerConfig(cliCtx *cli.Context) (*remote.KeymanagerOpts, e
 	return newCfg, nil
 }
 
+// InputWeb3SignerKeymanagerConfig via the cli.
+func InputWeb3SignerKeymanagerConfig(cliCtx *cli.Context) (*web3signer.KeymanagerOpts, error) {
+	signerURL := cliCtx.String(flags.Web3SignerURLFlag.Name)
+	log.Info("Input desired configuration")
+	var err error
+	if signerURL == "" {
+		signerURL, err = promptutil.ValidatePrompt(
+			os.Stdin,
+			"Remote signer URL (such as http://localhost:9000)",
+			validateSignerURL)
+		if err != nil {
+			return nil, err
+		}
+	} else if err := validateSignerURL(signerURL); err != nil {
+		return nil, err
+	}
+	maxRetries := cliCtx.Int(flags.Web3SignerMaxRetriesFlag.Name)
+	if maxRetries < 0 {
+		return nil, errors.New("max retries cannot be negative")
+	}
+	newCfg := &web3signer.KeymanagerOpts{
+		URL:                    strings.TrimRight(signerURL, "\r\n"),
+		TimeoutMillis:          uint64(cliCtx.Duration(flags.Web3SignerTimeoutFlag.Name).Milliseconds()),
+		MaxRetries:             maxRetries,
+		RefreshIntervalSeconds: uint64(cliCtx.Duration(flags.Web3SignerRefreshIntervalFlag.Name).Seconds()),
+	}
+	fmt.Printf("%s\n", newCfg)
+	return newCfg, nil
+}
+
+func validateSignerURL(input string) error {
+	u, err := url.Parse(strings.TrimRight(input, "\r\n"))
+	if err != nil {
+		return errors.Wrap(err, "invalid URL")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return errors.New("URL must start with http:// or https://")
+	}
+	return nil
+}
+
 func validateCertPath(input string) error {
 	if input == "" {
 		return errors.New("crt path cannot be empty")
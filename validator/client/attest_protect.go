@@ -50,6 +50,18 @@ func (v *validator) preAttSignValidations(ctx context.Context, indexedAtt *ethpb
 	if err != nil {
 		return errors.Wrap(err, "could not check if attestation is slashable")
 	}
+	if !slashable {
+		slashable, err = v.isAttBelowPrunedHistory(
+			ctx,
+			pubKey,
+			attesterHistory,
+			indexedAtt.Data.Source.Epoch,
+			indexedAtt.Data.Target.Epoch,
+		)
+		if err != nil {
+			return errors.Wrap(err, "could not check attestation against the highest signed epochs")
+		}
+	}
 	if slashable {
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
@@ -95,6 +107,18 @@ func (v *validator) postAttSignUpdate(ctx context.Context, indexedAtt *ethpb.Ind
 	if err != nil {
 		return errors.Wrap(err, "could not check if attestation is slashable")
 	}
+	if !slashable {
+		slashable, err = v.isAttBelowPrunedHistory(
+			ctx,
+			pubKey,
+			attesterHistory,
+			indexedAtt.Data.Source.Epoch,
+			indexedAtt.Data.Target.Epoch,
+		)
+		if err != nil {
+			return errors.Wrap(err, "could not check attestation against the highest signed epochs")
+		}
+	}
 	if slashable {
 		if v.emitAccountMetrics {
 			ValidatorAttestFailVec.WithLabelValues(fmtKey).Inc()
@@ -171,6 +195,47 @@ func isNewAttSlashable(
 	return isSurround, nil
 }
 
+// isAttBelowPrunedHistory checks an attestation against the highest signed source and target epochs
+// of a key when its attestation history does not cover them, as after importing a slashing protection
+// interchange file or pruning the history. Signing is then only safe for a target epoch after the
+// highest signed target epoch and a source epoch no earlier than the highest signed source epoch.
+func (v *validator) isAttBelowPrunedHistory(
+	ctx context.Context,
+	pubKey [48]byte,
+	history kv.EncHistoryData,
+	sourceEpoch,
+	targetEpoch uint64,
+) (bool, error) {
+	highestTarget, err := v.db.HighestSignedTargetEpoch(ctx, pubKey)
+	if err != nil {
+		return false, err
+	}
+	var latestEpochWritten uint64
+	if history != nil {
+		latestEpochWritten, err = history.GetLatestEpochWritten(ctx)
+		if err != nil {
+			return false, err
+		}
+	}
+	if latestEpochWritten >= highestTarget {
+		return false, nil
+	}
+	highestSource, err := v.db.HighestSignedSourceEpoch(ctx, pubKey)
+	if err != nil {
+		return false, err
+	}
+	if targetEpoch <= highestTarget || sourceEpoch < highestSource {
+		log.WithFields(logrus.Fields{
+			"targetEpoch":        targetEpoch,
+			"sourceEpoch":        sourceEpoch,
+			"highestTargetEpoch": highestTarget,
+			"highestSourceEpoch": highestSource,
+		}).Warn("Attempted to submit an attestation below the highest signed epochs, but blocked by slashing protection")
+		return true, nil
+	}
+	return false, nil
+}
+
 func isSurroundVote(
 	ctx context.Context,
 	history kv.EncHistoryData,
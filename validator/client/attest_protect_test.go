@@ -190,6 +190,39 @@ func TestAttestationHistory_BlocksDoubleAttestation(t *testing.T) {
 	}
 }
 
+func TestAttestationHistory_BlocksBelowHighestSignedEpochs(t *testing.T) {
+	ctx := context.Background()
+	validator, _, validatorKey, finish := setup(t)
+	defer finish()
+	pubKey := [48]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+
+	// The history of the key was pruned, only its highest signed epochs remain.
+	require.NoError(t, validator.db.SaveHighestSignedSourceEpoch(ctx, pubKey, 9))
+	require.NoError(t, validator.db.SaveHighestSignedTargetEpoch(ctx, pubKey, 10))
+	history := kv.NewAttestationHistoryArray(0)
+
+	slashable, err := validator.isAttBelowPrunedHistory(ctx, pubKey, history, 9, 10)
+	require.NoError(t, err)
+	require.Equal(t, true, slashable, "Expected attestation at the highest signed target epoch to be blocked")
+	slashable, err = validator.isAttBelowPrunedHistory(ctx, pubKey, history, 8, 11)
+	require.NoError(t, err)
+	require.Equal(t, true, slashable, "Expected attestation before the highest signed source epoch to be blocked")
+	slashable, err = validator.isAttBelowPrunedHistory(ctx, pubKey, history, 9, 11)
+	require.NoError(t, err)
+	require.Equal(t, false, slashable, "Expected attestation after the highest signed epochs to be allowed")
+
+	// Once the history covers the highest signed epochs, it is used instead.
+	history, err = kv.MarkAllAsAttestedSinceLatestWrittenEpoch(ctx, history, 11, &kv.HistoryData{
+		Source:      9,
+		SigningRoot: make([]byte, 32),
+	})
+	require.NoError(t, err)
+	slashable, err = validator.isAttBelowPrunedHistory(ctx, pubKey, history, 8, 12)
+	require.NoError(t, err)
+	require.Equal(t, false, slashable, "Expected the attestation history to be used")
+}
+
 func TestAttestationHistory_BlocksSurroundAttestationPostSignature(t *testing.T) {
 	ctx := context.Background()
 	att := &ethpb.IndexedAttestation{
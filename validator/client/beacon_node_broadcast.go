@@ -0,0 +1,136 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// broadcastValidatorClient publishes signed blocks, attestations and aggregates to all healthy
+// beacon nodes concurrently. All other requests, such as fetching duties, are sent to the active
+// beacon node only. Objects are signed, and recorded by slashing protection, once before they are
+// handed to the client, so broadcasting does not affect slashing protection.
+type broadcastValidatorClient struct {
+	ethpb.BeaconNodeValidatorClient
+	beaconNodes *beaconNodeHealthTracker
+}
+
+type broadcastResult struct {
+	endpoint string
+	res      interface{}
+	err      error
+}
+
+// ProposeBlock publishes the signed block to all healthy beacon nodes.
+func (c *broadcastValidatorClient) ProposeBlock(
+	ctx context.Context,
+	in *ethpb.SignedBeaconBlock,
+	opts ...grpc.CallOption,
+) (*ethpb.ProposeResponse, error) {
+	res, err := c.broadcast(ctx, "block", func(ctx context.Context, client ethpb.BeaconNodeValidatorClient) (interface{}, error) {
+		return client.ProposeBlock(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.ProposeResponse), nil
+}
+
+// ProposeAttestation publishes the signed attestation to all healthy beacon nodes.
+func (c *broadcastValidatorClient) ProposeAttestation(
+	ctx context.Context,
+	in *ethpb.Attestation,
+	opts ...grpc.CallOption,
+) (*ethpb.AttestResponse, error) {
+	res, err := c.broadcast(ctx, "attestation", func(ctx context.Context, client ethpb.BeaconNodeValidatorClient) (interface{}, error) {
+		return client.ProposeAttestation(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.AttestResponse), nil
+}
+
+// SubmitSignedAggregateSelectionProof publishes the signed aggregate and proof to all healthy beacon nodes.
+func (c *broadcastValidatorClient) SubmitSignedAggregateSelectionProof(
+	ctx context.Context,
+	in *ethpb.SignedAggregateSubmitRequest,
+	opts ...grpc.CallOption,
+) (*ethpb.SignedAggregateSubmitResponse, error) {
+	res, err := c.broadcast(ctx, "aggregate", func(ctx context.Context, client ethpb.BeaconNodeValidatorClient) (interface{}, error) {
+		return client.SubmitSignedAggregateSelectionProof(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ethpb.SignedAggregateSubmitResponse), nil
+}
+
+// Submits an object to all healthy beacon nodes concurrently and returns the response of the
+// first beacon node accepting it, so that a slow beacon node does not delay the validator. The
+// submissions to the remaining beacon nodes continue in the background for at most one slot.
+func (c *broadcastValidatorClient) broadcast(
+	ctx context.Context,
+	kind string,
+	submit func(context.Context, ethpb.BeaconNodeValidatorClient) (interface{}, error),
+) (interface{}, error) {
+	nodes := c.beaconNodes.broadcastTargets()
+	// The submissions outlive the request of the validator, but keep its gRPC headers.
+	md, _ := metadata.FromOutgoingContext(ctx)
+	broadcastCtx, cancel := context.WithTimeout(
+		metadata.NewOutgoingContext(context.Background(), md),
+		time.Duration(params.BeaconConfig().SecondsPerSlot)*time.Second,
+	)
+	results := make(chan broadcastResult, len(nodes))
+	for _, node := range nodes {
+		go func(node *beaconNode) {
+			res, err := submit(broadcastCtx, node.validatorClient)
+			results <- broadcastResult{endpoint: node.endpoint, res: res, err: err}
+		}(node)
+	}
+	logRemaining := func(remaining int) {
+		defer cancel()
+		for i := 0; i < remaining; i++ {
+			c.logResult(kind, <-results)
+		}
+	}
+
+	var firstErr error
+	for i := 0; i < len(nodes); i++ {
+		select {
+		case result := <-results:
+			c.logResult(kind, result)
+			if result.err == nil {
+				go logRemaining(len(nodes) - i - 1)
+				return result.res, nil
+			}
+			if firstErr == nil {
+				firstErr = result.err
+			}
+		case <-ctx.Done():
+			go logRemaining(len(nodes) - i)
+			return nil, ctx.Err()
+		}
+	}
+	cancel()
+	return nil, errors.Wrapf(firstErr, "could not publish %s to any beacon node", kind)
+}
+
+func (c *broadcastValidatorClient) logResult(kind string, result broadcastResult) {
+	c.beaconNodes.recordResult(result.endpoint, result.err)
+	entry := log.WithFields(logrus.Fields{
+		"endpoint": result.endpoint,
+		"type":     kind,
+	})
+	if result.err != nil {
+		entry.WithError(result.err).Warn("Could not publish to beacon node")
+		return
+	}
+	entry.Debug("Published to beacon node")
+}
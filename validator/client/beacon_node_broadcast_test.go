@@ -0,0 +1,138 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+)
+
+func setupBroadcastClient(
+	t *testing.T,
+	ctrl *gomock.Controller,
+) (*broadcastValidatorClient, *mock.MockBeaconNodeValidatorClient, []*mock.MockBeaconNodeValidatorClient, []*mockBeaconNode) {
+	tracker, nodes := setupBeaconNodes(t, ctrl, "primary:4000", "secondary:4000", "tertiary:4000")
+	validatorClients := make([]*mock.MockBeaconNodeValidatorClient, len(nodes))
+	for i, node := range tracker.nodes {
+		validatorClients[i] = mock.NewMockBeaconNodeValidatorClient(ctrl)
+		node.validatorClient = validatorClients[i]
+	}
+	activeClient := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	return &broadcastValidatorClient{
+		BeaconNodeValidatorClient: activeClient,
+		beaconNodes:               tracker,
+	}, activeClient, validatorClients, nodes
+}
+
+func TestBroadcastValidatorClient_StuckBeaconNode(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client, _, validatorClients, _ := setupBroadcastClient(t, ctrl)
+	client.beaconNodes.checkHealth(ctx)
+
+	att := &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 5}}
+	stuck := make(chan struct{})
+	defer close(stuck)
+	primaryCalled, secondaryCalled := make(chan struct{}), make(chan struct{})
+	validatorClients[0].EXPECT().ProposeAttestation(gomock.Any(), att).DoAndReturn(
+		func(ctx context.Context, _ *ethpb.Attestation, _ ...grpc.CallOption) (*ethpb.AttestResponse, error) {
+			close(primaryCalled)
+			<-stuck
+			return nil, ctx.Err()
+		})
+	validatorClients[1].EXPECT().ProposeAttestation(gomock.Any(), att).DoAndReturn(
+		func(_ context.Context, _ *ethpb.Attestation, _ ...grpc.CallOption) (*ethpb.AttestResponse, error) {
+			close(secondaryCalled)
+			return nil, errors.New("bad attestation")
+		})
+	validatorClients[2].EXPECT().ProposeAttestation(gomock.Any(), att).DoAndReturn(
+		func(_ context.Context, _ *ethpb.Attestation, _ ...grpc.CallOption) (*ethpb.AttestResponse, error) {
+			<-primaryCalled
+			<-secondaryCalled
+			return &ethpb.AttestResponse{AttestationDataRoot: []byte{1}}, nil
+		})
+
+	res, err := client.ProposeAttestation(ctx, att)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{1}, res.AttestationDataRoot)
+}
+
+func TestBroadcastValidatorClient_OnlyHealthyBeaconNodes(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client, activeClient, validatorClients, nodes := setupBroadcastClient(t, ctrl)
+	nodes[2].syncing = true
+	client.beaconNodes.checkHealth(ctx)
+
+	blk := &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: 5}}
+	validatorClients[0].EXPECT().ProposeBlock(gomock.Any(), blk).Return(nil, errors.New("unavailable"))
+	validatorClients[1].EXPECT().ProposeBlock(gomock.Any(), blk).Return(nil, errors.New("unavailable"))
+	_, err := client.ProposeBlock(ctx, blk)
+	assert.ErrorContains(t, "could not publish block to any beacon node", err)
+
+	// Aggregates are broadcast as well.
+	req := &ethpb.SignedAggregateSubmitRequest{}
+	validatorClients[0].EXPECT().SubmitSignedAggregateSelectionProof(gomock.Any(), req).Return(&ethpb.SignedAggregateSubmitResponse{}, nil)
+	validatorClients[1].EXPECT().SubmitSignedAggregateSelectionProof(gomock.Any(), req).Return(&ethpb.SignedAggregateSubmitResponse{}, nil).MaxTimes(1)
+	_, err = client.SubmitSignedAggregateSelectionProof(ctx, req)
+	require.NoError(t, err)
+
+	// Duties are fetched from the active beacon node only.
+	activeClient.EXPECT().GetDuties(gomock.Any(), gomock.Any()).Return(&ethpb.DutiesResponse{}, nil)
+	_, err = client.GetDuties(ctx, &ethpb.DutiesRequest{})
+	require.NoError(t, err)
+	// Let the remaining submission finish before the mocks are checked.
+	time.Sleep(100 * time.Millisecond)
+}
+
+func TestNewValidatorClient_DutiesFromPrimaryAndBroadcastToAll(t *testing.T) {
+	ctx := context.Background()
+	primary, secondary := startGRPCBeaconNode(t), startGRPCBeaconNode(t)
+	tracker, conn, err := dialBeaconNodes(
+		ctx,
+		primary.endpoint+","+secondary.endpoint,
+		ConstructDialOptions(0, "", 0, 0),
+		4,
+		1,
+	)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, conn.Close())
+		tracker.close()
+	}()
+	tracker.checkHealth(ctx)
+	client := newValidatorClient(conn, tracker, true)
+
+	for i := 0; i < 3; i++ {
+		_, err = client.GetDuties(ctx, &ethpb.DutiesRequest{})
+		require.NoError(t, err)
+	}
+	_, err = client.ProposeAttestation(ctx, &ethpb.Attestation{Data: &ethpb.AttestationData{Slot: 5}})
+	require.NoError(t, err)
+
+	duties, _ := primary.counts()
+	assert.Equal(t, 3, duties, "Expected duties to be fetched from the primary beacon node")
+	duties, _ = secondary.counts()
+	assert.Equal(t, 0, duties, "Expected no duties to be fetched from the secondary beacon node")
+	// The attestation is submitted to the remaining beacon node in the background.
+	for _, node := range []*grpcBeaconNode{primary, secondary} {
+		deadline := time.Now().Add(time.Second)
+		for _, attestations := node.counts(); attestations == 0 && time.Now().Before(deadline); _, attestations = node.counts() {
+			time.Sleep(10 * time.Millisecond)
+		}
+		_, attestations := node.counts()
+		assert.Equal(t, 1, attestations, "Expected the attestation to be sent to %s", node.endpoint)
+	}
+
+	_, ok := newValidatorClient(conn, tracker, false).(*broadcastValidatorClient)
+	assert.Equal(t, false, ok, "Expected no broadcasting when disabled")
+}
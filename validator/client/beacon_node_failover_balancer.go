@@ -0,0 +1,67 @@
+package client
+
+import (
+	"google.golang.org/grpc/balancer"
+	"google.golang.org/grpc/balancer/base"
+)
+
+// Name of the gRPC load balancer routing requests to the active beacon node of a health tracker.
+// It can be enabled by adding the following option when dialing multiple endpoints with the
+// multipleEndpointsGrpcResolverBuilder:
+// grpc.WithDefaultServiceConfig("{\"loadBalancingConfig\":[{\"beacon_node_failover\":{}}]}")
+const beaconNodeFailoverBalancerName = "beacon_node_failover"
+
+// Key of the resolver address attribute holding the health tracker of the beacon nodes.
+type beaconNodeHealthTrackerKey struct{}
+
+func init() {
+	balancer.Register(base.NewBalancerBuilder(beaconNodeFailoverBalancerName, &failoverPickerBuilder{}, base.Config{}))
+}
+
+type failoverPickerBuilder struct{}
+
+func (*failoverPickerBuilder) Build(info base.PickerBuildInfo) balancer.Picker {
+	if len(info.ReadySCs) == 0 {
+		return base.NewErrPicker(balancer.ErrNoSubConnAvailable)
+	}
+	p := &failoverPicker{subConns: make(map[string]balancer.SubConn, len(info.ReadySCs))}
+	for sc, scInfo := range info.ReadySCs {
+		p.subConns[scInfo.Address.Addr] = sc
+		if tracker, ok := scInfo.Address.Attributes.Value(beaconNodeHealthTrackerKey{}).(*beaconNodeHealthTracker); ok {
+			p.tracker = tracker
+		}
+		if p.fallback == nil {
+			p.fallback = sc
+		}
+	}
+	return p
+}
+
+// failoverPicker picks the connection to the first ready beacon node in the ranking of the
+// health tracker for every request, so that requests are routed to another beacon node as
+// soon as the active one degrades.
+type failoverPicker struct {
+	tracker  *beaconNodeHealthTracker
+	subConns map[string]balancer.SubConn
+	fallback balancer.SubConn
+}
+
+func (p *failoverPicker) Pick(_ balancer.PickInfo) (balancer.PickResult, error) {
+	if p.tracker == nil {
+		return balancer.PickResult{SubConn: p.fallback}, nil
+	}
+	for _, endpoint := range p.tracker.ranking() {
+		sc, ok := p.subConns[endpoint]
+		if !ok {
+			continue
+		}
+		endpoint := endpoint
+		return balancer.PickResult{
+			SubConn: sc,
+			Done: func(info balancer.DoneInfo) {
+				p.tracker.recordResult(endpoint, info.Err)
+			},
+		}, nil
+	}
+	return balancer.PickResult{SubConn: p.fallback}, nil
+}
@@ -0,0 +1,288 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	ptypes "github.com/gogo/protobuf/types"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// Beacon nodes failing more than this fraction of the requests routed to them are unhealthy.
+	maxBeaconNodeErrorRate = 0.5
+	// Minimum number of requests between two health checks for the error rate to be considered.
+	minBeaconNodeErrorRateRequests = 5
+)
+
+// BeaconNodeHealth describes the health of one of the beacon nodes the validator client is configured with.
+type BeaconNodeHealth struct {
+	Endpoint  string
+	Active    bool
+	Healthy   bool
+	Connected bool
+	Syncing   bool
+	HeadSlot  uint64
+	SlotLag   uint64
+	PeerCount uint64
+	ErrorRate float64
+	LastError string
+}
+
+// beaconNode is a beacon node the validator client routes its requests to, along with
+// the clients used to check its health and to broadcast signed objects to it.
+type beaconNode struct {
+	endpoint        string
+	conn            *grpc.ClientConn
+	nodeClient      ethpb.NodeClient
+	beaconClient    ethpb.BeaconChainClient
+	validatorClient ethpb.BeaconNodeValidatorClient
+	health          BeaconNodeHealth
+	requests        uint64
+	errors          uint64
+}
+
+// beaconNodeHealthTracker periodically checks the health of the configured beacon nodes and
+// decides to which of them the requests of the validator client are routed. The first healthy
+// beacon node, in the order of their configuration, is active. If no beacon node is healthy, the
+// reachable beacon node with the most recent head is active instead.
+type beaconNodeHealthTracker struct {
+	maxSlotLag  uint64
+	minPeers    uint64
+	lock        sync.RWMutex
+	nodes       []*beaconNode
+	active      int
+	genesisTime uint64
+}
+
+func newBeaconNodeHealthTracker(nodes []*beaconNode, maxSlotLag, minPeers uint64) *beaconNodeHealthTracker {
+	for _, node := range nodes {
+		node.health.Endpoint = node.endpoint
+	}
+	return &beaconNodeHealthTracker{
+		maxSlotLag: maxSlotLag,
+		minPeers:   minPeers,
+		nodes:      nodes,
+	}
+}
+
+// run checks the health of the beacon nodes every slot until the context is canceled.
+func (t *beaconNodeHealthTracker) run(ctx context.Context) {
+	ticker := time.NewTicker(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second)
+	defer ticker.Stop()
+	for {
+		t.checkHealth(ctx)
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// checkHealth queries every beacon node for its sync status, head and peers, and then
+// selects the beacon node to which requests are routed.
+func (t *beaconNodeHealthTracker) checkHealth(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(params.BeaconConfig().SecondsPerSlot)*time.Second/2)
+	defer cancel()
+	healths := make([]BeaconNodeHealth, len(t.nodes))
+	var wg sync.WaitGroup
+	for i, node := range t.nodes {
+		wg.Add(1)
+		go func(i int, node *beaconNode) {
+			defer wg.Done()
+			healths[i] = t.queryHealth(ctx, node)
+		}(i, node)
+	}
+	wg.Wait()
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for i, node := range t.nodes {
+		health := healths[i]
+		if node.requests > 0 {
+			health.ErrorRate = float64(node.errors) / float64(node.requests)
+		}
+		if health.LastError == "" {
+			health.LastError = node.health.LastError
+		}
+		health.Healthy = health.Connected &&
+			!health.Syncing &&
+			health.SlotLag <= t.maxSlotLag &&
+			health.PeerCount >= t.minPeers &&
+			(node.requests < minBeaconNodeErrorRateRequests || health.ErrorRate <= maxBeaconNodeErrorRate)
+		node.health = health
+		node.requests = 0
+		node.errors = 0
+	}
+	t.selectActive()
+}
+
+// Queries the health of a single beacon node. Its error rate is computed by the caller.
+func (t *beaconNodeHealthTracker) queryHealth(ctx context.Context, node *beaconNode) BeaconNodeHealth {
+	health := BeaconNodeHealth{Endpoint: node.endpoint}
+	syncStatus, err := node.nodeClient.GetSyncStatus(ctx, &ptypes.Empty{})
+	if err != nil {
+		health.LastError = err.Error()
+		return health
+	}
+	health.Connected = true
+	health.Syncing = syncStatus.Syncing
+
+	t.lock.RLock()
+	genesisTime := t.genesisTime
+	t.lock.RUnlock()
+	if genesisTime == 0 {
+		genesis, err := node.nodeClient.GetGenesis(ctx, &ptypes.Empty{})
+		if err != nil {
+			health.Connected = false
+			health.LastError = err.Error()
+			return health
+		}
+		genesisTime = uint64(genesis.GenesisTime.Seconds)
+		t.lock.Lock()
+		t.genesisTime = genesisTime
+		t.lock.Unlock()
+	}
+	head, err := node.beaconClient.GetChainHead(ctx, &ptypes.Empty{})
+	if err != nil {
+		health.Connected = false
+		health.LastError = err.Error()
+		return health
+	}
+	health.HeadSlot = head.HeadSlot
+	if currentSlot := helpers.CurrentSlot(genesisTime); currentSlot > head.HeadSlot {
+		health.SlotLag = currentSlot - head.HeadSlot
+	}
+	peers, err := node.nodeClient.ListPeers(ctx, &ptypes.Empty{})
+	if err != nil {
+		health.Connected = false
+		health.LastError = err.Error()
+		return health
+	}
+	for _, peer := range peers.Peers {
+		if peer.ConnectionState == ethpb.ConnectionState_CONNECTED {
+			health.PeerCount++
+		}
+	}
+	return health
+}
+
+// Selects the beacon node to route requests to. Must be called with the lock held.
+func (t *beaconNodeHealthTracker) selectActive() {
+	selected := -1
+	for i, node := range t.nodes {
+		if node.health.Healthy {
+			selected = i
+			break
+		}
+	}
+	if selected == -1 {
+		for i, node := range t.nodes {
+			if !node.health.Connected {
+				continue
+			}
+			if selected == -1 || node.health.SlotLag < t.nodes[selected].health.SlotLag {
+				selected = i
+			}
+		}
+	}
+	if selected == -1 || selected == t.active {
+		return
+	}
+	previous := t.nodes[t.active].health
+	log.WithFields(logrus.Fields{
+		"previousEndpoint": previous.Endpoint,
+		"endpoint":         t.nodes[selected].endpoint,
+		"connected":        previous.Connected,
+		"syncing":          previous.Syncing,
+		"slotLag":          previous.SlotLag,
+		"peerCount":        previous.PeerCount,
+		"errorRate":        previous.ErrorRate,
+	}).Warn("Switching to another beacon node")
+	t.active = selected
+}
+
+// ranking returns the endpoints of the beacon nodes in the order in which requests are routed
+// to them: the active beacon node first, followed by the healthy and then the unhealthy ones.
+func (t *beaconNodeHealthTracker) ranking() []string {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	endpoints := make([]string, 0, len(t.nodes))
+	endpoints = append(endpoints, t.nodes[t.active].endpoint)
+	for _, healthy := range []bool{true, false} {
+		for i, node := range t.nodes {
+			if i != t.active && node.health.Healthy == healthy {
+				endpoints = append(endpoints, node.endpoint)
+			}
+		}
+	}
+	return endpoints
+}
+
+// broadcastTargets returns the beacon nodes signed objects are published to: the active
+// beacon node, followed by all other healthy beacon nodes.
+func (t *beaconNodeHealthTracker) broadcastTargets() []*beaconNode {
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	nodes := []*beaconNode{t.nodes[t.active]}
+	for i, node := range t.nodes {
+		if i != t.active && node.health.Healthy {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+// recordResult records the outcome of a request routed to a beacon node. Only errors
+// indicating a problem with the beacon node itself count towards its error rate.
+func (t *beaconNodeHealthTracker) recordResult(endpoint string, err error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for _, node := range t.nodes {
+		if node.endpoint != endpoint {
+			continue
+		}
+		node.requests++
+		switch status.Code(err) {
+		case codes.Unavailable, codes.DeadlineExceeded, codes.Internal, codes.ResourceExhausted:
+			node.errors++
+			node.health.LastError = err.Error()
+		}
+		return
+	}
+}
+
+// report returns the health of the beacon nodes in the order of their configuration.
+func (t *beaconNodeHealthTracker) report() []*BeaconNodeHealth {
+	if t == nil {
+		return []*BeaconNodeHealth{}
+	}
+	t.lock.RLock()
+	defer t.lock.RUnlock()
+	healths := make([]*BeaconNodeHealth, len(t.nodes))
+	for i, node := range t.nodes {
+		health := node.health
+		health.Active = i == t.active
+		healths[i] = &health
+	}
+	return healths
+}
+
+func (t *beaconNodeHealthTracker) close() {
+	for _, node := range t.nodes {
+		if node.conn == nil {
+			continue
+		}
+		if err := node.conn.Close(); err != nil {
+			log.WithError(err).WithField("endpoint", node.endpoint).Debug("Could not close beacon node connection")
+		}
+	}
+}
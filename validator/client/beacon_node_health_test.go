@@ -0,0 +1,309 @@
+package client
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/attributes"
+	"google.golang.org/grpc/balancer"
+	"google.golang.org/grpc/balancer/base"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/status"
+)
+
+// Number of slots since genesis in the health checks of these tests.
+const testCurrentSlot = 100
+
+type mockBeaconNode struct {
+	nodeClient   *mock.MockNodeClient
+	beaconClient *mock.MockBeaconChainClient
+	syncing      bool
+	headSlot     uint64
+	peers        int
+	err          error
+}
+
+func setupBeaconNodes(t *testing.T, ctrl *gomock.Controller, endpoints ...string) (*beaconNodeHealthTracker, []*mockBeaconNode) {
+	genesisTime := uint64(timeutils.Now().Unix()) - testCurrentSlot*params.BeaconConfig().SecondsPerSlot
+	nodes := make([]*beaconNode, len(endpoints))
+	mocks := make([]*mockBeaconNode, len(endpoints))
+	for i, endpoint := range endpoints {
+		m := &mockBeaconNode{
+			nodeClient:   mock.NewMockNodeClient(ctrl),
+			beaconClient: mock.NewMockBeaconChainClient(ctrl),
+			headSlot:     testCurrentSlot,
+			peers:        1,
+		}
+		m.nodeClient.EXPECT().GetSyncStatus(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ *ptypes.Empty) (*ethpb.SyncStatus, error) {
+				if m.err != nil {
+					return nil, m.err
+				}
+				return &ethpb.SyncStatus{Syncing: m.syncing}, nil
+			}).AnyTimes()
+		m.nodeClient.EXPECT().GetGenesis(gomock.Any(), gomock.Any()).Return(
+			&ethpb.Genesis{GenesisTime: &ptypes.Timestamp{Seconds: int64(genesisTime)}}, nil,
+		).AnyTimes()
+		m.beaconClient.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ *ptypes.Empty) (*ethpb.ChainHead, error) {
+				return &ethpb.ChainHead{HeadSlot: m.headSlot}, nil
+			}).AnyTimes()
+		m.nodeClient.EXPECT().ListPeers(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ *ptypes.Empty) (*ethpb.Peers, error) {
+				peers := make([]*ethpb.Peer, m.peers)
+				for j := range peers {
+					peers[j] = &ethpb.Peer{ConnectionState: ethpb.ConnectionState_CONNECTED}
+				}
+				return &ethpb.Peers{Peers: append(peers, &ethpb.Peer{ConnectionState: ethpb.ConnectionState_DISCONNECTED})}, nil
+			}).AnyTimes()
+		nodes[i] = &beaconNode{endpoint: endpoint, nodeClient: m.nodeClient, beaconClient: m.beaconClient}
+		mocks[i] = m
+	}
+	return newBeaconNodeHealthTracker(nodes, 4, 1), mocks
+}
+
+func TestBeaconNodeHealthTracker_FailsOverAndBack(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	tracker, nodes := setupBeaconNodes(t, ctrl, "primary:4000", "secondary:4000", "tertiary:4000")
+
+	tracker.checkHealth(ctx)
+	assert.DeepEqual(t, []string{"primary:4000", "secondary:4000", "tertiary:4000"}, tracker.ranking())
+
+	// The primary beacon node falls behind and the secondary one is syncing.
+	nodes[0].headSlot = testCurrentSlot - 10
+	nodes[1].syncing = true
+	tracker.checkHealth(ctx)
+	assert.DeepEqual(t, []string{"tertiary:4000", "primary:4000", "secondary:4000"}, tracker.ranking())
+	report := tracker.report()
+	require.Equal(t, 3, len(report))
+	assert.Equal(t, false, report[0].Healthy)
+	assert.Equal(t, true, report[0].Connected)
+	assert.Equal(t, uint64(10), report[0].SlotLag)
+	assert.Equal(t, true, report[1].Syncing)
+	assert.Equal(t, true, report[2].Active)
+	assert.Equal(t, uint64(1), report[2].PeerCount)
+
+	// The primary beacon node is preferred again once it recovered.
+	nodes[0].headSlot = testCurrentSlot
+	tracker.checkHealth(ctx)
+	assert.Equal(t, "primary:4000", tracker.ranking()[0])
+}
+
+func TestBeaconNodeHealthTracker_NoHealthyBeaconNode(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	tracker, nodes := setupBeaconNodes(t, ctrl, "primary:4000", "secondary:4000")
+
+	// Without a healthy beacon node, the reachable one with the most recent head is active.
+	nodes[0].err = status.Error(codes.Unavailable, "connection refused")
+	nodes[1].peers = 0
+	tracker.checkHealth(ctx)
+	report := tracker.report()
+	assert.Equal(t, false, report[0].Connected)
+	assert.Equal(t, "rpc error: code = Unavailable desc = connection refused", report[0].LastError)
+	assert.Equal(t, false, report[1].Healthy)
+	assert.Equal(t, true, report[1].Active)
+}
+
+func TestBeaconNodeHealthTracker_ErrorRate(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	tracker, _ := setupBeaconNodes(t, ctrl, "primary:4000", "secondary:4000")
+	tracker.checkHealth(ctx)
+
+	for i := 0; i < 4; i++ {
+		tracker.recordResult("primary:4000", status.Error(codes.Unavailable, "unavailable"))
+	}
+	// Errors returned by the beacon node for invalid requests do not count.
+	tracker.recordResult("primary:4000", status.Error(codes.InvalidArgument, "invalid"))
+	tracker.recordResult("primary:4000", nil)
+	tracker.checkHealth(ctx)
+	report := tracker.report()
+	assert.Equal(t, float64(4)/6, report[0].ErrorRate)
+	assert.Equal(t, false, report[0].Healthy)
+	assert.Equal(t, true, report[1].Active)
+
+	// The error rate is computed over the requests since the previous health check.
+	tracker.checkHealth(ctx)
+	assert.Equal(t, true, tracker.report()[0].Active)
+}
+
+type fakeSubConn struct {
+	endpoint string
+}
+
+func (*fakeSubConn) UpdateAddresses([]resolver.Address) {}
+
+func (*fakeSubConn) Connect() {}
+
+func TestFailoverPicker_PicksActiveBeaconNode(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	tracker, nodes := setupBeaconNodes(t, ctrl, "primary:4000", "secondary:4000")
+	tracker.checkHealth(ctx)
+
+	attrs := attributes.New(beaconNodeHealthTrackerKey{}, tracker)
+	primary, secondary := &fakeSubConn{"primary:4000"}, &fakeSubConn{"secondary:4000"}
+	picker := (&failoverPickerBuilder{}).Build(base.PickerBuildInfo{ReadySCs: map[balancer.SubConn]base.SubConnInfo{
+		primary:   {Address: resolver.Address{Addr: "primary:4000", Attributes: attrs}},
+		secondary: {Address: resolver.Address{Addr: "secondary:4000", Attributes: attrs}},
+	}})
+	res, err := picker.Pick(balancer.PickInfo{})
+	require.NoError(t, err)
+	assert.Equal(t, primary, res.SubConn)
+
+	// Requests are routed to the secondary beacon node as soon as the primary one degrades.
+	nodes[0].syncing = true
+	tracker.checkHealth(ctx)
+	res, err = picker.Pick(balancer.PickInfo{})
+	require.NoError(t, err)
+	assert.Equal(t, secondary, res.SubConn)
+	res.Done(balancer.DoneInfo{Err: status.Error(codes.Unavailable, "unavailable")})
+	assert.Equal(t, uint64(1), tracker.nodes[1].errors)
+
+	// Requests are routed to another beacon node if the active one is not ready.
+	picker = (&failoverPickerBuilder{}).Build(base.PickerBuildInfo{ReadySCs: map[balancer.SubConn]base.SubConnInfo{
+		primary: {Address: resolver.Address{Addr: "primary:4000", Attributes: attrs}},
+	}})
+	res, err = picker.Pick(balancer.PickInfo{})
+	require.NoError(t, err)
+	assert.Equal(t, primary, res.SubConn)
+
+	picker = (&failoverPickerBuilder{}).Build(base.PickerBuildInfo{})
+	_, err = picker.Pick(balancer.PickInfo{})
+	assert.Equal(t, balancer.ErrNoSubConnAvailable, err)
+}
+
+// grpcBeaconNode is a beacon node served over gRPC on a local port, counting the requests it receives.
+type grpcBeaconNode struct {
+	ethpb.UnimplementedNodeServer
+	ethpb.UnimplementedBeaconChainServer
+	ethpb.UnimplementedBeaconNodeValidatorServer
+	endpoint     string
+	genesisTime  uint64
+	lock         sync.Mutex
+	dutiesErr    error
+	duties       int
+	attestations int
+}
+
+func startGRPCBeaconNode(t *testing.T) *grpcBeaconNode {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	node := &grpcBeaconNode{
+		endpoint:    lis.Addr().String(),
+		genesisTime: uint64(timeutils.Now().Unix()) - testCurrentSlot*params.BeaconConfig().SecondsPerSlot,
+	}
+	server := grpc.NewServer()
+	ethpb.RegisterNodeServer(server, node)
+	ethpb.RegisterBeaconChainServer(server, node)
+	ethpb.RegisterBeaconNodeValidatorServer(server, node)
+	go func() {
+		if err := server.Serve(lis); err != nil {
+			t.Log(err)
+		}
+	}()
+	t.Cleanup(server.Stop)
+	return node
+}
+
+func (n *grpcBeaconNode) GetSyncStatus(_ context.Context, _ *ptypes.Empty) (*ethpb.SyncStatus, error) {
+	return &ethpb.SyncStatus{}, nil
+}
+
+func (n *grpcBeaconNode) GetGenesis(_ context.Context, _ *ptypes.Empty) (*ethpb.Genesis, error) {
+	return &ethpb.Genesis{GenesisTime: &ptypes.Timestamp{Seconds: int64(n.genesisTime)}}, nil
+}
+
+func (n *grpcBeaconNode) ListPeers(_ context.Context, _ *ptypes.Empty) (*ethpb.Peers, error) {
+	return &ethpb.Peers{Peers: []*ethpb.Peer{{ConnectionState: ethpb.ConnectionState_CONNECTED}}}, nil
+}
+
+func (n *grpcBeaconNode) GetChainHead(_ context.Context, _ *ptypes.Empty) (*ethpb.ChainHead, error) {
+	return &ethpb.ChainHead{HeadSlot: helpers.CurrentSlot(n.genesisTime)}, nil
+}
+
+func (n *grpcBeaconNode) GetDuties(_ context.Context, _ *ethpb.DutiesRequest) (*ethpb.DutiesResponse, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.duties++
+	if n.dutiesErr != nil {
+		return nil, n.dutiesErr
+	}
+	return &ethpb.DutiesResponse{}, nil
+}
+
+func (n *grpcBeaconNode) ProposeAttestation(_ context.Context, _ *ethpb.Attestation) (*ethpb.AttestResponse, error) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.attestations++
+	return &ethpb.AttestResponse{AttestationDataRoot: []byte(n.endpoint)}, nil
+}
+
+func (n *grpcBeaconNode) counts() (int, int) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return n.duties, n.attestations
+}
+
+func TestDialBeaconNodes_FailsOver(t *testing.T) {
+	ctx := context.Background()
+	primary, secondary := startGRPCBeaconNode(t), startGRPCBeaconNode(t)
+	tracker, conn, err := dialBeaconNodes(
+		ctx,
+		primary.endpoint+","+secondary.endpoint,
+		ConstructDialOptions(0, "", 0, 0),
+		4,
+		1,
+	)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, conn.Close())
+		tracker.close()
+	}()
+	tracker.checkHealth(ctx)
+	client := ethpb.NewBeaconNodeValidatorClient(conn)
+
+	_, err = client.GetDuties(ctx, &ethpb.DutiesRequest{})
+	require.NoError(t, err)
+	duties, _ := primary.counts()
+	assert.Equal(t, 1, duties)
+
+	// The primary beacon node fails the requests routed to it, until its error rate makes
+	// the health tracker route requests to the secondary beacon node.
+	primary.lock.Lock()
+	primary.dutiesErr = status.Error(codes.Unavailable, "unavailable")
+	primary.lock.Unlock()
+	for i := 0; i < minBeaconNodeErrorRateRequests; i++ {
+		_, err = client.GetDuties(ctx, &ethpb.DutiesRequest{})
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	}
+	tracker.checkHealth(ctx)
+	assert.Equal(t, false, tracker.report()[0].Healthy)
+	assert.Equal(t, true, tracker.report()[1].Active)
+
+	_, err = client.GetDuties(ctx, &ethpb.DutiesRequest{})
+	require.NoError(t, err)
+	duties, _ = primary.counts()
+	assert.Equal(t, 1+minBeaconNodeErrorRateRequests, duties)
+	duties, _ = secondary.counts()
+	assert.Equal(t, 1, duties)
+}
@@ -0,0 +1,259 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DoppelgangerStatus is the outcome of the doppelganger check of a validating key.
+type DoppelgangerStatus struct {
+	PublicKey     [48]byte
+	Index         uint64
+	Detected      bool
+	DetectedEpoch uint64
+}
+
+// DoppelgangerReport describes the state of the doppelganger protection of the validator client.
+type DoppelgangerReport struct {
+	Enabled  bool
+	Checking bool
+	Statuses []*DoppelgangerStatus
+}
+
+// doppelgangerCheck is the pending doppelganger check of a single validator.
+type doppelgangerCheck struct {
+	startEpoch uint64
+	nextEpoch  uint64
+}
+
+// doppelgangerTracker keeps track of the doppelganger check of the active validating keys. A key
+// is only used for signing once it was watched for the configured number of epochs, and keys for
+// which activity was detected elsewhere are never used for signing by this validator client.
+type doppelgangerTracker struct {
+	epochs   uint64
+	lock     sync.RWMutex
+	indices  []uint64
+	pending  map[uint64]*doppelgangerCheck
+	statuses map[uint64]*DoppelgangerStatus
+	cleared  map[[48]byte]bool
+	detected map[[48]byte]bool
+}
+
+func newDoppelgangerTracker(epochs uint64) *doppelgangerTracker {
+	return &doppelgangerTracker{
+		epochs:   epochs,
+		pending:  make(map[uint64]*doppelgangerCheck),
+		statuses: make(map[uint64]*DoppelgangerStatus),
+		cleared:  make(map[[48]byte]bool),
+		detected: make(map[[48]byte]bool),
+	}
+}
+
+func (d *doppelgangerTracker) enabled() bool {
+	return d != nil && d.epochs > 0
+}
+
+// canSign returns whether the given key passed the doppelganger check.
+func (d *doppelgangerTracker) canSign(pubKey [48]byte) bool {
+	if !d.enabled() {
+		return true
+	}
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	return d.cleared[pubKey] && !d.detected[pubKey]
+}
+
+// track starts the doppelganger check of a validator which became active in the given epoch. The
+// check starts with the next epoch, so that messages signed by this validator client before a
+// restart are not mistaken for a doppelganger. Returns false if the validator is already tracked.
+func (d *doppelgangerTracker) track(index uint64, pubKey [48]byte, epoch uint64) bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if _, ok := d.statuses[index]; ok {
+		return false
+	}
+	d.indices = append(d.indices, index)
+	d.statuses[index] = &DoppelgangerStatus{PublicKey: pubKey, Index: index}
+	d.pending[index] = &doppelgangerCheck{startEpoch: epoch + 1, nextEpoch: epoch + 1}
+	ValidatorDoppelgangerCheckInProgressGauge.Set(1)
+	return true
+}
+
+// pendingEpochs returns the indices of the validators to check in each epoch up to the given one.
+func (d *doppelgangerTracker) pendingEpochs(epoch uint64) map[uint64][]uint64 {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	epochs := make(map[uint64][]uint64)
+	for _, idx := range d.indices {
+		c, ok := d.pending[idx]
+		if !ok {
+			continue
+		}
+		for e := c.nextEpoch; e <= epoch && e < c.startEpoch+d.epochs; e++ {
+			epochs[e] = append(epochs[e], idx)
+		}
+	}
+	return epochs
+}
+
+// markChecked records that the given validators were not live in the given epoch, which has ended.
+// Returns the number of validators whose check is complete.
+func (d *doppelgangerTracker) markChecked(indices []uint64, epoch uint64) int {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	numCleared := 0
+	for _, idx := range indices {
+		c, ok := d.pending[idx]
+		if !ok || c.nextEpoch != epoch {
+			continue
+		}
+		c.nextEpoch++
+		if c.nextEpoch >= c.startEpoch+d.epochs {
+			delete(d.pending, idx)
+			d.cleared[d.statuses[idx].PublicKey] = true
+			numCleared++
+		}
+	}
+	if len(d.pending) == 0 {
+		ValidatorDoppelgangerCheckInProgressGauge.Set(0)
+	}
+	return numCleared
+}
+
+// markDetected records that the validator with the given index was live in the given epoch.
+func (d *doppelgangerTracker) markDetected(index, epoch uint64) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	s, ok := d.statuses[index]
+	if !ok || s.Detected {
+		return
+	}
+	s.Detected = true
+	s.DetectedEpoch = epoch
+	d.detected[s.PublicKey] = true
+	delete(d.pending, index)
+	if len(d.pending) == 0 {
+		ValidatorDoppelgangerCheckInProgressGauge.Set(0)
+	}
+	ValidatorDoppelgangerDetectedGaugeVec.WithLabelValues(fmt.Sprintf("%#x", s.PublicKey[:])).Set(1)
+	log.WithFields(logrus.Fields{
+		"publicKey":      fmt.Sprintf("%#x", bytesutil.Trunc(s.PublicKey[:])),
+		"validatorIndex": index,
+		"epoch":          epoch,
+	}).Warn("Doppelganger detected, refusing to sign with this key")
+}
+
+// report returns a copy of the current state of the doppelganger check.
+func (d *doppelgangerTracker) report() *DoppelgangerReport {
+	if !d.enabled() {
+		return &DoppelgangerReport{}
+	}
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	statuses := make([]*DoppelgangerStatus, 0, len(d.indices))
+	for _, idx := range d.indices {
+		s := *d.statuses[idx]
+		statuses = append(statuses, &s)
+	}
+	return &DoppelgangerReport{
+		Enabled:  true,
+		Checking: len(d.pending) > 0,
+		Statuses: statuses,
+	}
+}
+
+// CheckDoppelganger watches the liveness of the active validating keys for the configured number
+// of epochs before the validator client signs with them. Keys are tracked as soon as they show up
+// as active in the duties, which covers keys that are activated or added while the validator client
+// runs. Liveness of a key during that period means that the key is being used by another validator
+// client, so that signing with it would lead to a slashing. Such keys are excluded from all duties.
+// Keys whose check is not complete are not used for signing, also when the check fails.
+func (v *validator) CheckDoppelganger(ctx context.Context, slot uint64) error {
+	if !v.doppelganger.enabled() {
+		return nil
+	}
+	ctx, span := trace.StartSpan(ctx, "validator.CheckDoppelganger")
+	defer span.End()
+
+	d := v.doppelganger
+	epoch := helpers.SlotToEpoch(slot)
+	if numTracked := v.trackActiveValidators(epoch); numTracked > 0 {
+		log.WithFields(logrus.Fields{
+			"epochs":     d.epochs,
+			"validators": numTracked,
+		}).Info("Checking for doppelgangers before signing, this will delay validator duties")
+	}
+
+	pending := d.pendingEpochs(epoch)
+	epochs := make([]uint64, 0, len(pending))
+	for e := range pending {
+		epochs = append(epochs, e)
+	}
+	sort.Slice(epochs, func(i, j int) bool { return epochs[i] < epochs[j] })
+	numCleared := 0
+	for _, e := range epochs {
+		res, err := v.livenessClient.GetValidatorLiveness(ctx, &pbrpc.ValidatorLivenessRequest{
+			Epoch:   e,
+			Indices: pending[e],
+		})
+		if err != nil {
+			switch status.Code(err) {
+			case codes.Unimplemented:
+				return errors.Wrap(err, "beacon node does not support liveness checks")
+			case codes.InvalidArgument:
+				// The head state of the beacon node has not reached the current epoch yet.
+				if e == epoch {
+					continue
+				}
+			}
+			log.WithError(err).WithField("epoch", e).Warn("Could not check validator liveness")
+			continue
+		}
+		for _, l := range res.Liveness {
+			if l.IsLive {
+				d.markDetected(l.Index, e)
+			}
+		}
+		// The current epoch can still show activity, so it only counts once it has ended.
+		if e < epoch {
+			numCleared += d.markChecked(pending[e], e)
+		}
+	}
+	if numCleared > 0 {
+		log.WithField("validators", numCleared).Info("Finished checking for doppelgangers")
+	}
+	return nil
+}
+
+// trackActiveValidators starts the doppelganger check of the validators which are active according
+// to the current duties and are not tracked yet. Returns the number of newly tracked validators.
+func (v *validator) trackActiveValidators(epoch uint64) int {
+	if v.duties == nil {
+		return 0
+	}
+	numTracked := 0
+	for _, duty := range v.duties.Duties {
+		if duty == nil {
+			continue
+		}
+		switch duty.Status {
+		case ethpb.ValidatorStatus_ACTIVE, ethpb.ValidatorStatus_EXITING, ethpb.ValidatorStatus_SLASHING:
+			if v.doppelganger.track(duty.ValidatorIndex, bytesutil.ToBytes48(duty.PublicKey), epoch) {
+				numTracked++
+			}
+		}
+	}
+	return numTracked
+}
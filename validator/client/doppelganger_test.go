@@ -0,0 +1,174 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func livenessResponse(epoch uint64, live map[uint64]bool, indices ...uint64) *pbrpc.ValidatorLivenessResponse {
+	res := &pbrpc.ValidatorLivenessResponse{Epoch: epoch}
+	for _, idx := range indices {
+		res.Liveness = append(res.Liveness, &pbrpc.ValidatorLivenessResponse_Liveness{Index: idx, IsLive: live[idx]})
+	}
+	return res
+}
+
+func activeDuties(indices ...uint64) *ethpb.DutiesResponse {
+	duties := &ethpb.DutiesResponse{}
+	for _, idx := range indices {
+		duties.Duties = append(duties.Duties, &ethpb.DutiesResponse_Duty{
+			PublicKey:      []byte{byte(idx)},
+			ValidatorIndex: idx,
+			Status:         ethpb.ValidatorStatus_ACTIVE,
+		})
+	}
+	return duties
+}
+
+func TestCheckDoppelganger_Disabled(t *testing.T) {
+	v := validator{doppelganger: newDoppelgangerTracker(0), duties: activeDuties(1)}
+	require.NoError(t, v.CheckDoppelganger(context.Background(), 0))
+	assert.Equal(t, false, v.doppelganger.report().Enabled)
+	assert.Equal(t, true, v.doppelganger.canSign([48]byte{1}))
+}
+
+func TestCheckDoppelganger_DetectsLiveKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockLivenessClient(ctrl)
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	indices := []uint64{1, 2}
+
+	v := validator{livenessClient: client, doppelganger: newDoppelgangerTracker(2), duties: activeDuties(indices...)}
+
+	// Keys seen in epoch 4 are watched from epoch 5 on, so earlier epochs are never checked.
+	require.NoError(t, v.CheckDoppelganger(context.Background(), 4*slotsPerEpoch+3))
+	assert.Equal(t, true, v.doppelganger.report().Checking)
+	assert.Equal(t, false, v.doppelganger.canSign([48]byte{1}))
+
+	client.EXPECT().GetValidatorLiveness(gomock.Any(), &pbrpc.ValidatorLivenessRequest{Epoch: 5, Indices: indices}).
+		Return(livenessResponse(5, map[uint64]bool{2: true}, indices...), nil)
+	require.NoError(t, v.CheckDoppelganger(context.Background(), 5*slotsPerEpoch))
+	assert.Equal(t, false, v.doppelganger.canSign([48]byte{1}))
+	assert.Equal(t, false, v.doppelganger.canSign([48]byte{2}))
+
+	// The head state of the beacon node is still in epoch 5.
+	client.EXPECT().GetValidatorLiveness(gomock.Any(), &pbrpc.ValidatorLivenessRequest{Epoch: 5, Indices: []uint64{1}}).
+		Return(livenessResponse(5, nil, 1), nil)
+	client.EXPECT().GetValidatorLiveness(gomock.Any(), &pbrpc.ValidatorLivenessRequest{Epoch: 6, Indices: []uint64{1}}).
+		Return(nil, status.Error(codes.InvalidArgument, "Cannot check liveness in epoch 6"))
+	require.NoError(t, v.CheckDoppelganger(context.Background(), 6*slotsPerEpoch))
+	assert.Equal(t, false, v.doppelganger.canSign([48]byte{1}))
+
+	// The last watched epoch is checked once it has ended.
+	client.EXPECT().GetValidatorLiveness(gomock.Any(), &pbrpc.ValidatorLivenessRequest{Epoch: 6, Indices: []uint64{1}}).
+		Return(livenessResponse(6, nil, 1), nil)
+	require.NoError(t, v.CheckDoppelganger(context.Background(), 7*slotsPerEpoch))
+	assert.Equal(t, true, v.doppelganger.canSign([48]byte{1}))
+	assert.Equal(t, false, v.doppelganger.canSign([48]byte{2}))
+
+	report := v.doppelganger.report()
+	assert.Equal(t, true, report.Enabled)
+	assert.Equal(t, false, report.Checking)
+	require.Equal(t, 2, len(report.Statuses))
+	assert.Equal(t, false, report.Statuses[0].Detected)
+	assert.Equal(t, true, report.Statuses[1].Detected)
+	assert.Equal(t, uint64(5), report.Statuses[1].DetectedEpoch)
+}
+
+func TestCheckDoppelganger_ChecksNewlyActiveKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockLivenessClient(ctrl)
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+	v := validator{livenessClient: client, doppelganger: newDoppelgangerTracker(1), duties: activeDuties(1)}
+	require.NoError(t, v.CheckDoppelganger(context.Background(), 0))
+	client.EXPECT().GetValidatorLiveness(gomock.Any(), &pbrpc.ValidatorLivenessRequest{Epoch: 1, Indices: []uint64{1}}).
+		Return(livenessResponse(1, nil, 1), nil)
+	require.NoError(t, v.CheckDoppelganger(context.Background(), 2*slotsPerEpoch))
+	assert.Equal(t, true, v.doppelganger.canSign([48]byte{1}))
+
+	// A key which becomes active later is not used for signing before it was checked as well.
+	v.duties = activeDuties(1, 2)
+	require.NoError(t, v.CheckDoppelganger(context.Background(), 2*slotsPerEpoch+1))
+	assert.Equal(t, true, v.doppelganger.report().Checking)
+	assert.Equal(t, false, v.doppelganger.canSign([48]byte{2}))
+	client.EXPECT().GetValidatorLiveness(gomock.Any(), &pbrpc.ValidatorLivenessRequest{Epoch: 3, Indices: []uint64{2}}).
+		Return(livenessResponse(3, nil, 2), nil)
+	require.NoError(t, v.CheckDoppelganger(context.Background(), 4*slotsPerEpoch))
+	assert.Equal(t, true, v.doppelganger.canSign([48]byte{1}))
+	assert.Equal(t, true, v.doppelganger.canSign([48]byte{2}))
+}
+
+func TestCheckDoppelganger_RetriesFailedEpochs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockLivenessClient(ctrl)
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	indices := []uint64{3}
+
+	v := validator{livenessClient: client, doppelganger: newDoppelgangerTracker(1), duties: activeDuties(indices...)}
+	require.NoError(t, v.CheckDoppelganger(context.Background(), slotsPerEpoch))
+	client.EXPECT().GetValidatorLiveness(gomock.Any(), &pbrpc.ValidatorLivenessRequest{Epoch: 2, Indices: indices}).
+		Return(livenessResponse(2, nil, indices...), nil)
+	require.NoError(t, v.CheckDoppelganger(context.Background(), 2*slotsPerEpoch))
+	assert.Equal(t, false, v.doppelganger.canSign([48]byte{3}))
+
+	client.EXPECT().GetValidatorLiveness(gomock.Any(), &pbrpc.ValidatorLivenessRequest{Epoch: 2, Indices: indices}).
+		Return(nil, status.Error(codes.Unavailable, "Head state is not available yet"))
+	require.NoError(t, v.CheckDoppelganger(context.Background(), 3*slotsPerEpoch))
+	assert.Equal(t, false, v.doppelganger.canSign([48]byte{3}))
+
+	client.EXPECT().GetValidatorLiveness(gomock.Any(), &pbrpc.ValidatorLivenessRequest{Epoch: 2, Indices: indices}).
+		Return(livenessResponse(2, nil, indices...), nil)
+	require.NoError(t, v.CheckDoppelganger(context.Background(), 3*slotsPerEpoch+1))
+	assert.Equal(t, true, v.doppelganger.canSign([48]byte{3}))
+}
+
+func TestCheckDoppelganger_Unimplemented(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	client := mock.NewMockLivenessClient(ctrl)
+
+	v := validator{livenessClient: client, doppelganger: newDoppelgangerTracker(1), duties: activeDuties(0)}
+	client.EXPECT().GetValidatorLiveness(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.Unimplemented, "unknown service"))
+	require.NoError(t, v.CheckDoppelganger(context.Background(), 0))
+	err := v.CheckDoppelganger(context.Background(), params.BeaconConfig().SlotsPerEpoch)
+	assert.ErrorContains(t, "beacon node does not support liveness checks", err)
+	assert.Equal(t, false, v.doppelganger.canSign([48]byte{0}))
+}
+
+func TestRolesAt_SkipsDoppelgangers(t *testing.T) {
+	v := validator{
+		doppelganger: newDoppelgangerTracker(1),
+		duties: &ethpb.DutiesResponse{
+			Duties: []*ethpb.DutiesResponse_Duty{
+				{PublicKey: []byte{1}, AttesterSlot: 2},
+				{PublicKey: []byte{2}, AttesterSlot: 3},
+				{PublicKey: []byte{3}, AttesterSlot: 3},
+			},
+		},
+	}
+	v.doppelganger.track(1, [48]byte{1}, 0)
+	v.doppelganger.track(2, [48]byte{2}, 0)
+	v.doppelganger.track(3, [48]byte{3}, 0)
+	v.doppelganger.markDetected(1, 1)
+	v.doppelganger.markChecked([]uint64{1, 2}, 1)
+
+	roles, err := v.RolesAt(context.Background(), 4)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(roles))
+	assert.DeepEqual(t, []ValidatorRole{roleUnknown}, roles[[48]byte{2}])
+}
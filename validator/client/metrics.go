@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
@@ -37,6 +38,25 @@ var (
 			"pubkey",
 		},
 	)
+	// ValidatorDoppelgangerDetectedGaugeVec used to track keys for which a doppelganger was detected.
+	ValidatorDoppelgangerDetectedGaugeVec = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "doppelganger_detected",
+			Help:      "Set to 1 if activity of the key was detected elsewhere, the key is not used for signing.",
+		},
+		[]string{
+			"pubkey",
+		},
+	)
+	// ValidatorDoppelgangerCheckInProgressGauge used to track whether the doppelganger check is running.
+	ValidatorDoppelgangerCheckInProgressGauge = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "validator",
+			Name:      "doppelganger_check_in_progress",
+			Help:      "Set to 1 while the validator client checks for doppelgangers before signing.",
+		},
+	)
 	// ValidatorAggSuccessVec used to count successful aggregations.
 	ValidatorAggSuccessVec = promauto.NewCounterVec(
 		prometheus.CounterOpts{
@@ -287,6 +307,9 @@ func (v *validator) LogValidatorGainsAndLosses(ctx context.Context, slot uint64)
 	v.prevBalanceLock.Unlock()
 
 	v.UpdateLogAggregateStats(resp, slot)
+	if err := v.saveValidatorPerformance(ctx, prevEpoch, resp); err != nil {
+		return errors.Wrap(err, "could not save validator performance")
+	}
 	return nil
 }
 
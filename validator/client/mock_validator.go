@@ -29,10 +29,12 @@ type FakeValidator struct {
 	SaveProtectionsCalled             bool
 	DeleteProtectionCalled            bool
 	SlotDeadlineCalled                bool
+	CheckDoppelgangerCalled           bool
 	ProposeBlockArg1                  uint64
 	AttestToBlockHeadArg1             uint64
 	RoleAtArg1                        uint64
 	UpdateDutiesArg1                  uint64
+	CheckDoppelgangerArg1             uint64
 	NextSlotRet                       <-chan uint64
 	PublicKey                         string
 	UpdateDutiesRet                   error
@@ -181,3 +183,10 @@ func (fv *FakeValidator) AllValidatorsAreExited(ctx context.Context) (bool, erro
 	}
 	return ctx.Value(allValidatorsAreExitedCtxKey).(bool), nil
 }
+
+// CheckDoppelganger for mocking.
+func (fv *FakeValidator) CheckDoppelganger(_ context.Context, slot uint64) error {
+	fv.CheckDoppelgangerCalled = true
+	fv.CheckDoppelgangerArg1 = slot
+	return nil
+}
@@ -3,6 +3,7 @@ package client
 import (
 	"strings"
 
+	"google.golang.org/grpc/attributes"
 	"google.golang.org/grpc/resolver"
 )
 
@@ -12,12 +13,16 @@ import (
 // It can be used with any grpc load balancer (pick_first, round_robin). Default is pick_first.
 // Round robin can be used by adding the following option:
 // grpc.WithDefaultServiceConfig("{\"loadBalancingConfig\":[{\"round_robin\":{}}]}")
-type multipleEndpointsGrpcResolverBuilder struct{}
+// If a health tracker is set, it is attached to the addresses for the beacon_node_failover load balancer.
+type multipleEndpointsGrpcResolverBuilder struct {
+	healthTracker *beaconNodeHealthTracker
+}
 
-func (*multipleEndpointsGrpcResolverBuilder) Build(target resolver.Target, cc resolver.ClientConn, _ resolver.BuildOptions) (resolver.Resolver, error) {
+func (b *multipleEndpointsGrpcResolverBuilder) Build(target resolver.Target, cc resolver.ClientConn, _ resolver.BuildOptions) (resolver.Resolver, error) {
 	r := &multipleEndpointsGrpcResolver{
-		target: target,
-		cc:     cc,
+		target:        target,
+		cc:            cc,
+		healthTracker: b.healthTracker,
 	}
 	r.start()
 	return r, nil
@@ -28,15 +33,20 @@ func (*multipleEndpointsGrpcResolverBuilder) Scheme() string {
 }
 
 type multipleEndpointsGrpcResolver struct {
-	target resolver.Target
-	cc     resolver.ClientConn
+	target        resolver.Target
+	cc            resolver.ClientConn
+	healthTracker *beaconNodeHealthTracker
 }
 
 func (r *multipleEndpointsGrpcResolver) start() {
 	endpoints := strings.Split(r.target.Endpoint, ",")
 	var addrs []resolver.Address
 	for _, endpoint := range endpoints {
-		addrs = append(addrs, resolver.Address{Addr: endpoint})
+		addr := resolver.Address{Addr: endpoint}
+		if r.healthTracker != nil {
+			addr.Attributes = attributes.New(beaconNodeHealthTrackerKey{}, r.healthTracker)
+		}
+		addrs = append(addrs, addr)
 	}
 	r.cc.UpdateState(resolver.State{Addresses: addrs})
 }
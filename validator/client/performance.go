@@ -0,0 +1,82 @@
+package client
+
+import (
+	"context"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"go.opencensus.io/trace"
+)
+
+// Proposals of a key during an epoch, recorded in its performance history.
+type proposalCounts struct {
+	assigned uint64
+	missed   uint64
+}
+
+// recordProposal records the outcome of a proposal duty of the key at the given slot.
+func (v *validator) recordProposal(pubKey [48]byte, slot uint64, proposed bool) {
+	v.proposalCountsLock.Lock()
+	defer v.proposalCountsLock.Unlock()
+	epoch := helpers.SlotToEpoch(slot)
+	if v.proposalCounts == nil {
+		v.proposalCounts = make(map[uint64]map[[48]byte]*proposalCounts)
+	}
+	if v.proposalCounts[epoch] == nil {
+		v.proposalCounts[epoch] = make(map[[48]byte]*proposalCounts)
+	}
+	counts, ok := v.proposalCounts[epoch][pubKey]
+	if !ok {
+		counts = &proposalCounts{}
+		v.proposalCounts[epoch][pubKey] = counts
+	}
+	counts.assigned++
+	if !proposed {
+		counts.missed++
+	}
+}
+
+// saveValidatorPerformance persists the performance of the validating keys during the epoch,
+// as reported by the beacon node, along with their proposals during the epoch.
+func (v *validator) saveValidatorPerformance(
+	ctx context.Context, epoch uint64, resp *ethpb.ValidatorPerformanceResponse,
+) error {
+	ctx, span := trace.StartSpan(ctx, "validator.saveValidatorPerformance")
+	defer span.End()
+
+	v.proposalCountsLock.Lock()
+	proposals := v.proposalCounts[epoch]
+	// Proposals of earlier epochs can no longer be recorded.
+	for e := range v.proposalCounts {
+		if e <= epoch {
+			delete(v.proposalCounts, e)
+		}
+	}
+	v.proposalCountsLock.Unlock()
+
+	if v.db == nil {
+		return nil
+	}
+	records := make([]*kv.ValidatorPerformance, len(resp.PublicKeys))
+	for i, pubKey := range resp.PublicKeys {
+		record := &kv.ValidatorPerformance{
+			PublicKey:            bytesutil.ToBytes48(pubKey),
+			Epoch:                epoch,
+			InclusionSlot:        resp.InclusionSlots[i],
+			InclusionDistance:    resp.InclusionDistances[i],
+			CorrectlyVotedSource: resp.CorrectlyVotedSource[i],
+			CorrectlyVotedTarget: resp.CorrectlyVotedTarget[i],
+			CorrectlyVotedHead:   resp.CorrectlyVotedHead[i],
+			BalanceBefore:        resp.BalancesBeforeEpochTransition[i],
+			BalanceAfter:         resp.BalancesAfterEpochTransition[i],
+		}
+		if counts, ok := proposals[record.PublicKey]; ok {
+			record.ProposalsAssigned = counts.assigned
+			record.ProposalsMissed = counts.missed
+		}
+		records[i] = record
+	}
+	return v.db.SaveValidatorPerformance(ctx, records)
+}
@@ -0,0 +1,56 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	dbTest "github.com/prysmaticlabs/prysm/validator/db/testing"
+)
+
+func TestSaveValidatorPerformance(t *testing.T) {
+	ctx := context.Background()
+	db := dbTest.SetupDB(t, [][48]byte{})
+	v := &validator{db: db}
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+
+	pubKey1, pubKey2 := [48]byte{1}, [48]byte{2}
+	v.recordProposal(pubKey1, slotsPerEpoch+1, true)
+	v.recordProposal(pubKey1, slotsPerEpoch+5, false)
+	v.recordProposal(pubKey2, 2*slotsPerEpoch, true)
+
+	resp := &ethpb.ValidatorPerformanceResponse{
+		PublicKeys:                    [][]byte{pubKey1[:], pubKey2[:]},
+		InclusionSlots:                []uint64{slotsPerEpoch + 2, ^uint64(0)},
+		InclusionDistances:            []uint64{1, 0},
+		CorrectlyVotedSource:          []bool{true, false},
+		CorrectlyVotedTarget:          []bool{true, false},
+		CorrectlyVotedHead:            []bool{false, false},
+		BalancesBeforeEpochTransition: []uint64{32000000000, 32000000000},
+		BalancesAfterEpochTransition:  []uint64{32000010000, 31999990000},
+	}
+	require.NoError(t, v.saveValidatorPerformance(ctx, 1, resp))
+
+	records, err := db.ValidatorPerformanceForPubKey(ctx, pubKey1, 0, ^uint64(0))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(records))
+	assert.Equal(t, uint64(1), records[0].Epoch)
+	assert.Equal(t, uint64(1), records[0].InclusionDistance)
+	assert.Equal(t, true, records[0].CorrectlyVotedTarget)
+	assert.Equal(t, uint64(32000010000), records[0].BalanceAfter)
+	assert.Equal(t, uint64(2), records[0].ProposalsAssigned)
+	assert.Equal(t, uint64(1), records[0].ProposalsMissed)
+
+	// Proposals of the following epoch are kept for its own record.
+	records, err = db.ValidatorPerformanceForPubKey(ctx, pubKey2, 0, ^uint64(0))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(records))
+	assert.Equal(t, false, records[0].Included())
+	assert.Equal(t, uint64(0), records[0].ProposalsAssigned)
+	_, ok := v.proposalCounts[2][pubKey2]
+	assert.Equal(t, true, ok)
+	assert.Equal(t, 1, len(v.proposalCounts))
+}
@@ -37,6 +37,10 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]by
 	ctx, span := trace.StartSpan(ctx, "validator.ProposeBlock")
 	defer span.End()
 	fmtKey := fmt.Sprintf("%#x", pubKey[:])
+	proposed := false
+	defer func() {
+		v.recordProposal(pubKey, slot, proposed)
+	}()
 
 	span.AddAttributes(trace.StringAttribute("validator", fmt.Sprintf("%#x", pubKey)))
 	log := log.WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])))
@@ -52,11 +56,17 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]by
 		return
 	}
 
+	graffiti, err := v.graffitiFor(pubKey)
+	if err != nil {
+		log.WithError(err).Warn("Could not render graffiti of proposer config, using the default graffiti")
+		graffiti = v.graffiti
+	}
+
 	// Request block from beacon node
 	b, err := v.validatorClient.GetBlock(ctx, &ethpb.BlockRequest{
 		Slot:         slot,
 		RandaoReveal: randaoReveal,
-		Graffiti:     v.graffiti,
+		Graffiti:     graffiti,
 	})
 	if err != nil {
 		log.WithField("blockSlot", slot).WithError(err).Error("Failed to request block from beacon node")
@@ -103,6 +113,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot uint64, pubKey [48]by
 		}
 		return
 	}
+	proposed = true
 
 	span.AddAttributes(
 		trace.StringAttribute("blockRoot", fmt.Sprintf("%#x", blkResp.BlockRoot)),
@@ -140,20 +151,15 @@ func ProposeExit(
 	if err != nil {
 		return errors.Wrap(err, "gRPC call to get validator index failed")
 	}
-	genesisResponse, err := nodeClient.GetGenesis(ctx, &types.Empty{})
+	currentEpoch, err := CurrentEpoch(ctx, nodeClient)
 	if err != nil {
-		return errors.Wrap(err, "gRPC call to get genesis time failed")
+		return err
 	}
-	totalSecondsPassed := timeutils.Now().Unix() - genesisResponse.GenesisTime.Seconds
-	currentEpoch := uint64(totalSecondsPassed) / (params.BeaconConfig().SecondsPerSlot * params.BeaconConfig().SlotsPerEpoch)
-
-	exit := &ethpb.VoluntaryExit{Epoch: currentEpoch, ValidatorIndex: indexResponse.Index}
-	sig, err := signVoluntaryExit(ctx, validatorClient, signer, pubKey, exit)
+	signedExit, err := CreateSignedVoluntaryExit(ctx, validatorClient, signer, pubKey, indexResponse.Index, currentEpoch)
 	if err != nil {
-		return errors.Wrap(err, "failed to sign voluntary exit")
+		return err
 	}
 
-	signedExit := &ethpb.SignedVoluntaryExit{Exit: exit, Signature: sig}
 	exitResp, err := validatorClient.ProposeExit(ctx, signedExit)
 	if err != nil {
 		return errors.Wrap(err, "failed to propose voluntary exit")
@@ -166,6 +172,37 @@ func ProposeExit(
 	return nil
 }
 
+// CurrentEpoch returns the current epoch of the chain based on the genesis time of the beacon node.
+func CurrentEpoch(ctx context.Context, nodeClient ethpb.NodeClient) (uint64, error) {
+	genesisResponse, err := nodeClient.GetGenesis(ctx, &types.Empty{})
+	if err != nil {
+		return 0, errors.Wrap(err, "gRPC call to get genesis time failed")
+	}
+	totalSecondsPassed := timeutils.Now().Unix() - genesisResponse.GenesisTime.Seconds
+	if totalSecondsPassed < 0 {
+		return 0, nil
+	}
+	return uint64(totalSecondsPassed) / (params.BeaconConfig().SecondsPerSlot * params.BeaconConfig().SlotsPerEpoch), nil
+}
+
+// CreateSignedVoluntaryExit signs a voluntary exit of the validator with the given index for the
+// given epoch. Exits for a future epoch are only accepted by beacon nodes once that epoch is reached.
+func CreateSignedVoluntaryExit(
+	ctx context.Context,
+	validatorClient ethpb.BeaconNodeValidatorClient,
+	signer signingFunc,
+	pubKey []byte,
+	validatorIndex uint64,
+	epoch uint64,
+) (*ethpb.SignedVoluntaryExit, error) {
+	exit := &ethpb.VoluntaryExit{Epoch: epoch, ValidatorIndex: validatorIndex}
+	sig, err := signVoluntaryExit(ctx, validatorClient, signer, pubKey, exit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign voluntary exit")
+	}
+	return &ethpb.SignedVoluntaryExit{Exit: exit, Signature: sig}, nil
+}
+
 // Sign randao reveal with randao domain and private key.
 func (v *validator) signRandaoReveal(ctx context.Context, pubKey [48]byte, epoch uint64) ([]byte, error) {
 	domain, err := v.domainData(ctx, epoch, params.BeaconConfig().DomainRandao[:])
@@ -220,6 +257,24 @@ func (v *validator) signBlock(ctx context.Context, pubKey [48]byte, epoch uint64
 	return sig.Marshal(), domain, nil
 }
 
+// Returns the graffiti of the proposer config for the public key, rendered with the index of the
+// validator from its duties, or the graffiti of the validator client without a proposer config.
+func (v *validator) graffitiFor(pubKey [48]byte) ([]byte, error) {
+	if v.proposerConfig == nil {
+		return v.graffiti, nil
+	}
+	var index uint64
+	if v.duties != nil {
+		for _, duty := range v.duties.Duties {
+			if bytesutil.ToBytes48(duty.PublicKey) == pubKey {
+				index = duty.ValidatorIndex
+				break
+			}
+		}
+	}
+	return v.proposerConfig.For(pubKey).RenderGraffiti(pubKey, index)
+}
+
 // Sign voluntary exit with proposer domain and private key.
 func signVoluntaryExit(
 	ctx context.Context,
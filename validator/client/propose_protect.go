@@ -9,6 +9,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/shared/blockutil"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
 )
 
@@ -34,6 +35,18 @@ func (v *validator) preBlockSignValidations(ctx context.Context, pubKey [48]byte
 		}
 		return errors.New(failedPreBlockSignLocalErr)
 	}
+	// Proposals older than the weak subjectivity period from the highest signed proposal are
+	// pruned from our history, so we cannot know whether a block was signed for the slot.
+	highestSlot, err := v.db.HighestSignedProposal(ctx, pubKey)
+	if err != nil {
+		return errors.Wrap(err, "failed to get highest signed proposal")
+	}
+	if helpers.SlotToEpoch(block.Slot)+params.BeaconConfig().WeakSubjectivityPeriod <= helpers.SlotToEpoch(highestSlot) {
+		if v.emitAccountMetrics {
+			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
+		}
+		return errors.New(failedPreBlockSignLocalErr)
+	}
 
 	if featureconfig.Get().SlasherProtection && v.protector != nil {
 		blockHdr, err := blockutil.BeaconBlockHeaderFromBlock(block)
@@ -6,6 +6,7 @@ import (
 
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	mockSlasher "github.com/prysmaticlabs/prysm/validator/testing"
@@ -55,6 +56,33 @@ func TestPreBlockSignLocalValidation(t *testing.T) {
 	require.NoError(t, err, "Expected allowed block not to throw error")
 }
 
+func TestPreBlockSignLocalValidation_PrunedHistory(t *testing.T) {
+	ctx := context.Background()
+	config := &featureconfig.Flags{
+		SlasherProtection: false,
+	}
+	reset := featureconfig.InitWithReset(config)
+	defer reset()
+	validator, _, validatorKey, finish := setup(t)
+	defer finish()
+	pubKey := [48]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	highestSlot := (wsPeriod + 10) * slotsPerEpoch
+	require.NoError(t, validator.db.SaveProposalHistoryForSlot(ctx, pubKey, highestSlot, []byte{1}))
+
+	// Proposals older than the weak subjectivity period are no longer in our history.
+	block := &ethpb.BeaconBlock{Slot: 10 * slotsPerEpoch}
+	err := validator.preBlockSignValidations(ctx, pubKey, block)
+	require.ErrorContains(t, failedPreBlockSignLocalErr, err)
+
+	block.Slot = 11 * slotsPerEpoch
+	err = validator.preBlockSignValidations(ctx, pubKey, block)
+	require.NoError(t, err, "Expected block within the history to be allowed")
+}
+
 func TestPreBlockSignValidation(t *testing.T) {
 	config := &featureconfig.Flags{
 		SlasherProtection: true,
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/hex"
 	"errors"
+	"fmt"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -14,6 +16,7 @@ import (
 	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
 	"github.com/prysmaticlabs/prysm/shared/mock"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil"
@@ -21,6 +24,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	"github.com/prysmaticlabs/prysm/validator/db/kv"
 	testing2 "github.com/prysmaticlabs/prysm/validator/db/testing"
+	proposerconfig "github.com/prysmaticlabs/prysm/validator/proposer-config"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
 
@@ -407,6 +411,40 @@ func TestProposeBlock_BroadcastsBlock_WithGraffiti(t *testing.T) {
 	assert.Equal(t, string(validator.graffiti), string(sentBlock.Block.Body.Graffiti))
 }
 
+func TestProposeBlock_GraffitiFromProposerConfig(t *testing.T) {
+	validator, m, validatorKey, finish := setup(t)
+	defer finish()
+	pubKey := [48]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+
+	path := filepath.Join(t.TempDir(), "proposer-config.yaml")
+	require.NoError(t, fileutil.WriteFile(path, []byte(fmt.Sprintf(`keys: {"%#x": {graffiti: "validator {{.Index}}"}}`, pubKey))))
+	store, err := proposerconfig.NewStore(path, "")
+	require.NoError(t, err)
+	validator.proposerConfig = store
+	validator.duties = &ethpb.DutiesResponse{
+		Duties: []*ethpb.DutiesResponse_Duty{{PublicKey: pubKey[:], ValidatorIndex: 42}},
+	}
+
+	m.validatorClient.EXPECT().DomainData(
+		gomock.Any(), // ctx
+		gomock.Any(), //epoch
+	).Return(&ethpb.DomainResponse{SignatureDomain: make([]byte, 32)}, nil /*err*/)
+
+	var request *ethpb.BlockRequest
+	m.validatorClient.EXPECT().GetBlock(
+		gomock.Any(), // ctx
+		gomock.Any(),
+	).DoAndReturn(func(_ context.Context, req *ethpb.BlockRequest) (*ethpb.BeaconBlock, error) {
+		request = req
+		return nil, errors.New("uh oh")
+	})
+
+	validator.ProposeBlock(context.Background(), 1, pubKey)
+	require.NotNil(t, request)
+	assert.Equal(t, "validator 42", string(request.Graffiti))
+}
+
 func TestProposeExit_ValidatorIndexFailed(t *testing.T) {
 	_, m, validatorKey, finish := setup(t)
 	defer finish()
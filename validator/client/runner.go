@@ -37,6 +37,7 @@ type Validator interface {
 	UpdateDomainDataCaches(ctx context.Context, slot uint64)
 	WaitForWalletInitialization(ctx context.Context) error
 	AllValidatorsAreExited(ctx context.Context) (bool, error)
+	CheckDoppelganger(ctx context.Context, slot uint64) error
 }
 
 // Run the main validator routine. This routine exits if the context is
@@ -47,8 +48,9 @@ type Validator interface {
 // 2 - Wait for validator activation
 // 3 - Wait for the next slot start
 // 4 - Update assignments
-// 5 - Determine role at current slot
-// 6 - Perform assigned role, if any
+// 5 - Check for doppelgangers, if enabled
+// 6 - Determine role at current slot
+// 7 - Perform assigned role, if any
 func run(ctx context.Context, v Validator) {
 	cleanup := v.Done
 	defer cleanup()
@@ -114,6 +116,10 @@ func run(ctx context.Context, v Validator) {
 				continue
 			}
 
+			if err := v.CheckDoppelganger(ctx, slot); err != nil {
+				log.WithError(err).Error("Could not check for doppelgangers, refusing to sign with unchecked keys")
+			}
+
 			if err := v.UpdateProtections(ctx, slot); err != nil {
 				log.WithError(err).Error("Could not update validator protection")
 				span.End()
@@ -66,6 +66,25 @@ func TestUpdateDuties_NextSlot(t *testing.T) {
 	assert.Equal(t, slot, v.UpdateDutiesArg1, "UpdateAssignments was called with wrong argument")
 }
 
+func TestCheckDoppelganger_NextSlot(t *testing.T) {
+	v := &FakeValidator{}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	slot := uint64(55)
+	ticker := make(chan uint64)
+	v.NextSlotRet = ticker
+	go func() {
+		ticker <- slot
+
+		cancel()
+	}()
+
+	run(ctx, v)
+
+	require.Equal(t, true, v.CheckDoppelgangerCalled, "Expected CheckDoppelganger(%d) to be called", slot)
+	assert.Equal(t, slot, v.CheckDoppelgangerArg1, "CheckDoppelganger was called with wrong argument")
+}
+
 func TestUpdateDuties_HandlesError(t *testing.T) {
 	hook := logTest.NewGlobal()
 	v := &FakeValidator{}
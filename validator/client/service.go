@@ -15,6 +15,7 @@ import (
 	lru "github.com/hashicorp/golang-lru"
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/grpcutils"
@@ -22,7 +23,7 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
 	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
-	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
+	proposerconfig "github.com/prysmaticlabs/prysm/validator/proposer-config"
 	slashingprotection "github.com/prysmaticlabs/prysm/validator/slashing-protection"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/plugin/ocgrpc"
@@ -45,6 +46,12 @@ type GenesisFetcher interface {
 	GenesisInfo(ctx context.Context) (*ethpb.Genesis, error)
 }
 
+// accountChangesSubscriber is implemented by keymanagers whose set of
+// validating keys can change while the validator client is running.
+type accountChangesSubscriber interface {
+	SubscribeAccountChanges(pubKeysChan chan [][48]byte) event.Subscription
+}
+
 // ValidatorService represents a service to manage the validator client
 // routine.
 type ValidatorService struct {
@@ -67,6 +74,12 @@ type ValidatorService struct {
 	keyManager            keymanager.IKeymanager
 	grpcHeaders           []string
 	graffiti              []byte
+	doppelganger          *doppelgangerTracker
+	maxSlotLag            uint64
+	minPeers              uint64
+	beaconNodes           *beaconNodeHealthTracker
+	broadcast             bool
+	proposerConfig        *proposerconfig.Store
 }
 
 // Config for the validator service.
@@ -87,6 +100,11 @@ type Config struct {
 	CertFlag                   string
 	DataDir                    string
 	GrpcHeadersFlag            string
+	DoppelgangerEpochs         uint64
+	BeaconNodeMaxSlotLag       uint64
+	BeaconNodeMinPeers         uint64
+	BroadcastToBeaconNodes     bool
+	ProposerConfig             *proposerconfig.Store
 }
 
 // NewValidatorService creates a new validator service for the service
@@ -112,6 +130,11 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		db:                    cfg.ValDB,
 		walletInitializedFeed: cfg.WalletInitializedFeed,
 		useWeb:                cfg.UseWeb,
+		doppelganger:          newDoppelgangerTracker(cfg.DoppelgangerEpochs),
+		maxSlotLag:            cfg.BeaconNodeMaxSlotLag,
+		minPeers:              cfg.BeaconNodeMinPeers,
+		broadcast:             cfg.BroadcastToBeaconNodes,
+		proposerConfig:        cfg.ProposerConfig,
 	}, nil
 }
 
@@ -145,11 +168,14 @@ func (v *ValidatorService) Start() {
 		}
 	}
 
-	conn, err := grpc.DialContext(v.ctx, v.endpoint, dialOpts...)
+	tracker, conn, err := dialBeaconNodes(v.ctx, v.endpoint, dialOpts, v.maxSlotLag, v.minPeers)
 	if err != nil {
-		log.Errorf("Could not dial endpoint: %s, %v", v.endpoint, err)
+		log.WithError(err).Error("Could not dial beacon nodes")
 		return
 	}
+	v.beaconNodes = tracker
+	go v.beaconNodes.run(v.ctx)
+
 	if v.withCert != "" {
 		log.Info("Established secure gRPC connection")
 	}
@@ -170,11 +196,13 @@ func (v *ValidatorService) Start() {
 		return
 	}
 
+	validatorClient := newValidatorClient(v.conn, v.beaconNodes, v.broadcast)
 	v.validator = &validator{
 		db:                             v.db,
-		validatorClient:                ethpb.NewBeaconNodeValidatorClient(v.conn),
+		validatorClient:                validatorClient,
 		beaconClient:                   ethpb.NewBeaconChainClient(v.conn),
 		node:                           ethpb.NewNodeClient(v.conn),
+		livenessClient:                 pbrpc.NewLivenessClient(v.conn),
 		keyManager:                     v.keyManager,
 		graffiti:                       v.graffiti,
 		logValidatorBalances:           v.logValidatorBalances,
@@ -188,21 +216,45 @@ func (v *ValidatorService) Start() {
 		voteStats:                      voteStats{startEpoch: ^uint64(0)},
 		useWeb:                         v.useWeb,
 		walletInitializedFeed:          v.walletInitializedFeed,
+		doppelganger:                   v.doppelganger,
+		proposerConfig:                 v.proposerConfig,
+	}
+	if v.proposerConfig != nil {
+		go v.proposerConfig.Watch(v.ctx)
 	}
 	go run(v.ctx, v.validator)
 	go v.recheckKeys(v.ctx)
 }
 
+// ProposerConfig returns the proposer config of the validator client, or nil if the
+// validator client was started without a proposer config file.
+func (v *ValidatorService) ProposerConfig() *proposerconfig.Store {
+	return v.proposerConfig
+}
+
 // Stop the validator service.
 func (v *ValidatorService) Stop() error {
 	v.cancel()
 	log.Info("Stopping service")
+	if v.beaconNodes != nil {
+		v.beaconNodes.close()
+	}
 	if v.conn != nil {
 		return v.conn.Close()
 	}
 	return nil
 }
 
+// DoppelgangerStatus returns the state of the doppelganger protection of the validating keys.
+func (v *ValidatorService) DoppelgangerStatus() *DoppelgangerReport {
+	return v.doppelganger.report()
+}
+
+// BeaconNodesHealth returns the health of the beacon nodes the validator client is configured with.
+func (v *ValidatorService) BeaconNodesHealth() []*BeaconNodeHealth {
+	return v.beaconNodes.report()
+}
+
 // Status of the validator service.
 func (v *ValidatorService) Status() error {
 	if v.conn == nil {
@@ -243,6 +295,63 @@ func (v *ValidatorService) recheckKeys(ctx context.Context) {
 	}
 }
 
+// dialBeaconNodes dials every beacon node of the comma separated endpoint separately, so that
+// their health can be checked, and then all of them through a single connection whose requests
+// are routed to the active beacon node by the beacon_node_failover load balancer.
+func dialBeaconNodes(
+	ctx context.Context,
+	endpoint string,
+	dialOpts []grpc.DialOption,
+	maxSlotLag, minPeers uint64,
+) (*beaconNodeHealthTracker, *grpc.ClientConn, error) {
+	endpoints := strings.Split(endpoint, ",")
+	nodes := make([]*beaconNode, 0, len(endpoints))
+	for _, e := range endpoints {
+		nodeConn, err := grpc.DialContext(ctx, e, dialOpts...)
+		if err != nil {
+			newBeaconNodeHealthTracker(nodes, maxSlotLag, minPeers).close()
+			return nil, nil, errors.Wrapf(err, "could not dial endpoint %s", e)
+		}
+		nodes = append(nodes, &beaconNode{
+			endpoint:        e,
+			conn:            nodeConn,
+			nodeClient:      ethpb.NewNodeClient(nodeConn),
+			beaconClient:    ethpb.NewBeaconChainClient(nodeConn),
+			validatorClient: ethpb.NewBeaconNodeValidatorClient(nodeConn),
+		})
+	}
+	tracker := newBeaconNodeHealthTracker(nodes, maxSlotLag, minPeers)
+
+	// gRPC uses the first resolver given for a scheme, so the resolver attaching the health
+	// tracker to the addresses must precede the default one of the dial options.
+	failoverOpts := append([]grpc.DialOption{
+		grpc.WithResolvers(&multipleEndpointsGrpcResolverBuilder{healthTracker: tracker}),
+		grpc.WithDefaultServiceConfig(
+			fmt.Sprintf("{\"loadBalancingConfig\":[{\"%s\":{}}]}", beaconNodeFailoverBalancerName),
+		),
+	}, dialOpts...)
+	conn, err := grpc.DialContext(ctx, endpoint, failoverOpts...)
+	if err != nil {
+		tracker.close()
+		return nil, nil, errors.Wrapf(err, "could not dial endpoint %s", endpoint)
+	}
+	return tracker, conn, nil
+}
+
+// newValidatorClient returns the client of the validator API routing its requests to the active
+// beacon node. If broadcasting is enabled and there are several beacon nodes, signed objects are
+// published to all healthy beacon nodes instead.
+func newValidatorClient(conn *grpc.ClientConn, tracker *beaconNodeHealthTracker, broadcast bool) ethpb.BeaconNodeValidatorClient {
+	validatorClient := ethpb.NewBeaconNodeValidatorClient(conn)
+	if !broadcast || len(tracker.nodes) < 2 {
+		return validatorClient
+	}
+	return &broadcastValidatorClient{
+		BeaconNodeValidatorClient: validatorClient,
+		beaconNodes:               tracker,
+	}
+}
+
 // ConstructDialOptions constructs a list of grpc dial options
 func ConstructDialOptions(
 	maxCallRecvMsgSize int,
@@ -290,10 +399,12 @@ func ConstructDialOptions(
 			grpc_prometheus.StreamClientInterceptor,
 			grpc_retry.StreamClientInterceptor(),
 		),
-		grpc.WithResolvers(&multipleEndpointsGrpcResolverBuilder{}),
 	}
 
 	dialOpts = append(dialOpts, extraOpts...)
+	// gRPC uses the first resolver given for a scheme, so a resolver given in the extra options,
+	// or in options preceding the returned ones, takes precedence over the default one.
+	dialOpts = append(dialOpts, grpc.WithResolvers(&multipleEndpointsGrpcResolverBuilder{}))
 	return dialOpts
 }
 
@@ -317,12 +428,12 @@ func (v *ValidatorService) GenesisInfo(ctx context.Context) (*ethpb.Genesis, err
 // to accounts changes in the keymanager, then updates those keys'
 // buckets in bolt DB if a bucket for a key does not exist.
 func recheckValidatingKeysBucket(ctx context.Context, valDB db.Database, km keymanager.IKeymanager) {
-	importedKeymanager, ok := km.(*imported.Keymanager)
+	subscriber, ok := km.(accountChangesSubscriber)
 	if !ok {
 		return
 	}
 	validatingPubKeysChan := make(chan [][48]byte, 1)
-	sub := importedKeymanager.SubscribeAccountChanges(validatingPubKeysChan)
+	sub := subscriber.SubscribeAccountChanges(validatingPubKeysChan)
 	defer sub.Unsubscribe()
 	for {
 		select {
@@ -21,6 +21,7 @@ import (
 	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/beacon/rpc/v1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
@@ -31,6 +32,7 @@ import (
 	vdb "github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/db/kv"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	proposerconfig "github.com/prysmaticlabs/prysm/validator/proposer-config"
 	slashingprotection "github.com/prysmaticlabs/prysm/validator/slashing-protection"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
@@ -58,6 +60,7 @@ type validator struct {
 	attLogsLock                        sync.Mutex
 	aggregatedSlotCommitteeIDCacheLock sync.Mutex
 	prevBalanceLock                    sync.RWMutex
+	proposalCountsLock                 sync.Mutex
 	attesterHistoryByPubKeyLock        sync.RWMutex
 	walletInitializedFeed              *event.Feed
 	genesisTime                        uint64
@@ -66,6 +69,7 @@ type validator struct {
 	ticker                             *slotutil.SlotTicker
 	attesterHistoryByPubKey            map[[48]byte]kv.EncHistoryData
 	prevBalance                        map[[48]byte]uint64
+	proposalCounts                     map[uint64]map[[48]byte]*proposalCounts
 	duties                             *ethpb.DutiesResponse
 	startBalances                      map[[48]byte]uint64
 	attLogs                            map[[32]byte]*attSubmitted
@@ -73,9 +77,12 @@ type validator struct {
 	keyManager                         keymanager.IKeymanager
 	beaconClient                       ethpb.BeaconChainClient
 	validatorClient                    ethpb.BeaconNodeValidatorClient
+	livenessClient                     pbrpc.LivenessClient
+	doppelganger                       *doppelgangerTracker
 	protector                          slashingprotection.Protector
 	db                                 vdb.Database
 	graffiti                           []byte
+	proposerConfig                     *proposerconfig.Store
 	voteStats                          voteStats
 }
 
@@ -156,6 +163,9 @@ func (v *validator) WaitForChainStart(ctx context.Context) error {
 				)
 			}
 		}
+		if setter, ok := v.keyManager.(keymanager.GenesisValidatorsRootSetter); ok {
+			setter.SetGenesisValidatorsRoot(chainStartRes.GenesisValidatorsRoot)
+		}
 	}
 
 	// Once the ChainStart log is received, we update the genesis time of the validator client
@@ -474,7 +484,14 @@ func (v *validator) RolesAt(ctx context.Context, slot uint64) (map[[48]byte][]Va
 		if duty == nil {
 			continue
 		}
-		if len(duty.ProposerSlots) > 0 {
+		if !v.doppelganger.canSign(bytesutil.ToBytes48(duty.PublicKey)) {
+			continue
+		}
+		keyConfig := v.proposerConfig.For(bytesutil.ToBytes48(duty.PublicKey))
+		if !keyConfig.Enabled {
+			continue
+		}
+		if len(duty.ProposerSlots) > 0 && keyConfig.Propose {
 			for _, proposerSlot := range duty.ProposerSlots {
 				if proposerSlot != 0 && proposerSlot == slot {
 					roles = append(roles, roleProposer)
@@ -482,7 +499,7 @@ func (v *validator) RolesAt(ctx context.Context, slot uint64) (map[[48]byte][]Va
 				}
 			}
 		}
-		if duty.AttesterSlot == slot {
+		if duty.AttesterSlot == slot && keyConfig.Attest {
 			roles = append(roles, roleAttester)
 
 			aggregator, err := v.isAggregator(ctx, duty.Committee, slot, bytesutil.ToBytes48(duty.PublicKey))
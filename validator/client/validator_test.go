@@ -3,7 +3,9 @@ package client
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io/ioutil"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
@@ -14,12 +16,14 @@ import (
 	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
 	"github.com/prysmaticlabs/prysm/shared/bls"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
 	"github.com/prysmaticlabs/prysm/shared/mock"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	"github.com/prysmaticlabs/prysm/validator/db/kv"
 	dbTest "github.com/prysmaticlabs/prysm/validator/db/testing"
+	proposerconfig "github.com/prysmaticlabs/prysm/validator/proposer-config"
 	"github.com/sirupsen/logrus"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 )
@@ -870,6 +874,42 @@ func TestRolesAt_DoesNotAssignProposer_Slot0(t *testing.T) {
 	assert.Equal(t, ValidatorRole(roleAttester), roleMap[bytesutil.ToBytes48(validatorKey.PublicKey().Marshal())][0])
 }
 
+func TestRolesAt_ProposerConfig(t *testing.T) {
+	v, _, validatorKey, finish := setup(t)
+	defer finish()
+	disabledKey := bytesutil.PadTo([]byte{1}, 48)
+	path := filepath.Join(t.TempDir(), "proposer-config.yaml")
+	require.NoError(t, fileutil.WriteFile(path, []byte(fmt.Sprintf(
+		`keys: {"%#x": {propose: false}, "%#x": {enabled: false}}`,
+		validatorKey.PublicKey().Marshal(),
+		disabledKey,
+	))))
+	store, err := proposerconfig.NewStore(path, "")
+	require.NoError(t, err)
+	v.proposerConfig = store
+
+	v.duties = &ethpb.DutiesResponse{
+		Duties: []*ethpb.DutiesResponse_Duty{
+			{
+				AttesterSlot:  2,
+				ProposerSlots: []uint64{1},
+				PublicKey:     validatorKey.PublicKey().Marshal(),
+			},
+			{
+				AttesterSlot:  1,
+				ProposerSlots: []uint64{1},
+				PublicKey:     disabledKey,
+			},
+		},
+	}
+
+	roleMap, err := v.RolesAt(context.Background(), 1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, map[[48]byte][]ValidatorRole{
+		bytesutil.ToBytes48(validatorKey.PublicKey().Marshal()): {roleUnknown},
+	}, roleMap)
+}
+
 func TestCheckAndLogValidatorStatus_OK(t *testing.T) {
 	nonexistentIndex := ^uint64(0)
 	type statusTest struct {
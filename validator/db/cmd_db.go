@@ -0,0 +1,165 @@
+package db
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// Commands for maintaining the validator slashing protection database while the validator is stopped.
+var Commands = &cli.Command{
+	Name:     "db",
+	Category: "db",
+	Usage:    "defines commands for maintaining the validator database while the validator is stopped",
+	Subcommands: []*cli.Command{
+		{
+			Name: "restore",
+			Usage: `restores the validator database from a backup file, the target directory is the ` +
+				`directory holding the validator.db file, which is the wallet directory by default`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.RestoreSourceFileFlag,
+				cmd.RestoreTargetDirFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				return cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags)
+			},
+			Action: restoreCli,
+		},
+		{
+			Name: "check",
+			Usage: `checks the slashing protection data of the validator database for inconsistencies ` +
+				`between the attestation and proposal histories, the lowest and highest signed ` +
+				`watermarks and the histories in the legacy format`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				cmd.DataDirFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				return cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags)
+			},
+			Action: checkCli,
+		},
+		{
+			Name: "prune",
+			Usage: `collapses the slashing protection history older than the weak subjectivity period ` +
+				`into the lowest and highest signed watermarks of each key and removes the migrated ` +
+				`histories in the legacy format, signing below the watermarks remains refused`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				cmd.DataDirFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				return cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags)
+			},
+			Action: pruneCli,
+		},
+	},
+}
+
+func restoreCli(cliCtx *cli.Context) error {
+	sourceFile, err := fileutil.ExpandPath(cliCtx.String(cmd.RestoreSourceFileFlag.Name))
+	if err != nil {
+		return err
+	}
+	targetDir, err := fileutil.ExpandPath(cliCtx.String(cmd.RestoreTargetDirFlag.Name))
+	if err != nil {
+		return err
+	}
+	if err := kv.Restore(cliCtx.Context, sourceFile, targetDir); err != nil {
+		return errors.Wrap(err, "could not restore database")
+	}
+	return nil
+}
+
+func checkCli(cliCtx *cli.Context) error {
+	validatorDB, err := openValidatorDB(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := validatorDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close validator database")
+		}
+	}()
+	issues, err := validatorDB.CheckSlashingProtection(cliCtx.Context)
+	if err != nil {
+		return errors.Wrap(err, "could not check slashing protection data")
+	}
+	for _, issue := range issues {
+		log.WithFields(log.Fields{
+			"bucket": issue.Bucket,
+			"key":    fmt.Sprintf("%#x", issue.Key),
+		}).Warn(issue.Reason)
+	}
+	if len(issues) > 0 {
+		return fmt.Errorf("found %d integrity issues", len(issues))
+	}
+	log.Info("No integrity issues found in the slashing protection data")
+	return nil
+}
+
+func pruneCli(cliCtx *cli.Context) error {
+	validatorDB, err := openValidatorDB(cliCtx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := validatorDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close validator database")
+		}
+	}()
+	report, err := validatorDB.PruneSlashingProtection(cliCtx.Context)
+	if err != nil {
+		return errors.Wrap(err, "could not prune slashing protection history")
+	}
+	log.WithFields(log.Fields{
+		"referenceEpoch":                    report.ReferenceEpoch,
+		"collapsedAttestationHistories":     report.CollapsedAttestationHistories,
+		"prunedProposals":                   report.PrunedProposals,
+		"removedLegacyAttestationHistories": report.RemovedLegacyAttestationHistories,
+		"removedLegacyProposalHistories":    report.RemovedLegacyProposalHistories,
+	}).Info("Pruned slashing protection history")
+	return nil
+}
+
+// Opens the validator database in the accounts directory of the wallet, unless a data directory
+// is specified.
+func openValidatorDB(cliCtx *cli.Context) (*kv.Store, error) {
+	var dataDir string
+	if cliCtx.IsSet(cmd.DataDirFlag.Name) {
+		dir, err := fileutil.ExpandPath(cliCtx.String(cmd.DataDirFlag.Name))
+		if err != nil {
+			return nil, err
+		}
+		dataDir = dir
+	} else {
+		walletDir, err := fileutil.ExpandPath(cliCtx.String(flags.WalletDirFlag.Name))
+		if err != nil {
+			return nil, err
+		}
+		w, err := wallet.OpenWallet(cliCtx.Context, &wallet.Config{WalletDir: walletDir})
+		if err != nil {
+			return nil, errors.Wrapf(
+				err, "could not open wallet, please specify the directory of the validator database with --%s",
+				cmd.DataDirFlag.Name,
+			)
+		}
+		dataDir = w.AccountsDir()
+	}
+	if !fileutil.FileExists(filepath.Join(dataDir, kv.ProtectionDbFileName)) {
+		return nil, fmt.Errorf("no validator database found in %s", dataDir)
+	}
+	validatorDB, err := kv.NewKVStore(dataDir, nil)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not open validator database in %s", dataDir)
+	}
+	return validatorDB, nil
+}
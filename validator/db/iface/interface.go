@@ -45,4 +45,13 @@ type ValidatorDB interface {
 	SaveAttestationHistoryForPubKeysV2(ctx context.Context, historyByPubKeys map[[48]byte]kv.EncHistoryData) error
 	SaveAttestationHistoryForPubKeyV2(ctx context.Context, pubKey [48]byte, history kv.EncHistoryData) error
 	AttestedPublicKeys(ctx context.Context) ([][48]byte, error)
+
+	// Voluntary exit related methods.
+	VoluntaryExits(ctx context.Context) ([]*kv.VoluntaryExit, error)
+	SaveVoluntaryExits(ctx context.Context, exits []*kv.VoluntaryExit) error
+
+	// Validator performance related methods.
+	SaveValidatorPerformance(ctx context.Context, records []*kv.ValidatorPerformance) error
+	ValidatorPerformanceForPubKey(ctx context.Context, publicKey [48]byte, startEpoch, endEpoch uint64) ([]*kv.ValidatorPerformance, error)
+	ValidatorPerformancePublicKeys(ctx context.Context) ([][48]byte, error)
 }
@@ -94,6 +94,8 @@ func NewKVStore(dirPath string, pubKeys [][48]byte) (*Store, error) {
 			highestSignedTargetBucket,
 			lowestSignedProposalsBucket,
 			highestSignedProposalsBucket,
+			voluntaryExitsBucket,
+			validatorPerformanceBucket,
 		)
 	}); err != nil {
 		return nil, err
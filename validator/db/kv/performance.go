@@ -0,0 +1,153 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// Length of an encoded validator performance record.
+const performanceEncodedLength = 6*8 + 1
+
+// ValidatorPerformance is the performance of a validator key during an epoch, as reported
+// by the beacon node at the end of the following epoch, along with the proposals of the key
+// during the epoch.
+type ValidatorPerformance struct {
+	PublicKey            [48]byte
+	Epoch                uint64
+	InclusionSlot        uint64
+	InclusionDistance    uint64
+	CorrectlyVotedSource bool
+	CorrectlyVotedTarget bool
+	CorrectlyVotedHead   bool
+	BalanceBefore        uint64
+	BalanceAfter         uint64
+	ProposalsAssigned    uint64
+	ProposalsMissed      uint64
+}
+
+// Included returns whether the attestation of the validator was included in the chain.
+func (p *ValidatorPerformance) Included() bool {
+	return p.InclusionSlot != ^uint64(0)
+}
+
+// SaveValidatorPerformance saves performance records, replacing any existing record
+// of the same public key and epoch.
+func (store *Store) SaveValidatorPerformance(ctx context.Context, records []*ValidatorPerformance) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SaveValidatorPerformance")
+	defer span.End()
+
+	return store.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(validatorPerformanceBucket)
+		for _, record := range records {
+			if err := bucket.Put(performanceKey(record.PublicKey, record.Epoch), encodeValidatorPerformance(record)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ValidatorPerformanceForPubKey retrieves the performance records of a public key from the start
+// epoch up to and including the end epoch, ordered by epoch.
+func (store *Store) ValidatorPerformanceForPubKey(
+	ctx context.Context, publicKey [48]byte, startEpoch, endEpoch uint64,
+) ([]*ValidatorPerformance, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.ValidatorPerformanceForPubKey")
+	defer span.End()
+
+	records := make([]*ValidatorPerformance, 0)
+	err := store.view(func(tx *bolt.Tx) error {
+		c := tx.Bucket(validatorPerformanceBucket).Cursor()
+		max := performanceKey(publicKey, endEpoch)
+		for k, v := c.Seek(performanceKey(publicKey, startEpoch)); k != nil && bytes.Compare(k, max) <= 0; k, v = c.Next() {
+			record, err := decodeValidatorPerformance(k, v)
+			if err != nil {
+				return err
+			}
+			records = append(records, record)
+		}
+		return nil
+	})
+	return records, err
+}
+
+// ValidatorPerformancePublicKeys retrieves the public keys with performance records, in order.
+func (store *Store) ValidatorPerformancePublicKeys(ctx context.Context) ([][48]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.ValidatorPerformancePublicKeys")
+	defer span.End()
+
+	publicKeys := make([][48]byte, 0)
+	err := store.view(func(tx *bolt.Tx) error {
+		c := tx.Bucket(validatorPerformanceBucket).Cursor()
+		for k, _ := c.First(); k != nil; {
+			publicKey := bytesutil.ToBytes48(k[:48])
+			publicKeys = append(publicKeys, publicKey)
+			// Skip to the first record of the next public key.
+			k, _ = c.Seek(performanceKey(publicKey, ^uint64(0)))
+			if k != nil && bytesutil.ToBytes48(k[:48]) == publicKey {
+				k, _ = c.Next()
+			}
+		}
+		return nil
+	})
+	return publicKeys, err
+}
+
+// Records are keyed by public key followed by the big-endian epoch, so that the records of
+// a public key are stored contiguously in epoch order.
+func performanceKey(publicKey [48]byte, epoch uint64) []byte {
+	return append(publicKey[:], bytesutil.Uint64ToBytesBigEndian(epoch)...)
+}
+
+// A record is encoded as its little-endian integer fields followed by a byte of flags for
+// the correctly voted source, target and head.
+func encodeValidatorPerformance(record *ValidatorPerformance) []byte {
+	enc := make([]byte, performanceEncodedLength)
+	for i, field := range []uint64{
+		record.InclusionSlot,
+		record.InclusionDistance,
+		record.BalanceBefore,
+		record.BalanceAfter,
+		record.ProposalsAssigned,
+		record.ProposalsMissed,
+	} {
+		binary.LittleEndian.PutUint64(enc[i*8:], field)
+	}
+	var flags byte
+	for i, flag := range []bool{record.CorrectlyVotedSource, record.CorrectlyVotedTarget, record.CorrectlyVotedHead} {
+		if flag {
+			flags |= 1 << i
+		}
+	}
+	enc[performanceEncodedLength-1] = flags
+	return enc
+}
+
+func decodeValidatorPerformance(key, enc []byte) (*ValidatorPerformance, error) {
+	if len(key) != 56 || len(enc) != performanceEncodedLength {
+		return nil, fmt.Errorf("invalid validator performance record with key %#x", key)
+	}
+	field := func(i int) uint64 {
+		return binary.LittleEndian.Uint64(enc[i*8:])
+	}
+	flags := enc[performanceEncodedLength-1]
+	return &ValidatorPerformance{
+		PublicKey:            bytesutil.ToBytes48(key[:48]),
+		Epoch:                bytesutil.BytesToUint64BigEndian(key[48:]),
+		InclusionSlot:        field(0),
+		InclusionDistance:    field(1),
+		BalanceBefore:        field(2),
+		BalanceAfter:         field(3),
+		ProposalsAssigned:    field(4),
+		ProposalsMissed:      field(5),
+		CorrectlyVotedSource: flags&1 != 0,
+		CorrectlyVotedTarget: flags&2 != 0,
+		CorrectlyVotedHead:   flags&4 != 0,
+	}, nil
+}
@@ -0,0 +1,68 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_ValidatorPerformance_ReadAndWrite(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t, [][48]byte{})
+
+	keys, err := db.ValidatorPerformancePublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(keys))
+
+	records := []*ValidatorPerformance{
+		{
+			PublicKey:            [48]byte{2},
+			Epoch:                5,
+			InclusionSlot:        ^uint64(0),
+			BalanceBefore:        32000000000,
+			BalanceAfter:         31999990000,
+			ProposalsAssigned:    1,
+			ProposalsMissed:      1,
+			CorrectlyVotedTarget: true,
+		},
+		{
+			PublicKey:            [48]byte{1},
+			Epoch:                1,
+			InclusionSlot:        33,
+			InclusionDistance:    1,
+			CorrectlyVotedSource: true,
+			CorrectlyVotedHead:   true,
+		},
+		{PublicKey: [48]byte{1}, Epoch: 3},
+		{PublicKey: [48]byte{1}, Epoch: 2},
+		{PublicKey: [48]byte{1}, Epoch: ^uint64(0) - 1},
+	}
+	require.NoError(t, db.SaveValidatorPerformance(ctx, records))
+
+	keys, err = db.ValidatorPerformancePublicKeys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][48]byte{{1}, {2}}, keys)
+
+	// Records are returned in epoch order within the requested range.
+	got, err := db.ValidatorPerformanceForPubKey(ctx, [48]byte{1}, 1, 3)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(got))
+	assert.DeepEqual(t, records[1], got[0])
+	assert.Equal(t, uint64(2), got[1].Epoch)
+	assert.Equal(t, uint64(3), got[2].Epoch)
+
+	got, err = db.ValidatorPerformanceForPubKey(ctx, [48]byte{2}, 0, ^uint64(0))
+	require.NoError(t, err)
+	require.Equal(t, 1, len(got))
+	assert.DeepEqual(t, records[0], got[0])
+	assert.Equal(t, false, got[0].Included())
+
+	// Saving a record of the same epoch replaces it.
+	require.NoError(t, db.SaveValidatorPerformance(ctx, []*ValidatorPerformance{{PublicKey: [48]byte{2}, Epoch: 5}}))
+	got, err = db.ValidatorPerformanceForPubKey(ctx, [48]byte{2}, 5, 5)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(got))
+	assert.Equal(t, uint64(0), got[0].ProposalsMissed)
+}
@@ -0,0 +1,294 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/gogo/protobuf/proto"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/dbutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// integrityCheck walks the slashing protection buckets in a single read transaction.
+type integrityCheck struct {
+	ctx    context.Context
+	tx     *bolt.Tx
+	issues dbutil.IntegrityIssues
+}
+
+// CheckSlashingProtection walks the attestation and proposal histories of the database and
+// cross-validates them against the lowest and highest signed watermarks and the histories in
+// the legacy format. It returns the issues found, an error is only returned if the database
+// could not be read.
+func (store *Store) CheckSlashingProtection(ctx context.Context) ([]*dbutil.IntegrityIssue, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.CheckSlashingProtection")
+	defer span.End()
+
+	var issues []*dbutil.IntegrityIssue
+	err := store.view(func(tx *bolt.Tx) error {
+		c := &integrityCheck{ctx: ctx, tx: tx}
+		checks := []func() error{
+			c.checkAttestationHistories,
+			c.checkAttestationWatermarks,
+			c.checkProposalHistories,
+			c.checkProposalWatermarks,
+			c.checkLegacyAttestationHistories,
+			c.checkLegacyProposalHistories,
+		}
+		for _, check := range checks {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if err := check(); err != nil {
+				return err
+			}
+		}
+		issues = c.issues
+		return nil
+	})
+	return issues, err
+}
+
+// checkAttestationHistories checks that every attestation history is well formed, and that the
+// attestations it records have a source epoch no later than their target epoch and are not later
+// than the latest epoch written.
+func (c *integrityCheck) checkAttestationHistories() error {
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	bkt := c.tx.Bucket(newHistoricAttestationsBucket)
+	return bkt.ForEach(func(k, v []byte) error {
+		if len(k) != 48 {
+			c.issues.Report(newHistoricAttestationsBucket, k, "key is not a 48 bytes public key")
+			return nil
+		}
+		history := EncHistoryData(v)
+		if err := history.assertSize(); err != nil {
+			c.issues.Report(newHistoricAttestationsBucket, k, "malformed attestation history: %v", err)
+			return nil
+		}
+		entries := uint64(len(history)-latestEpochWrittenSize) / historySize
+		if entries > wsPeriod {
+			c.issues.Report(newHistoricAttestationsBucket, k, "attestation history has %d entries, more than the weak subjectivity period", entries)
+			return nil
+		}
+		latest, err := history.GetLatestEpochWritten(c.ctx)
+		if err != nil {
+			return err
+		}
+		for i := uint64(0); i < entries; i++ {
+			data, err := history.GetTargetData(c.ctx, i)
+			if err != nil {
+				return err
+			}
+			if isUnwrittenHistoryData(data) {
+				continue
+			}
+			// Entries are indexed by target epoch modulo the weak subjectivity period, the most recent
+			// target epoch of an entry is the latest target epoch written with the same index.
+			distance := (latest%wsPeriod + wsPeriod - i) % wsPeriod
+			if distance > latest {
+				c.issues.Report(newHistoricAttestationsBucket, k, "attestation recorded after the latest epoch written %d", latest)
+				continue
+			}
+			if target := latest - distance; data.Source > target {
+				c.issues.Report(newHistoricAttestationsBucket, k, "attestation with source epoch %d after its target epoch %d", data.Source, target)
+			}
+		}
+		if latest > 0 {
+			data, err := history.GetTargetData(c.ctx, latest)
+			if err != nil {
+				return err
+			}
+			if isUnwrittenHistoryData(data) {
+				c.issues.Report(newHistoricAttestationsBucket, k, "no attestation recorded at the latest epoch written %d", latest)
+			}
+		}
+		return nil
+	})
+}
+
+// checkAttestationWatermarks checks that the highest signed source and target epochs are well
+// formed and that the highest signed source epoch is not after the highest signed target epoch.
+func (c *integrityCheck) checkAttestationWatermarks() error {
+	sourceBkt := c.tx.Bucket(highestSignedSourceBucket)
+	targetBkt := c.tx.Bucket(highestSignedTargetBucket)
+	if err := c.checkWatermarks(highestSignedSourceBucket); err != nil {
+		return err
+	}
+	if err := c.checkWatermarks(highestSignedTargetBucket); err != nil {
+		return err
+	}
+	return sourceBkt.ForEach(func(k, v []byte) error {
+		target := targetBkt.Get(k)
+		if len(v) != 8 || len(target) != 8 {
+			return nil
+		}
+		source := bytesutil.BytesToUint64BigEndian(v)
+		if targetEpoch := bytesutil.BytesToUint64BigEndian(target); source > targetEpoch {
+			c.issues.Report(highestSignedSourceBucket, k, "highest signed source epoch %d is after the highest signed target epoch %d", source, targetEpoch)
+		}
+		return nil
+	})
+}
+
+// checkProposalHistories checks that the signed proposals of every key are well formed and lie
+// between the lowest and highest signed proposal slots of the key.
+func (c *integrityCheck) checkProposalHistories() error {
+	bkt := c.tx.Bucket(newHistoricProposalsBucket)
+	lowestBkt := c.tx.Bucket(lowestSignedProposalsBucket)
+	highestBkt := c.tx.Bucket(highestSignedProposalsBucket)
+	return bkt.ForEach(func(k, v []byte) error {
+		valBucket := bkt.Bucket(k)
+		if v != nil || valBucket == nil {
+			c.issues.Report(newHistoricProposalsBucket, k, "proposal history is not a bucket")
+			return nil
+		}
+		if len(k) != 48 {
+			c.issues.Report(newHistoricProposalsBucket, k, "key is not a 48 bytes public key")
+			return nil
+		}
+		var minSlot, maxSlot uint64
+		var proposals int
+		if err := valBucket.ForEach(func(slotKey, signingRoot []byte) error {
+			if len(slotKey) != 8 {
+				c.issues.Report(newHistoricProposalsBucket, k, "proposal recorded with a malformed slot %#x", slotKey)
+				return nil
+			}
+			// Signing roots of proposals migrated from the legacy format are a single byte.
+			if len(signingRoot) != 0 && len(signingRoot) != 1 && len(signingRoot) != 32 {
+				c.issues.Report(newHistoricProposalsBucket, k, "proposal recorded with a malformed signing root %#x", signingRoot)
+			}
+			slot := bytesutil.BytesToUint64BigEndian(slotKey)
+			if proposals == 0 || slot < minSlot {
+				minSlot = slot
+			}
+			if proposals == 0 || slot > maxSlot {
+				maxSlot = slot
+			}
+			proposals++
+			return nil
+		}); err != nil {
+			return err
+		}
+		if proposals == 0 {
+			return nil
+		}
+		if lowest, ok := watermark(lowestBkt, k); !ok || minSlot < lowest {
+			c.issues.Report(newHistoricProposalsBucket, k, "proposal at slot %d is lower than the lowest signed proposal slot", minSlot)
+		}
+		if highest, ok := watermark(highestBkt, k); !ok || maxSlot > highest {
+			c.issues.Report(newHistoricProposalsBucket, k, "proposal at slot %d is higher than the highest signed proposal slot", maxSlot)
+		}
+		return nil
+	})
+}
+
+// checkProposalWatermarks checks that the lowest and highest signed proposal slots are well formed
+// and that the lowest signed proposal slot is not after the highest signed proposal slot.
+func (c *integrityCheck) checkProposalWatermarks() error {
+	lowestBkt := c.tx.Bucket(lowestSignedProposalsBucket)
+	highestBkt := c.tx.Bucket(highestSignedProposalsBucket)
+	if err := c.checkWatermarks(lowestSignedProposalsBucket); err != nil {
+		return err
+	}
+	if err := c.checkWatermarks(highestSignedProposalsBucket); err != nil {
+		return err
+	}
+	return lowestBkt.ForEach(func(k, v []byte) error {
+		highest, ok := watermark(highestBkt, k)
+		if len(v) != 8 {
+			return nil
+		}
+		if !ok {
+			c.issues.Report(lowestSignedProposalsBucket, k, "lowest signed proposal slot without a highest signed proposal slot")
+			return nil
+		}
+		if lowest := bytesutil.BytesToUint64BigEndian(v); lowest > highest {
+			c.issues.Report(lowestSignedProposalsBucket, k, "lowest signed proposal slot %d is after the highest signed proposal slot %d", lowest, highest)
+		}
+		return nil
+	})
+}
+
+// checkLegacyAttestationHistories checks that the attestation histories in the legacy format were
+// migrated to the current format, the legacy histories are no longer used for slashing protection.
+func (c *integrityCheck) checkLegacyAttestationHistories() error {
+	bkt := c.tx.Bucket(historicAttestationsBucket)
+	migrated := bkt.Get([]byte(attestationExported)) != nil
+	newBkt := c.tx.Bucket(newHistoricAttestationsBucket)
+	return bkt.ForEach(func(k, v []byte) error {
+		if bytes.Equal(k, []byte(attestationExported)) {
+			return nil
+		}
+		history := &slashpb.AttestationHistory{}
+		if err := proto.Unmarshal(v, history); err != nil {
+			c.issues.Report(historicAttestationsBucket, k, "malformed legacy attestation history: %v", err)
+			return nil
+		}
+		if !migrated {
+			c.issues.Report(historicAttestationsBucket, k, "legacy attestation history was not migrated")
+		} else if newBkt.Get(k) == nil {
+			c.issues.Report(historicAttestationsBucket, k, "legacy attestation history is missing from the migrated attestation histories")
+		}
+		return nil
+	})
+}
+
+// checkLegacyProposalHistories checks that the proposal histories in the legacy format were
+// migrated to the current format, the legacy histories are no longer used for slashing protection.
+func (c *integrityCheck) checkLegacyProposalHistories() error {
+	bkt := c.tx.Bucket(historicProposalsBucket)
+	migrated := bkt.Get([]byte(proposalExported)) != nil
+	newBkt := c.tx.Bucket(newHistoricProposalsBucket)
+	return bkt.ForEach(func(k, v []byte) error {
+		valBucket := bkt.Bucket(k)
+		if v != nil || valBucket == nil {
+			return nil
+		}
+		// Buckets are created for every key at startup, only histories with proposals need migrating.
+		if first, _ := valBucket.Cursor().First(); first == nil {
+			return nil
+		}
+		if !migrated {
+			c.issues.Report(historicProposalsBucket, k, "legacy proposal history was not migrated")
+		} else if newBkt.Bucket(k) == nil {
+			c.issues.Report(historicProposalsBucket, k, "legacy proposal history is missing from the migrated proposal histories")
+		}
+		return nil
+	})
+}
+
+// checkWatermarks checks that the keys of a watermark bucket are public keys and its values are
+// 8 bytes long, shorter values are read as zero and would disable the protection they provide.
+func (c *integrityCheck) checkWatermarks(bucket []byte) error {
+	return c.tx.Bucket(bucket).ForEach(func(k, v []byte) error {
+		if len(k) != 48 {
+			c.issues.Report(bucket, k, "key is not a 48 bytes public key")
+		}
+		if len(v) != 8 {
+			c.issues.Report(bucket, k, "malformed value %#x", v)
+		}
+		return nil
+	})
+}
+
+// watermark reads the watermark of a public key from a watermark bucket.
+func watermark(bkt *bolt.Bucket, pubKey []byte) (uint64, bool) {
+	enc := bkt.Get(pubKey)
+	if len(enc) != 8 {
+		return 0, false
+	}
+	return bytesutil.BytesToUint64BigEndian(enc), true
+}
+
+// isUnwrittenHistoryData returns whether an entry of an attestation history records no attestation,
+// either marked empty or zeroed when the history was extended.
+func isUnwrittenHistoryData(data *HistoryData) bool {
+	if data.IsEmpty() {
+		return true
+	}
+	return data.Source == 0 && bytes.Equal(data.SigningRoot, make([]byte, signingRootSize))
+}
@@ -0,0 +1,83 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestStore_CheckSlashingProtection_Consistent(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	db := setupDB(t, [][48]byte{pubKey})
+
+	history, err := MarkAllAsAttestedSinceLatestWrittenEpoch(ctx, NewAttestationHistoryArray(0), 5, &HistoryData{
+		Source:      4,
+		SigningRoot: bytesutil.PadTo([]byte{1}, 32),
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.SaveAttestationHistoryForPubKeyV2(ctx, pubKey, history))
+	require.NoError(t, db.SaveProposalHistoryForSlot(ctx, pubKey, 10, bytesutil.PadTo([]byte{1}, 32)))
+	require.NoError(t, db.SaveProposalHistoryForSlot(ctx, pubKey, 12, bytesutil.PadTo([]byte{2}, 32)))
+
+	issues, err := db.CheckSlashingProtection(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(issues), "Unexpected issues %v", issues)
+}
+
+func TestStore_CheckSlashingProtection_Issues(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	db := setupDB(t, [][48]byte{pubKey})
+
+	history, err := MarkAllAsAttestedSinceLatestWrittenEpoch(ctx, NewAttestationHistoryArray(0), 5, &HistoryData{
+		Source:      6,
+		SigningRoot: bytesutil.PadTo([]byte{1}, 32),
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.SaveAttestationHistoryForPubKeyV2(ctx, pubKey, history))
+	require.NoError(t, db.SaveProposalHistoryForSlot(ctx, pubKey, 10, bytesutil.PadTo([]byte{1}, 32)))
+	require.NoError(t, db.update(func(tx *bolt.Tx) error {
+		// Lose the highest signed proposal slot and corrupt the highest signed source epoch.
+		if err := tx.Bucket(highestSignedProposalsBucket).Delete(pubKey[:]); err != nil {
+			return err
+		}
+		return tx.Bucket(highestSignedSourceBucket).Put(pubKey[:], []byte{1})
+	}))
+
+	issues, err := db.CheckSlashingProtection(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(issues), "Unexpected issues %v", issues)
+	assert.Equal(t, "attestation with source epoch 6 after its target epoch 5", issues[0].Reason)
+	assert.Equal(t, "malformed value 0x01", issues[1].Reason)
+	assert.Equal(t, "proposal at slot 10 is higher than the highest signed proposal slot", issues[2].Reason)
+	assert.Equal(t, "lowest signed proposal slot without a highest signed proposal slot", issues[3].Reason)
+	for _, issue := range issues {
+		assert.DeepEqual(t, pubKey[:], issue.Key)
+	}
+}
+
+func TestStore_CheckSlashingProtection_LegacyNotMigrated(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	db := setupDB(t, [][48]byte{pubKey})
+
+	require.NoError(t, db.SaveProposalHistoryForEpoch(ctx, pubKey[:], 1, []byte{0x01, 0x00, 0x00, 0x00, 0x01}))
+
+	issues, err := db.CheckSlashingProtection(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(issues), "Unexpected issues %v", issues)
+	assert.Equal(t, string(historicProposalsBucket), issues[0].Bucket)
+	assert.Equal(t, "legacy proposal history was not migrated", issues[0].Reason)
+
+	require.NoError(t, db.MigrateV2ProposalsProtectionDb(ctx))
+	issues, err = db.CheckSlashingProtection(ctx)
+	require.NoError(t, err)
+	for _, issue := range issues {
+		assert.NotEqual(t, string(historicProposalsBucket), issue.Bucket, "Unexpected issue %v", issue)
+	}
+}
@@ -0,0 +1,313 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// PruneReport describes the slashing protection history removed by pruning.
+type PruneReport struct {
+	// Epoch the weak subjectivity period is counted back from, the latest epoch signed by any key.
+	ReferenceEpoch uint64
+	// Keys whose attestation history was entirely older than the weak subjectivity period and was
+	// collapsed into their highest signed source and target epochs.
+	CollapsedAttestationHistories int
+	// Signed proposals older than the weak subjectivity period removed from the proposal histories.
+	PrunedProposals int
+	// Keys whose histories in the legacy format, already migrated, were removed.
+	RemovedLegacyAttestationHistories int
+	RemovedLegacyProposalHistories    int
+}
+
+// PruneSlashingProtection collapses the slashing protection history older than the weak subjectivity
+// period into the lowest and highest signed watermarks of each key, and removes the histories in the
+// legacy format once they have been migrated. The watermarks are raised to cover everything removed,
+// so signing anything at or below them is still refused and the protection is as safe as before.
+func (store *Store) PruneSlashingProtection(ctx context.Context) (*PruneReport, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.PruneSlashingProtection")
+	defer span.End()
+
+	report := &PruneReport{}
+	err := store.update(func(tx *bolt.Tx) error {
+		referenceEpoch, err := latestSignedEpoch(ctx, tx)
+		if err != nil {
+			return err
+		}
+		report.ReferenceEpoch = referenceEpoch
+		if report.CollapsedAttestationHistories, err = collapseAttestationHistories(ctx, tx, referenceEpoch); err != nil {
+			return errors.Wrap(err, "could not collapse attestation histories")
+		}
+		if report.PrunedProposals, err = pruneProposalHistories(tx); err != nil {
+			return errors.Wrap(err, "could not prune proposal histories")
+		}
+		if report.RemovedLegacyAttestationHistories, err = removeLegacyAttestationHistories(tx); err != nil {
+			return errors.Wrap(err, "could not remove legacy attestation histories")
+		}
+		if report.RemovedLegacyProposalHistories, err = removeLegacyProposalHistories(tx); err != nil {
+			return errors.Wrap(err, "could not remove legacy proposal histories")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// latestSignedEpoch returns the latest epoch of an attestation or proposal signed by any key.
+func latestSignedEpoch(ctx context.Context, tx *bolt.Tx) (uint64, error) {
+	var latest uint64
+	if err := tx.Bucket(newHistoricAttestationsBucket).ForEach(func(k, v []byte) error {
+		history := EncHistoryData(v)
+		if history.assertSize() != nil {
+			return nil
+		}
+		epoch, err := history.GetLatestEpochWritten(ctx)
+		if err != nil {
+			return err
+		}
+		if epoch > latest {
+			latest = epoch
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	proposalsBkt := tx.Bucket(newHistoricProposalsBucket)
+	if err := proposalsBkt.ForEach(func(k, v []byte) error {
+		valBucket := proposalsBkt.Bucket(k)
+		if v != nil || valBucket == nil {
+			return nil
+		}
+		if slotKey, _ := valBucket.Cursor().Last(); len(slotKey) == 8 {
+			if epoch := helpers.SlotToEpoch(bytesutil.BytesToUint64BigEndian(slotKey)); epoch > latest {
+				latest = epoch
+			}
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	return latest, nil
+}
+
+// collapseAttestationHistories removes the attestation histories whose latest epoch written is a weak
+// subjectivity period or more before the reference epoch, after raising the highest signed source and
+// target epochs of their keys to the highest source and target epochs in the history.
+func collapseAttestationHistories(ctx context.Context, tx *bolt.Tx, referenceEpoch uint64) (int, error) {
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	bkt := tx.Bucket(newHistoricAttestationsBucket)
+	var collapsed [][]byte
+	if err := bkt.ForEach(func(k, v []byte) error {
+		history := EncHistoryData(v)
+		if len(k) != 48 || history.assertSize() != nil {
+			return nil
+		}
+		latest, err := history.GetLatestEpochWritten(ctx)
+		if err != nil {
+			return err
+		}
+		if latest+wsPeriod > referenceEpoch {
+			return nil
+		}
+		entries := uint64(len(history)-latestEpochWrittenSize) / historySize
+		for i := uint64(0); i < entries; i++ {
+			data, err := history.GetTargetData(ctx, i)
+			if err != nil {
+				return err
+			}
+			distance := (latest%wsPeriod + wsPeriod - i) % wsPeriod
+			if isUnwrittenHistoryData(data) || distance > latest {
+				continue
+			}
+			if err := raiseWatermark(tx.Bucket(highestSignedSourceBucket), k, data.Source); err != nil {
+				return err
+			}
+			if err := raiseWatermark(tx.Bucket(highestSignedTargetBucket), k, latest-distance); err != nil {
+				return err
+			}
+		}
+		collapsed = append(collapsed, bytesutil.SafeCopyBytes(k))
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	for _, k := range collapsed {
+		if err := bkt.Delete(k); err != nil {
+			return 0, err
+		}
+	}
+	return len(collapsed), nil
+}
+
+// pruneProposalHistories removes the signed proposals older than the weak subjectivity period from
+// the latest proposal of their key, as done when saving proposals, and repairs the lowest and highest
+// signed proposal slots of the key so that they cover every proposal of the history.
+func pruneProposalHistories(tx *bolt.Tx) (int, error) {
+	bkt := tx.Bucket(newHistoricProposalsBucket)
+	lowestBkt := tx.Bucket(lowestSignedProposalsBucket)
+	highestBkt := tx.Bucket(highestSignedProposalsBucket)
+	var pruned int
+	err := bkt.ForEach(func(k, v []byte) error {
+		valBucket := bkt.Bucket(k)
+		if v != nil || valBucket == nil || len(k) != 48 {
+			return nil
+		}
+		c := valBucket.Cursor()
+		first, _ := c.First()
+		last, _ := c.Last()
+		if len(first) != 8 || len(last) != 8 {
+			return nil
+		}
+		if err := lowerWatermark(lowestBkt, k, bytesutil.BytesToUint64BigEndian(first)); err != nil {
+			return err
+		}
+		if err := raiseWatermark(highestBkt, k, bytesutil.BytesToUint64BigEndian(last)); err != nil {
+			return err
+		}
+		before := countKeys(valBucket)
+		if err := pruneProposalHistoryBySlot(valBucket, bytesutil.BytesToUint64BigEndian(last)); err != nil {
+			return err
+		}
+		pruned += before - countKeys(valBucket)
+		return nil
+	})
+	return pruned, err
+}
+
+// removeLegacyAttestationHistories removes the attestation histories in the legacy format once they
+// have been migrated, after raising the highest signed source and target epochs of their keys to the
+// highest source and target epochs in the history.
+func removeLegacyAttestationHistories(tx *bolt.Tx) (int, error) {
+	bkt := tx.Bucket(historicAttestationsBucket)
+	if bkt.Get([]byte(attestationExported)) == nil {
+		return 0, nil
+	}
+	var removed [][]byte
+	if err := bkt.ForEach(func(k, v []byte) error {
+		if bytes.Equal(k, []byte(attestationExported)) || len(k) != 48 {
+			return nil
+		}
+		history := &slashpb.AttestationHistory{}
+		if err := proto.Unmarshal(v, history); err != nil {
+			return nil
+		}
+		for target, source := range history.TargetToSource {
+			if source == params.BeaconConfig().FarFutureEpoch {
+				continue
+			}
+			if err := raiseWatermark(tx.Bucket(highestSignedSourceBucket), k, source); err != nil {
+				return err
+			}
+			if err := raiseWatermark(tx.Bucket(highestSignedTargetBucket), k, target); err != nil {
+				return err
+			}
+		}
+		removed = append(removed, bytesutil.SafeCopyBytes(k))
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	for _, k := range removed {
+		if err := bkt.Delete(k); err != nil {
+			return 0, err
+		}
+	}
+	return len(removed), nil
+}
+
+// removeLegacyProposalHistories empties the proposal histories in the legacy format once they have
+// been migrated, after extending the lowest and highest signed proposal slots of their keys to the
+// proposals in the history.
+func removeLegacyProposalHistories(tx *bolt.Tx) (int, error) {
+	bkt := tx.Bucket(historicProposalsBucket)
+	if bkt.Get([]byte(proposalExported)) == nil {
+		return 0, nil
+	}
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	var removed [][]byte
+	if err := bkt.ForEach(func(k, v []byte) error {
+		valBucket := bkt.Bucket(k)
+		if v != nil || valBucket == nil || len(k) != 48 {
+			return nil
+		}
+		if first, _ := valBucket.Cursor().First(); first == nil {
+			return nil
+		}
+		if err := valBucket.ForEach(func(epochKey, slotBits []byte) error {
+			if len(epochKey) != 8 {
+				return nil
+			}
+			epoch := binary.LittleEndian.Uint64(epochKey)
+			// Adding an extra byte for the bitlist length.
+			slotBitlist := make(bitfield.Bitlist, slotsPerEpoch/8+1)
+			copy(slotBitlist, slotBits)
+			for i := uint64(0); i < slotsPerEpoch; i++ {
+				if !slotBitlist.BitAt(i) {
+					continue
+				}
+				slot := epoch*slotsPerEpoch + i
+				if err := lowerWatermark(tx.Bucket(lowestSignedProposalsBucket), k, slot); err != nil {
+					return err
+				}
+				if err := raiseWatermark(tx.Bucket(highestSignedProposalsBucket), k, slot); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		removed = append(removed, bytesutil.SafeCopyBytes(k))
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	// The buckets of the keys are kept empty, as they are expected to exist for every key.
+	for _, k := range removed {
+		if err := bkt.DeleteBucket(k); err != nil {
+			return 0, err
+		}
+		if _, err := bkt.CreateBucket(k); err != nil {
+			return 0, err
+		}
+	}
+	return len(removed), nil
+}
+
+// raiseWatermark sets the watermark of a public key to the given value if it is higher or unset.
+func raiseWatermark(bkt *bolt.Bucket, pubKey []byte, value uint64) error {
+	if current, ok := watermark(bkt, pubKey); ok && current >= value {
+		return nil
+	}
+	return bkt.Put(pubKey, bytesutil.Uint64ToBytesBigEndian(value))
+}
+
+// lowerWatermark sets the watermark of a public key to the given value if it is lower or unset.
+func lowerWatermark(bkt *bolt.Bucket, pubKey []byte, value uint64) error {
+	if current, ok := watermark(bkt, pubKey); ok && current <= value {
+		return nil
+	}
+	return bkt.Put(pubKey, bytesutil.Uint64ToBytesBigEndian(value))
+}
+
+// countKeys returns the number of keys of a bucket, including the changes of the current transaction.
+func countKeys(bkt *bolt.Bucket) int {
+	var n int
+	c := bkt.Cursor()
+	for k, _ := c.First(); k != nil; k, _ = c.Next() {
+		n++
+	}
+	return n
+}
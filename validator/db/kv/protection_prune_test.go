@@ -0,0 +1,128 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	slashpb "github.com/prysmaticlabs/prysm/proto/slashing"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_PruneSlashingProtection_CollapsesAttestationHistories(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	config := params.BeaconConfig()
+	config.WeakSubjectivityPeriod = 16
+	params.OverrideBeaconConfig(config)
+	ctx := context.Background()
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	oldKey, recentKey := [48]byte{1}, [48]byte{2}
+	db := setupDB(t, [][48]byte{oldKey, recentKey})
+
+	oldHistory, err := MarkAllAsAttestedSinceLatestWrittenEpoch(ctx, NewAttestationHistoryArray(0), 3, &HistoryData{
+		Source:      2,
+		SigningRoot: bytesutil.PadTo([]byte{1}, 32),
+	})
+	require.NoError(t, err)
+	oldHistory, err = MarkAllAsAttestedSinceLatestWrittenEpoch(ctx, oldHistory, 4, &HistoryData{
+		Source:      3,
+		SigningRoot: bytesutil.PadTo([]byte{2}, 32),
+	})
+	require.NoError(t, err)
+	recentHistory, err := MarkAllAsAttestedSinceLatestWrittenEpoch(ctx, NewAttestationHistoryArray(0), wsPeriod+4, &HistoryData{
+		Source:      wsPeriod + 3,
+		SigningRoot: bytesutil.PadTo([]byte{3}, 32),
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.SaveAttestationHistoryForPubKeyV2(ctx, oldKey, oldHistory))
+	require.NoError(t, db.SaveAttestationHistoryForPubKeyV2(ctx, recentKey, recentHistory))
+
+	report, err := db.PruneSlashingProtection(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, wsPeriod+4, report.ReferenceEpoch)
+	assert.Equal(t, 1, report.CollapsedAttestationHistories)
+
+	histories, err := db.AttestationHistoryForPubKeysV2(ctx, [][48]byte{oldKey, recentKey})
+	require.NoError(t, err)
+	assert.DeepEqual(t, NewAttestationHistoryArray(0), histories[oldKey], "Expected history to be removed")
+	assert.DeepEqual(t, recentHistory, histories[recentKey], "Expected history to be kept")
+	source, err := db.HighestSignedSourceEpoch(ctx, oldKey)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), source)
+	target, err := db.HighestSignedTargetEpoch(ctx, oldKey)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), target)
+
+	issues, err := db.CheckSlashingProtection(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(issues), "Unexpected issues %v", issues)
+}
+
+func TestStore_PruneSlashingProtection_ProposalHistories(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	db := setupDB(t, [][48]byte{pubKey})
+
+	require.NoError(t, db.SaveProposalHistoryForEpoch(ctx, pubKey[:], 1, []byte{0x01, 0x00, 0x00, 0x00, 0x01}))
+	require.NoError(t, db.MigrateV2ProposalsProtectionDb(ctx))
+	require.NoError(t, db.SaveProposalHistoryForSlot(ctx, pubKey, 40, bytesutil.PadTo([]byte{1}, 32)))
+
+	report, err := db.PruneSlashingProtection(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, report.PrunedProposals)
+	assert.Equal(t, 1, report.RemovedLegacyProposalHistories)
+
+	// The proposal migrated from the legacy history at slot 32 is kept and covered by the watermarks.
+	_, exists, err := db.ProposalHistoryForSlot(ctx, pubKey, 32)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	lowest, err := db.LowestSignedProposal(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(32), lowest)
+	highest, err := db.HighestSignedProposal(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(40), highest)
+	slotBits, err := db.ProposalHistoryForEpoch(ctx, pubKey[:], 1)
+	require.NoError(t, err)
+	assert.Equal(t, false, slotBits.BitAt(0), "Expected legacy history to be removed")
+
+	issues, err := db.CheckSlashingProtection(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(issues), "Unexpected issues %v", issues)
+}
+
+func TestStore_PruneSlashingProtection_LegacyAttestationHistories(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	db := setupDB(t, [][48]byte{pubKey})
+
+	legacy := map[[48]byte]*slashpb.AttestationHistory{
+		pubKey: {
+			TargetToSource:     map[uint64]uint64{5: 4, 6: params.BeaconConfig().FarFutureEpoch},
+			LatestEpochWritten: 6,
+		},
+	}
+	require.NoError(t, db.SaveAttestationHistoryForPubKeys(ctx, legacy))
+
+	// Legacy histories are kept until they are migrated.
+	report, err := db.PruneSlashingProtection(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, report.RemovedLegacyAttestationHistories)
+
+	require.NoError(t, db.MigrateV2AttestationProtectionDb(ctx))
+	report, err = db.PruneSlashingProtection(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, report.RemovedLegacyAttestationHistories)
+	histories, err := db.AttestationHistoryForPubKeys(ctx, [][48]byte{pubKey})
+	require.NoError(t, err)
+	_, ok := histories[pubKey].TargetToSource[5]
+	assert.Equal(t, false, ok, "Expected legacy history to be removed")
+	source, err := db.HighestSignedSourceEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), source)
+	target, err := db.HighestSignedTargetEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), target)
+}
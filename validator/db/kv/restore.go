@@ -0,0 +1,105 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/dbutil"
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// restoreRequiredBuckets are the buckets a backup must contain to be restored. The slashing
+// protection history is only read from the interchange format buckets.
+var restoreRequiredBuckets = [][]byte{
+	genesisInfoBucket,
+	newHistoricProposalsBucket,
+	newHistoricAttestationsBucket,
+}
+
+// Restore replaces the validator database in the given directory with a backup of it. The backup
+// must contain the slashing protection buckets of the current schema. If the directory already
+// holds a database for a chain, the backup must be of the same genesis validators root and must not
+// roll back the highest signed source and target epochs or proposal slot of any validator. The
+// database must not be opened by a running validator while restoring.
+func Restore(ctx context.Context, backupPath, dirPath string) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.Db.Restore")
+	defer span.End()
+
+	dbPath := filepath.Join(dirPath, ProtectionDbFileName)
+	err := dbutil.Restore(backupPath, dbPath, restoreRequiredBuckets, func(backupTx, existingTx *bolt.Tx) error {
+		if existingTx == nil {
+			return nil
+		}
+		backupRoot := genesisValidatorsRootOf(backupTx)
+		existingRoot := genesisValidatorsRootOf(existingTx)
+		if len(existingRoot) != 0 && !bytes.Equal(backupRoot, existingRoot) {
+			return errors.Errorf(
+				"backup genesis validators root %#x does not match the existing database %#x",
+				backupRoot,
+				existingRoot,
+			)
+		}
+		for _, name := range watermarkBuckets {
+			if err := verifyWatermarks(name, backupTx, existingTx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	logrus.WithFields(logrus.Fields{
+		"backup": backupPath,
+		"path":   dbPath,
+	}).Info("Restored validator database from backup")
+	return nil
+}
+
+// watermarkBuckets hold the highest signed epochs and slots of the validators, keyed by public key.
+var watermarkBuckets = [][]byte{
+	highestSignedSourceBucket,
+	highestSignedTargetBucket,
+	highestSignedProposalsBucket,
+}
+
+func genesisValidatorsRootOf(tx *bolt.Tx) []byte {
+	if bkt := tx.Bucket(genesisInfoBucket); bkt != nil {
+		return bkt.Get(genesisValidatorsRootKey)
+	}
+	return nil
+}
+
+// verifyWatermarks checks that the backup is not behind the existing database in the given
+// watermark bucket, as restoring it would allow a validator to sign a slashable message.
+func verifyWatermarks(name []byte, backupTx, existingTx *bolt.Tx) error {
+	existing := existingTx.Bucket(name)
+	if existing == nil {
+		return nil
+	}
+	backup := backupTx.Bucket(name)
+	return existing.ForEach(func(pubKey, enc []byte) error {
+		if len(enc) < 8 {
+			return nil
+		}
+		existingValue := bytesutil.BytesToUint64BigEndian(enc)
+		var backupEnc []byte
+		if backup != nil {
+			backupEnc = backup.Get(pubKey)
+		}
+		if len(backupEnc) < 8 || bytesutil.BytesToUint64BigEndian(backupEnc) < existingValue {
+			return errors.Errorf(
+				"backup is older than the existing database, %s of validator %#x is %d in the existing database",
+				name,
+				bytesutil.Trunc(pubKey),
+				existingValue,
+			)
+		}
+		return nil
+	})
+}
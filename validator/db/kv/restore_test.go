@@ -0,0 +1,102 @@
+package kv
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestRestore(t *testing.T) {
+	ctx := context.Background()
+	backupDir := t.TempDir()
+	backup, err := NewKVStore(backupDir, nil)
+	require.NoError(t, err)
+	require.NoError(t, backup.SaveGenesisValidatorsRoot(ctx, []byte{1}))
+	require.NoError(t, backup.Close())
+	backupPath := filepath.Join(backupDir, ProtectionDbFileName)
+
+	// Restore into an empty directory and over an existing database of the same chain.
+	targetDir := filepath.Join(t.TempDir(), "validator")
+	require.NoError(t, Restore(ctx, backupPath, targetDir))
+	require.NoError(t, Restore(ctx, backupPath, targetDir))
+	restored, err := GetKVStore(targetDir)
+	require.NoError(t, err)
+	root, err := restored.GenesisValidatorsRoot(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{1}, root)
+
+	assert.ErrorContains(t, "cannot obtain database lock", Restore(ctx, backupPath, targetDir))
+	require.NoError(t, restored.Close())
+}
+
+func TestRestore_DifferentChain(t *testing.T) {
+	ctx := context.Background()
+	backupDir := t.TempDir()
+	backup, err := NewKVStore(backupDir, nil)
+	require.NoError(t, err)
+	require.NoError(t, backup.SaveGenesisValidatorsRoot(ctx, []byte{1}))
+	require.NoError(t, backup.Close())
+
+	existing := setupDB(t, nil)
+	require.NoError(t, existing.SaveGenesisValidatorsRoot(ctx, []byte{2}))
+	require.NoError(t, existing.Close())
+
+	err = Restore(ctx, filepath.Join(backupDir, ProtectionDbFileName), existing.databasePath)
+	assert.ErrorContains(t, "does not match the existing database", err)
+}
+
+func TestRestore_OlderBackup(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	backupDir := t.TempDir()
+	backup, err := NewKVStore(backupDir, [][48]byte{pubKey})
+	require.NoError(t, err)
+	require.NoError(t, backup.SaveGenesisValidatorsRoot(ctx, []byte{1}))
+	require.NoError(t, backup.SaveHighestSignedSourceEpoch(ctx, pubKey, 3))
+	require.NoError(t, backup.SaveHighestSignedTargetEpoch(ctx, pubKey, 4))
+	require.NoError(t, backup.SaveProposalHistoryForSlot(ctx, pubKey, 40, []byte{1}))
+	require.NoError(t, backup.Close())
+	backupPath := filepath.Join(backupDir, ProtectionDbFileName)
+
+	existing := setupDB(t, [][48]byte{pubKey})
+	require.NoError(t, existing.SaveGenesisValidatorsRoot(ctx, []byte{1}))
+	require.NoError(t, existing.SaveHighestSignedSourceEpoch(ctx, pubKey, 3))
+	require.NoError(t, existing.SaveHighestSignedTargetEpoch(ctx, pubKey, 5))
+	require.NoError(t, existing.SaveProposalHistoryForSlot(ctx, pubKey, 40, []byte{1}))
+	require.NoError(t, existing.Close())
+
+	err = Restore(ctx, backupPath, existing.databasePath)
+	assert.ErrorContains(t, "backup is older than the existing database", err)
+	assert.ErrorContains(t, string(highestSignedTargetBucket), err)
+
+	// A backup which is at least as recent as the existing database can be restored.
+	db, err := bolt.Open(filepath.Join(existing.databasePath, ProtectionDbFileName), params.BeaconIoConfig().ReadWritePermissions, nil)
+	require.NoError(t, err)
+	require.NoError(t, db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(highestSignedTargetBucket).Put(pubKey[:], bytesutil.Uint64ToBytesBigEndian(4))
+	}))
+	require.NoError(t, db.Close())
+	require.NoError(t, Restore(ctx, backupPath, existing.databasePath))
+}
+
+func TestRestore_MissingBucket(t *testing.T) {
+	backupPath := filepath.Join(t.TempDir(), ProtectionDbFileName)
+	db, err := bolt.Open(backupPath, params.BeaconIoConfig().ReadWritePermissions, nil)
+	require.NoError(t, err)
+	require.NoError(t, db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucket(genesisInfoBucket)
+		return err
+	}))
+	require.NoError(t, db.Close())
+
+	targetDir := t.TempDir()
+	assert.ErrorContains(t, "missing bucket", Restore(context.Background(), backupPath, targetDir))
+	assert.Equal(t, false, fileutil.FileExists(filepath.Join(targetDir, ProtectionDbFileName)))
+}
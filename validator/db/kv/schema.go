@@ -21,6 +21,12 @@ var (
 	lowestSignedProposalsBucket  = []byte("lowest-signed-proposals-bucket")
 	highestSignedProposalsBucket = []byte("highest-signed-proposals-bucket")
 
+	// Signed voluntary exits of validators, keyed by public key.
+	voluntaryExitsBucket = []byte("voluntary-exits-bucket")
+
+	// Performance of validators per epoch, keyed by public key and epoch.
+	validatorPerformanceBucket = []byte("validator-performance-bucket")
+
 	// Genesis validators root bucket key.
 	genesisValidatorsRootKey = []byte("genesis-val-root")
 )
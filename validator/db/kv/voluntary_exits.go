@@ -0,0 +1,93 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// VoluntaryExit is a signed voluntary exit of a validator key, kept in the database so that
+// scheduled exits can be submitted later and submitted exits can be tracked until the validator
+// is withdrawable.
+type VoluntaryExit struct {
+	PublicKey  [48]byte
+	SignedExit *ethpb.SignedVoluntaryExit
+	Submitted  bool
+}
+
+// VoluntaryExits retrieves all voluntary exits in the database, ordered by public key.
+func (store *Store) VoluntaryExits(ctx context.Context) ([]*VoluntaryExit, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.VoluntaryExits")
+	defer span.End()
+
+	exits := make([]*VoluntaryExit, 0)
+	err := store.view(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(voluntaryExitsBucket)
+		return bucket.ForEach(func(key []byte, enc []byte) error {
+			exit, err := decodeVoluntaryExit(enc)
+			if err != nil {
+				return errors.Wrapf(err, "could not decode voluntary exit for public key %#x", key)
+			}
+			exit.PublicKey = bytesutil.ToBytes48(key)
+			exits = append(exits, exit)
+			return nil
+		})
+	})
+	return exits, err
+}
+
+// SaveVoluntaryExits saves the voluntary exits, replacing any existing exit of their public keys.
+func (store *Store) SaveVoluntaryExits(ctx context.Context, exits []*VoluntaryExit) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SaveVoluntaryExits")
+	defer span.End()
+
+	return store.update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(voluntaryExitsBucket)
+		for _, exit := range exits {
+			enc, err := encodeVoluntaryExit(exit)
+			if err != nil {
+				return errors.Wrapf(err, "could not encode voluntary exit for public key %#x", exit.PublicKey)
+			}
+			if err := bucket.Put(exit.PublicKey[:], enc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// A voluntary exit is encoded as a byte marking whether it was submitted, followed by
+// the serialized signed exit.
+func encodeVoluntaryExit(exit *VoluntaryExit) ([]byte, error) {
+	if exit.SignedExit == nil || exit.SignedExit.Exit == nil {
+		return nil, errors.New("nil signed voluntary exit")
+	}
+	enc, err := exit.SignedExit.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	var submitted byte
+	if exit.Submitted {
+		submitted = 1
+	}
+	return append([]byte{submitted}, enc...), nil
+}
+
+func decodeVoluntaryExit(enc []byte) (*VoluntaryExit, error) {
+	if len(enc) < 1 {
+		return nil, fmt.Errorf("wanted at least 1 byte, received %d", len(enc))
+	}
+	signedExit := &ethpb.SignedVoluntaryExit{}
+	if err := signedExit.Unmarshal(enc[1:]); err != nil {
+		return nil, err
+	}
+	return &VoluntaryExit{
+		SignedExit: signedExit,
+		Submitted:  enc[0] == 1,
+	}, nil
+}
@@ -0,0 +1,51 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_VoluntaryExits_ReadAndWrite(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t, [][48]byte{})
+
+	exits, err := db.VoluntaryExits(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(exits))
+
+	scheduled := &VoluntaryExit{
+		PublicKey: [48]byte{2},
+		SignedExit: &ethpb.SignedVoluntaryExit{
+			Exit:      &ethpb.VoluntaryExit{Epoch: 10, ValidatorIndex: 2},
+			Signature: make([]byte, 96),
+		},
+	}
+	submitted := &VoluntaryExit{
+		PublicKey: [48]byte{1},
+		SignedExit: &ethpb.SignedVoluntaryExit{
+			Exit:      &ethpb.VoluntaryExit{Epoch: 5, ValidatorIndex: 1},
+			Signature: make([]byte, 96),
+		},
+		Submitted: true,
+	}
+	require.NoError(t, db.SaveVoluntaryExits(ctx, []*VoluntaryExit{scheduled, submitted}))
+	exits, err = db.VoluntaryExits(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(exits))
+	assert.DeepEqual(t, submitted, exits[0])
+	assert.DeepEqual(t, scheduled, exits[1])
+
+	// Saving the exit of a public key again replaces it.
+	scheduled.Submitted = true
+	require.NoError(t, db.SaveVoluntaryExits(ctx, []*VoluntaryExit{scheduled}))
+	exits, err = db.VoluntaryExits(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(exits))
+	assert.Equal(t, true, exits[1].Submitted)
+
+	assert.ErrorContains(t, "nil signed voluntary exit", db.SaveVoluntaryExits(ctx, []*VoluntaryExit{{PublicKey: [48]byte{3}}}))
+}
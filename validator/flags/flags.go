@@ -28,8 +28,9 @@ var (
 	}
 	// BeaconRPCProviderFlag defines a beacon node RPC endpoint.
 	BeaconRPCProviderFlag = &cli.StringFlag{
-		Name:  "beacon-rpc-provider",
-		Usage: "Beacon node RPC provider endpoint",
+		Name: "beacon-rpc-provider",
+		Usage: "Beacon node RPC provider endpoint. A comma-separated list of endpoints makes the validator client " +
+			"route its requests to the healthiest beacon node, preferring the endpoints in the order they are listed",
 		Value: "127.0.0.1:4000",
 	}
 	// BeaconRPCGatewayProviderFlag defines a beacon node JSON-RPC endpoint.
@@ -82,6 +83,47 @@ var (
 		Name:  "graffiti",
 		Usage: "String to include in proposed blocks",
 	}
+	// ProposerConfigFileFlag defines the path to a file configuring the graffiti and duties of each validating key.
+	ProposerConfigFileFlag = &cli.StringFlag{
+		Name: "proposer-config-file",
+		Usage: "Path to a YAML or JSON file configuring the graffiti, and whether to sign, attest and propose, " +
+			"for each validating key. Changes to the file are applied without restarting the validator client",
+	}
+	// EnableDoppelgangerProtectionFlag enables checking whether the validating keys are already
+	// active elsewhere before the validator client starts signing with them.
+	EnableDoppelgangerProtectionFlag = &cli.BoolFlag{
+		Name: "enable-doppelganger-protection",
+		Usage: "Watches the chain for activity of the validating keys for a number of epochs before " +
+			"signing with them, and never signs with keys found to be validating elsewhere. Delays " +
+			"the first duties of the validators by the watched epochs",
+	}
+	// DoppelgangerEpochsFlag defines the number of epochs to watch for activity of the validating keys.
+	DoppelgangerEpochsFlag = &cli.Uint64Flag{
+		Name:  "doppelganger-epochs",
+		Usage: "Number of epochs to watch for activity of the validating keys when doppelganger protection is enabled",
+		Value: 2,
+	}
+	// BeaconNodeMaxSlotLagFlag defines how many slots the head of a beacon node may be behind the current
+	// slot before the validator client considers the beacon node unhealthy.
+	BeaconNodeMaxSlotLagFlag = &cli.Uint64Flag{
+		Name: "beacon-node-max-slot-lag",
+		Usage: "Number of slots the head of a beacon node may be behind the current slot before the validator " +
+			"client fails over to another beacon node",
+		Value: 8,
+	}
+	// BeaconNodeMinPeersFlag defines the number of peers a beacon node needs for the validator client
+	// to consider it healthy.
+	BeaconNodeMinPeersFlag = &cli.Uint64Flag{
+		Name:  "beacon-node-min-peers",
+		Usage: "Number of peers a beacon node needs before the validator client fails over to another beacon node",
+		Value: 1,
+	}
+	// EnableBeaconNodeBroadcastFlag enables publishing signed objects to all healthy beacon nodes.
+	EnableBeaconNodeBroadcastFlag = &cli.BoolFlag{
+		Name: "enable-beacon-node-broadcast",
+		Usage: "Publishes signed blocks, attestations and aggregates to all healthy beacon nodes listed in " +
+			"--beacon-rpc-provider concurrently, while duties are still fetched from a single beacon node",
+	}
 	// GrpcRetriesFlag defines the number of times to retry a failed gRPC request.
 	GrpcRetriesFlag = &cli.UintFlag{
 		Name:  "grpc-retries",
@@ -258,10 +300,37 @@ var (
 		Usage: "/path/to/ca.crt for establishing a secure, TLS gRPC connection to a remote signer server",
 		Value: "",
 	}
+	// Web3SignerURLFlag defines the base URL of a Web3Signer compatible remote signer for a wallet
+	// to sign with via HTTP.
+	Web3SignerURLFlag = &cli.StringFlag{
+		Name:  "web3signer-url",
+		Usage: "Base URL of a remote signer implementing the Web3Signer HTTP API, such as http://localhost:9000",
+		Value: "",
+	}
+	// Web3SignerTimeoutFlag defines the timeout of a single request to a Web3Signer compatible remote signer.
+	Web3SignerTimeoutFlag = &cli.DurationFlag{
+		Name:  "web3signer-timeout",
+		Usage: "Timeout of a single request to the remote signer. Signing fails once all retries timed out",
+		Value: 1 * time.Second,
+	}
+	// Web3SignerMaxRetriesFlag defines the number of retries of a failed request to a Web3Signer
+	// compatible remote signer.
+	Web3SignerMaxRetriesFlag = &cli.IntFlag{
+		Name:  "web3signer-max-retries",
+		Usage: "Number of retries of a request to the remote signer which timed out or failed with a server error",
+		Value: 1,
+	}
+	// Web3SignerRefreshIntervalFlag defines how often the public keys of a Web3Signer compatible remote
+	// signer are refreshed.
+	Web3SignerRefreshIntervalFlag = &cli.DurationFlag{
+		Name:  "web3signer-refresh-interval",
+		Usage: "Interval at which the public keys of the remote signer are refreshed",
+		Value: 1 * time.Minute,
+	}
 	// KeymanagerKindFlag defines the kind of keymanager desired by a user during wallet creation.
 	KeymanagerKindFlag = &cli.StringFlag{
 		Name:  "keymanager-kind",
-		Usage: "Kind of keymanager, either imported, derived, or remote, specified during wallet creation",
+		Usage: "Kind of keymanager, either imported, derived, remote or web3signer, specified during wallet creation",
 		Value: "",
 	}
 	// SkipDepositConfirmationFlag skips the y/n confirmation prompt for sending a deposit to the deposit contract.
@@ -276,6 +345,97 @@ var (
 		Usage: "Enables the web portal for the validator client (work in progress)",
 		Value: false,
 	}
+	// MigratePublicKeysFlag defines a comma-separated list of hex string public keys
+	// for accounts which a user desires to migrate to another wallet.
+	MigratePublicKeysFlag = &cli.StringFlag{
+		Name:  "migrate-public-keys",
+		Usage: "Comma-separated list of public key hex strings to specify which validator accounts to migrate",
+		Value: "",
+	}
+	// MigrateTargetWalletDirFlag defines the wallet to which accounts are migrated.
+	MigrateTargetWalletDirFlag = &cli.StringFlag{
+		Name:  "migrate-target-wallet-dir",
+		Usage: "Path to the wallet to which the validator accounts are migrated",
+		Value: "",
+	}
+	// MigrateTargetWalletPasswordFileFlag is the path to a file containing the password of the target wallet.
+	MigrateTargetWalletPasswordFileFlag = &cli.StringFlag{
+		Name:  "migrate-target-wallet-password-file",
+		Usage: "Path to a plain-text, .txt file containing the password of the target wallet",
+	}
+	// MigrateTargetDataDirFlag defines the directory of the validator database of the target wallet.
+	MigrateTargetDataDirFlag = &cli.StringFlag{
+		Name:  "migrate-target-datadir",
+		Usage: "Directory of the validator database of the target wallet, which is the wallet's accounts directory by default",
+		Value: "",
+	}
+	// ExitPublicKeysFileFlag is the path to a file listing the public keys of the accounts to exit in bulk.
+	ExitPublicKeysFileFlag = &cli.StringFlag{
+		Name: "exit-public-keys-file",
+		Usage: "Path to a file with one public key hex string per line to specify on which validator accounts " +
+			"to perform a bulk voluntary exit. Empty lines and lines starting with # are ignored",
+		Value: "",
+	}
+	// ExitEpochFlag defines the epoch for which bulk voluntary exits are signed and submitted.
+	ExitEpochFlag = &cli.Uint64Flag{
+		Name: "exit-epoch",
+		Usage: "Epoch for which the voluntary exits are signed. Exits for a future epoch are kept in the " +
+			"validator database, and the command waits until the epoch is reached to submit them. Defaults " +
+			"to the current epoch",
+		Value: 0,
+	}
+	// ExitSubmissionIntervalFlag defines the delay between the submissions of bulk voluntary exits.
+	ExitSubmissionIntervalFlag = &cli.DurationFlag{
+		Name:  "exit-submission-interval",
+		Usage: "Time to wait between the submissions of two voluntary exits to the beacon node",
+		Value: time.Second,
+	}
+	// ForceExitFlag skips the confirmation prompt of a bulk voluntary exit.
+	ForceExitFlag = &cli.BoolFlag{
+		Name:  "force-exit",
+		Usage: "Perform the bulk voluntary exit without asking for confirmation",
+		Value: false,
+	}
+	// SlashingProtectionJSONFileFlag is used by the slashing protection import command to read
+	// an EIP-3076 slashing protection JSON file.
+	SlashingProtectionJSONFileFlag = &cli.StringFlag{
+		Name:  "slashing-protection-json-file",
+		Usage: "Path to an EIP-3076 slashing protection JSON file to import",
+	}
+	// SlashingProtectionExportDirFlag is used by the slashing protection export command to write
+	// the EIP-3076 slashing protection JSON file.
+	SlashingProtectionExportDirFlag = &cli.StringFlag{
+		Name:  "slashing-protection-export-dir",
+		Usage: "Directory to which the slashing_protection.json file is exported",
+	}
+	// SlashingProtectionPublicKeysFlag restricts the exported slashing protection data to the given keys.
+	SlashingProtectionPublicKeysFlag = &cli.StringFlag{
+		Name:  "slashing-protection-public-keys",
+		Usage: "Comma-separated list of public key hex strings to export slashing protection data for, all keys by default",
+	}
+	// SlashingProtectionMinimalFlag exports only the highest signed slot and epochs of each key.
+	SlashingProtectionMinimalFlag = &cli.BoolFlag{
+		Name:  "slashing-protection-minimal",
+		Usage: "Exports only the highest signed block and attestation of each key, as in the minimal EIP-3076 format",
+		Value: false,
+	}
+	// SlashingProtectionDryRunFlag reports what an import would change without writing to the database.
+	SlashingProtectionDryRunFlag = &cli.BoolFlag{
+		Name:  "slashing-protection-dry-run",
+		Usage: "Reports the slashing protection data which would be imported without writing to the database",
+		Value: false,
+	}
+	// PerformancePublicKeysFlag restricts the performance summaries to the given keys.
+	PerformancePublicKeysFlag = &cli.StringFlag{
+		Name:  "performance-public-keys",
+		Usage: "Comma-separated list of public key hex strings to summarize the performance of, all keys by default",
+	}
+	// PerformanceDaysFlag defines how many days of performance history are summarized.
+	PerformanceDaysFlag = &cli.Uint64Flag{
+		Name:  "performance-days",
+		Usage: "Number of days up to the latest recorded epoch of each key to summarize the performance of",
+		Value: 7,
+	}
 )
 
 // DefaultValidatorDir returns OS-specific default validator directory.
@@ -117,3 +117,18 @@ func (dr *Keymanager) FetchValidatingPrivateKeys(ctx context.Context) ([][32]byt
 func (dr *Keymanager) DeleteAccounts(ctx context.Context, publicKeys [][]byte) error {
 	return dr.importedKM.DeleteAccounts(ctx, publicKeys)
 }
+
+// DisableAccounts disables public keys from the derived keymanager.
+func (dr *Keymanager) DisableAccounts(ctx context.Context, pubKeys [][]byte) error {
+	return dr.importedKM.DisableAccounts(ctx, pubKeys)
+}
+
+// EnableAccounts enables public keys from the derived keymanager if they are disabled.
+func (dr *Keymanager) EnableAccounts(ctx context.Context, pubKeys [][]byte) error {
+	return dr.importedKM.EnableAccounts(ctx, pubKeys)
+}
+
+// DisabledPublicKeys returns the currently disabled public keys in the derived keymanager.
+func (dr *Keymanager) DisabledPublicKeys() [][]byte {
+	return dr.importedKM.DisabledPublicKeys()
+}
@@ -18,6 +18,12 @@ type IKeymanager interface {
 	Sign(context.Context, *validatorpb.SignRequest) (bls.Signature, error)
 }
 
+// GenesisValidatorsRootSetter is implemented by keymanagers which need the genesis validators
+// root of the chain to sign, such as remote signers which verify the signing root themselves.
+type GenesisValidatorsRootSetter interface {
+	SetGenesisValidatorsRoot(root []byte)
+}
+
 // Keystore json file representation as a Go struct.
 type Keystore struct {
 	Crypto  map[string]interface{} `json:"crypto"`
@@ -38,6 +44,8 @@ const (
 	Derived
 	// Remote keymanager capable of remote-signing data.
 	Remote
+	// Web3Signer keymanager capable of remote-signing data via the Web3Signer HTTP API.
+	Web3Signer
 )
 
 // String marshals a keymanager kind to a string value.
@@ -49,6 +57,8 @@ func (k Kind) String() string {
 		return "direct"
 	case Remote:
 		return "remote"
+	case Web3Signer:
+		return "web3signer"
 	default:
 		return fmt.Sprintf("%d", int(k))
 	}
@@ -63,6 +73,8 @@ func ParseKind(k string) (Kind, error) {
 		return Imported, nil
 	case "remote":
 		return Remote, nil
+	case "web3signer":
+		return Web3Signer, nil
 	default:
 		return 0, fmt.Errorf("%s is not an allowed keymanager", k)
 	}
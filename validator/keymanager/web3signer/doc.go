@@ -0,0 +1,23 @@
+/*
+Package web3signer defines a keymanager implementation which signs via a remote signer
+implementing the Web3Signer eth2 HTTP API. The keymanager never holds private keys: the
+validating public keys are listed by the remote signer and refreshed periodically, and each
+signing request is sent as a typed request containing the object being signed, its fork info
+and its signing root, so the remote signer can verify the signing root and apply its own
+slashing protection before signing.
+
+The remote signer responds to a signing request with the signature, either as plain hex or as
+a JSON object, or denies it with status 412 if signing would be slashable. Timeouts, connection
+errors and server errors are retried up to a configured number of times. Any request which
+does not yield a signature fails the signing operation, and no signature is produced.
+
+The keymanager options are stored in the wallet's keymanageropts.json, for example:
+
+	{
+		"url": "http://localhost:9000",
+		"timeout_ms": 1000,
+		"max_retries": 1,
+		"refresh_interval_secs": 60
+	}
+*/
+package web3signer
@@ -0,0 +1,433 @@
+package web3signer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/logrusorgru/aurora"
+	"github.com/pkg/errors"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/p2putils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+const (
+	publicKeysPath = "/api/v1/eth2/publicKeys"
+	signPath       = "/api/v1/eth2/sign/"
+	// DefaultTimeout of a single request to the remote signer.
+	DefaultTimeout = 1 * time.Second
+	// DefaultMaxRetries of a failed request to the remote signer.
+	DefaultMaxRetries = 1
+	// DefaultRefreshInterval of the public keys of the remote signer.
+	DefaultRefreshInterval = 1 * time.Minute
+	retryDelay             = 100 * time.Millisecond
+)
+
+var (
+	log = logrus.WithField("prefix", "web3signer-keymanager")
+	// ErrSigningFailed defines a failure from the remote signer
+	// when performing a signing operation.
+	ErrSigningFailed = errors.New("signing failed in the remote signer")
+	// ErrSigningDenied defines a signing request which was denied by the
+	// slashing protection of the remote signer.
+	ErrSigningDenied = errors.New("signing request was denied by remote signer")
+	// ErrNoGenesisValidatorsRoot defines a signing request made before the genesis
+	// validators root of the chain is known, which the remote signer needs to verify
+	// the signing root.
+	ErrNoGenesisValidatorsRoot = errors.New("genesis validators root is not known yet")
+)
+
+// KeymanagerOpts for a web3signer keymanager.
+type KeymanagerOpts struct {
+	URL                    string `json:"url"`
+	TimeoutMillis          uint64 `json:"timeout_ms"`
+	MaxRetries             int    `json:"max_retries"`
+	RefreshIntervalSeconds uint64 `json:"refresh_interval_secs"`
+}
+
+// SetupConfig includes configuration values for initializing
+// a keymanager, such as the options and the HTTP client.
+type SetupConfig struct {
+	Opts *KeymanagerOpts
+	// HTTPClient used for requests to the remote signer, http.DefaultClient if nil.
+	HTTPClient *http.Client
+}
+
+// Keymanager implementation which signs via a remote signer implementing the
+// Web3Signer eth2 HTTP API.
+type Keymanager struct {
+	opts                  *KeymanagerOpts
+	baseURL               string
+	httpClient            *http.Client
+	timeout               time.Duration
+	lock                  sync.RWMutex
+	pubKeys               [][48]byte
+	fetched               bool
+	genesisValidatorsRoot []byte
+	accountsChangedFeed   *event.Feed
+}
+
+// NewKeymanager instantiates a new web3signer keymanager from configuration options. The
+// public keys of the remote signer are refreshed in the background until the context is done.
+func NewKeymanager(ctx context.Context, cfg *SetupConfig) (*Keymanager, error) {
+	if cfg.Opts == nil || cfg.Opts.URL == "" {
+		return nil, errors.New("remote signer URL is required")
+	}
+	u, err := url.Parse(cfg.Opts.URL)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse remote signer URL")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("remote signer URL %s must use http or https", cfg.Opts.URL)
+	}
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	timeout := time.Duration(cfg.Opts.TimeoutMillis) * time.Millisecond
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+	refreshInterval := time.Duration(cfg.Opts.RefreshIntervalSeconds) * time.Second
+	if refreshInterval == 0 {
+		refreshInterval = DefaultRefreshInterval
+	}
+	k := &Keymanager{
+		opts:                cfg.Opts,
+		baseURL:             strings.TrimRight(cfg.Opts.URL, "/"),
+		httpClient:          httpClient,
+		timeout:             timeout,
+		accountsChangedFeed: new(event.Feed),
+	}
+	go k.refreshPublicKeys(ctx, refreshInterval)
+	return k, nil
+}
+
+// UnmarshalOptionsFile attempts to JSON unmarshal a keymanager
+// options file into a struct.
+func UnmarshalOptionsFile(r io.ReadCloser) (*KeymanagerOpts, error) {
+	enc, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read config")
+	}
+	defer func() {
+		if err := r.Close(); err != nil {
+			log.Errorf("Could not close keymanager config file: %v", err)
+		}
+	}()
+	opts := &KeymanagerOpts{}
+	if err := json.Unmarshal(enc, opts); err != nil {
+		return nil, errors.Wrap(err, "could not JSON unmarshal")
+	}
+	return opts, nil
+}
+
+// MarshalOptionsFile for the keymanager.
+func MarshalOptionsFile(_ context.Context, cfg *KeymanagerOpts) ([]byte, error) {
+	return json.MarshalIndent(cfg, "", "\t")
+}
+
+// String pretty-print of a web3signer keymanager options.
+func (opts *KeymanagerOpts) String() string {
+	au := aurora.NewAurora(true)
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("%s: %s\n", au.BrightMagenta("Remote signer URL"), opts.URL))
+	b.WriteString(fmt.Sprintf("%s: %dms\n", au.BrightMagenta("Request timeout"), opts.TimeoutMillis))
+	b.WriteString(fmt.Sprintf("%s: %d\n", au.BrightMagenta("Max retries"), opts.MaxRetries))
+	b.WriteString(fmt.Sprintf("%s: %ds\n", au.BrightMagenta("Public keys refresh interval"), opts.RefreshIntervalSeconds))
+	return b.String()
+}
+
+// KeymanagerOpts for the web3signer keymanager.
+func (k *Keymanager) KeymanagerOpts() *KeymanagerOpts {
+	return k.opts
+}
+
+// SetGenesisValidatorsRoot of the chain, which is sent to the remote signer as part of the
+// fork info of each signing request.
+func (k *Keymanager) SetGenesisValidatorsRoot(root []byte) {
+	k.lock.Lock()
+	defer k.lock.Unlock()
+	k.genesisValidatorsRoot = bytesutil.SafeCopyBytes(root)
+}
+
+// SubscribeAccountChanges creates an event subscription for a channel
+// to listen for public key changes at runtime, such as when new
+// keys are added to the remote signer.
+func (k *Keymanager) SubscribeAccountChanges(pubKeysChan chan [][48]byte) event.Subscription {
+	return k.accountsChangedFeed.Subscribe(pubKeysChan)
+}
+
+// FetchValidatingPublicKeys fetches the list of public keys that should be used to validate with.
+// The keys are fetched from the remote signer once and refreshed in the background afterwards.
+func (k *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][48]byte, error) {
+	k.lock.RLock()
+	fetched := k.fetched
+	pubKeys := k.pubKeys
+	k.lock.RUnlock()
+	if fetched {
+		return pubKeys, nil
+	}
+	pubKeys, err := k.fetchPublicKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list public keys from remote signer")
+	}
+	k.lock.Lock()
+	k.pubKeys = pubKeys
+	k.fetched = true
+	k.lock.Unlock()
+	return pubKeys, nil
+}
+
+// FetchAllValidatingPublicKeys fetches the list of all public keys, including disabled ones.
+func (k *Keymanager) FetchAllValidatingPublicKeys(ctx context.Context) ([][48]byte, error) {
+	return k.FetchValidatingPublicKeys(ctx)
+}
+
+// Sign signs a message for a validator key via a typed signing request to the remote signer.
+// Any failure to obtain a signature, including a timeout, is returned as an error.
+func (k *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	ctx, span := trace.StartSpan(ctx, "web3signer.Sign")
+	defer span.End()
+
+	k.lock.RLock()
+	genesisValidatorsRoot := k.genesisValidatorsRoot
+	k.lock.RUnlock()
+	if len(genesisValidatorsRoot) == 0 {
+		return nil, ErrNoGenesisValidatorsRoot
+	}
+	body, epoch, err := typedSignRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	f, err := p2putils.Fork(epoch)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get fork")
+	}
+	body.ForkInfo = &forkInfo{
+		Fork: &fork{
+			PreviousVersion: f.PreviousVersion,
+			CurrentVersion:  f.CurrentVersion,
+			Epoch:           f.Epoch,
+		},
+		GenesisValidatorsRoot: genesisValidatorsRoot,
+	}
+	body.SigningRoot = req.SigningRoot
+	enc, err := json.Marshal(body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal signing request")
+	}
+	res, contentType, err := k.do(ctx, http.MethodPost, signPath+hexutil.Encode(req.PublicKey), enc)
+	if errors.Is(err, ErrSigningDenied) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, errors.Wrap(ErrSigningFailed, err.Error())
+	}
+	return decodeSignature(res, contentType)
+}
+
+// typedSignRequest creates the signing request for the object of a sign request, along with
+// the epoch which determines the fork of its signing domain.
+func typedSignRequest(req *validatorpb.SignRequest) (*signRequest, uint64, error) {
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	switch o := req.Object.(type) {
+	case *validatorpb.SignRequest_Block:
+		if o.Block == nil {
+			break
+		}
+		return &signRequest{Type: blockType, Block: beaconBlockFromProto(o.Block)}, o.Block.Slot / slotsPerEpoch, nil
+	case *validatorpb.SignRequest_AttestationData:
+		if o.AttestationData == nil || o.AttestationData.Target == nil {
+			break
+		}
+		data := attestationDataFromProto(o.AttestationData)
+		return &signRequest{Type: attestationType, Attestation: data}, o.AttestationData.Target.Epoch, nil
+	case *validatorpb.SignRequest_AggregateAttestationAndProof:
+		agg := o.AggregateAttestationAndProof
+		if agg == nil || agg.Aggregate == nil || agg.Aggregate.Data == nil {
+			break
+		}
+		return &signRequest{
+			Type:              aggregateAndProofType,
+			AggregateAndProof: aggregateAndProofFromProto(agg),
+		}, agg.Aggregate.Data.Slot / slotsPerEpoch, nil
+	case *validatorpb.SignRequest_Slot:
+		return &signRequest{Type: aggregationSlotType, AggregationSlot: &aggregationSlot{Slot: o.Slot}}, o.Slot / slotsPerEpoch, nil
+	case *validatorpb.SignRequest_Epoch:
+		return &signRequest{Type: randaoRevealType, RandaoReveal: &randaoReveal{Epoch: o.Epoch}}, o.Epoch, nil
+	case *validatorpb.SignRequest_Exit:
+		if o.Exit == nil {
+			break
+		}
+		return &signRequest{Type: voluntaryExitType, VoluntaryExit: voluntaryExitFromProto(o.Exit)}, o.Exit.Epoch, nil
+	}
+	return nil, 0, fmt.Errorf("unsupported sign request object %T", req.Object)
+}
+
+func decodeSignature(res []byte, contentType string) (bls.Signature, error) {
+	var sig []byte
+	if strings.HasPrefix(contentType, "application/json") {
+		resp := &signResponse{}
+		if err := json.Unmarshal(res, resp); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal signing response")
+		}
+		sig = resp.Signature
+	} else {
+		dec, err := hexutil.Decode(strings.TrimSpace(string(res)))
+		if err != nil {
+			return nil, errors.Wrap(err, "could not decode signature")
+		}
+		sig = dec
+	}
+	return bls.SignatureFromBytes(sig)
+}
+
+func (k *Keymanager) fetchPublicKeys(ctx context.Context) ([][48]byte, error) {
+	res, _, err := k.do(ctx, http.MethodGet, publicKeysPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	var keys []hexBytes
+	if err := json.Unmarshal(res, &keys); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal public keys")
+	}
+	pubKeys := make([][48]byte, len(keys))
+	for i, key := range keys {
+		if len(key) != 48 {
+			return nil, fmt.Errorf("invalid public key length %d", len(key))
+		}
+		pubKeys[i] = bytesutil.ToBytes48(key)
+	}
+	return pubKeys, nil
+}
+
+// refreshPublicKeys periodically fetches the public keys of the remote signer and notifies
+// subscribers when they change. A failed refresh keeps the previous keys.
+func (k *Keymanager) refreshPublicKeys(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			pubKeys, err := k.fetchPublicKeys(ctx)
+			if err != nil {
+				log.WithError(err).Warn("Could not refresh public keys from remote signer")
+				continue
+			}
+			k.lock.Lock()
+			changed := !k.fetched || !equalKeys(k.pubKeys, pubKeys)
+			k.pubKeys = pubKeys
+			k.fetched = true
+			k.lock.Unlock()
+			if changed {
+				log.WithField("numKeys", len(pubKeys)).Info("Public keys of remote signer changed")
+				k.accountsChangedFeed.Send(pubKeys)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func equalKeys(a, b [][48]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// retryableError is a failed request which may succeed if retried, such as a timeout
+// or a server error.
+type retryableError struct {
+	err error
+}
+
+func (e *retryableError) Error() string {
+	return e.err.Error()
+}
+
+// do sends a request to the remote signer and returns the response body and content type.
+// Timeouts, connection errors and server errors are retried up to the configured number of
+// retries, all other failures are returned immediately.
+func (k *Keymanager) do(ctx context.Context, method, path string, body []byte) ([]byte, string, error) {
+	var err error
+	for attempt := 0; attempt <= k.opts.MaxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(retryDelay):
+			case <-ctx.Done():
+				return nil, "", errors.Wrap(ctx.Err(), err.Error())
+			}
+		}
+		var res []byte
+		var contentType string
+		res, contentType, err = k.doOnce(ctx, method, path, body)
+		if err == nil {
+			return res, contentType, nil
+		}
+		if _, ok := err.(*retryableError); !ok || ctx.Err() != nil {
+			return nil, "", err
+		}
+		log.WithError(err).WithField("attempt", attempt+1).Debug("Request to remote signer failed")
+	}
+	return nil, "", errors.Wrap(err, "exhausted retries")
+}
+
+func (k *Keymanager) doOnce(ctx context.Context, method, path string, body []byte) ([]byte, string, error) {
+	ctx, cancel := context.WithTimeout(ctx, k.timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, method, k.baseURL+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, "", err
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := k.httpClient.Do(req)
+	if err != nil {
+		return nil, "", &retryableError{err: err}
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Debug("Could not close response body")
+		}
+	}()
+	res, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", &retryableError{err: err}
+	}
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return res, resp.Header.Get("Content-Type"), nil
+	case resp.StatusCode == http.StatusPreconditionFailed:
+		return nil, "", ErrSigningDenied
+	case resp.StatusCode == http.StatusNotFound:
+		return nil, "", fmt.Errorf("%s %s: key not found in remote signer", method, path)
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError:
+		return nil, "", &retryableError{err: fmt.Errorf("%s %s: status %d: %s", method, path, resp.StatusCode, res)}
+	default:
+		return nil, "", fmt.Errorf("%s %s: status %d: %s", method, path, resp.StatusCode, res)
+	}
+}
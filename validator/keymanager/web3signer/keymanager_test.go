@@ -0,0 +1,364 @@
+package web3signer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// mockSigner is a remote signer serving the Web3Signer eth2 API with a single key.
+type mockSigner struct {
+	t          *testing.T
+	secretKey  bls.SecretKey
+	lock       sync.Mutex
+	pubKeys    []string
+	requests   []*signRequest
+	failures   int
+	status     int
+	delay      time.Duration
+	plaintext  bool
+	signCalls  int
+	keysCalled int
+}
+
+func newMockSigner(t *testing.T) *mockSigner {
+	secretKey, err := bls.RandKey()
+	require.NoError(t, err)
+	return &mockSigner{
+		t:         t,
+		secretKey: secretKey,
+		pubKeys:   []string{hexutil.Encode(secretKey.PublicKey().Marshal())},
+	}
+}
+
+func (m *mockSigner) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if r.URL.Path == publicKeysPath {
+		m.keysCalled++
+		require.NoError(m.t, json.NewEncoder(w).Encode(m.pubKeys))
+		return
+	}
+	require.Equal(m.t, true, strings.HasPrefix(r.URL.Path, signPath))
+	m.signCalls++
+	time.Sleep(m.delay)
+	if m.failures > 0 {
+		m.failures--
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if m.status != 0 {
+		w.WriteHeader(m.status)
+		return
+	}
+	if strings.TrimPrefix(r.URL.Path, signPath) != m.pubKeys[0] {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	enc, err := ioutil.ReadAll(r.Body)
+	require.NoError(m.t, err)
+	req := &signRequest{}
+	require.NoError(m.t, json.Unmarshal(enc, req))
+	m.requests = append(m.requests, req)
+	sig := hexutil.Encode(m.secretKey.Sign(req.SigningRoot).Marshal())
+	if m.plaintext {
+		w.Header().Set("Content-Type", "text/plain")
+		_, err = w.Write([]byte(sig))
+		require.NoError(m.t, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	require.NoError(m.t, json.NewEncoder(w).Encode(map[string]string{"signature": sig}))
+}
+
+func setupKeymanager(t *testing.T, ctx context.Context, signer *mockSigner, opts *KeymanagerOpts) *Keymanager {
+	srv := httptest.NewServer(signer)
+	t.Cleanup(srv.Close)
+	if opts == nil {
+		opts = &KeymanagerOpts{}
+	}
+	opts.URL = srv.URL
+	km, err := NewKeymanager(ctx, &SetupConfig{Opts: opts})
+	require.NoError(t, err)
+	km.SetGenesisValidatorsRoot(bytesutil.PadTo([]byte("genesis"), 32))
+	return km
+}
+
+func TestNewKeymanager_InvalidURL(t *testing.T) {
+	ctx := context.Background()
+	_, err := NewKeymanager(ctx, &SetupConfig{Opts: &KeymanagerOpts{}})
+	assert.ErrorContains(t, "URL is required", err)
+	_, err = NewKeymanager(ctx, &SetupConfig{Opts: &KeymanagerOpts{URL: "ftp://localhost:9000"}})
+	assert.ErrorContains(t, "must use http or https", err)
+}
+
+func TestKeymanager_FetchValidatingPublicKeys(t *testing.T) {
+	signer := newMockSigner(t)
+	km := setupKeymanager(t, context.Background(), signer, nil)
+	keys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(keys))
+	assert.DeepEqual(t, signer.secretKey.PublicKey().Marshal(), keys[0][:])
+
+	// Keys are cached after the first fetch.
+	_, err = km.FetchAllValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, signer.keysCalled)
+}
+
+func TestKeymanager_Sign(t *testing.T) {
+	signer := newMockSigner(t)
+	km := setupKeymanager(t, context.Background(), signer, nil)
+	pubKey := signer.secretKey.PublicKey().Marshal()
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	attData := &ethpb.AttestationData{
+		Slot:            3 * slotsPerEpoch,
+		CommitteeIndex:  2,
+		BeaconBlockRoot: make([]byte, 32),
+		Source:          &ethpb.Checkpoint{Epoch: 2, Root: make([]byte, 32)},
+		Target:          &ethpb.Checkpoint{Epoch: 3, Root: make([]byte, 32)},
+	}
+	tests := []struct {
+		name     string
+		req      *validatorpb.SignRequest
+		wantType string
+		check    func(t *testing.T, req *signRequest)
+	}{
+		{
+			name: "block",
+			req: &validatorpb.SignRequest{Object: &validatorpb.SignRequest_Block{Block: &ethpb.BeaconBlock{
+				Slot:       5,
+				ParentRoot: make([]byte, 32),
+				StateRoot:  make([]byte, 32),
+				Body:       &ethpb.BeaconBlockBody{Eth1Data: &ethpb.Eth1Data{}},
+			}}},
+			wantType: blockType,
+			check: func(t *testing.T, req *signRequest) {
+				require.NotNil(t, req.Block)
+				assert.Equal(t, uint64(5), req.Block.Slot)
+			},
+		},
+		{
+			name:     "attestation",
+			req:      &validatorpb.SignRequest{Object: &validatorpb.SignRequest_AttestationData{AttestationData: attData}},
+			wantType: attestationType,
+			check: func(t *testing.T, req *signRequest) {
+				require.NotNil(t, req.Attestation)
+				assert.Equal(t, uint64(2), req.Attestation.Index)
+				assert.Equal(t, uint64(3), req.Attestation.Target.Epoch)
+			},
+		},
+		{
+			name: "aggregate and proof",
+			req: &validatorpb.SignRequest{Object: &validatorpb.SignRequest_AggregateAttestationAndProof{
+				AggregateAttestationAndProof: &ethpb.AggregateAttestationAndProof{
+					AggregatorIndex: 7,
+					Aggregate:       &ethpb.Attestation{Data: attData, AggregationBits: []byte{1}},
+					SelectionProof:  make([]byte, 96),
+				},
+			}},
+			wantType: aggregateAndProofType,
+			check: func(t *testing.T, req *signRequest) {
+				require.NotNil(t, req.AggregateAndProof)
+				assert.Equal(t, uint64(7), req.AggregateAndProof.AggregatorIndex)
+			},
+		},
+		{
+			name:     "aggregation slot",
+			req:      &validatorpb.SignRequest{Object: &validatorpb.SignRequest_Slot{Slot: 9}},
+			wantType: aggregationSlotType,
+			check: func(t *testing.T, req *signRequest) {
+				require.NotNil(t, req.AggregationSlot)
+				assert.Equal(t, uint64(9), req.AggregationSlot.Slot)
+			},
+		},
+		{
+			name:     "randao reveal",
+			req:      &validatorpb.SignRequest{Object: &validatorpb.SignRequest_Epoch{Epoch: 4}},
+			wantType: randaoRevealType,
+			check: func(t *testing.T, req *signRequest) {
+				require.NotNil(t, req.RandaoReveal)
+				assert.Equal(t, uint64(4), req.RandaoReveal.Epoch)
+			},
+		},
+		{
+			name:     "voluntary exit",
+			req:      &validatorpb.SignRequest{Object: &validatorpb.SignRequest_Exit{Exit: &ethpb.VoluntaryExit{Epoch: 10, ValidatorIndex: 1}}},
+			wantType: voluntaryExitType,
+			check: func(t *testing.T, req *signRequest) {
+				require.NotNil(t, req.VoluntaryExit)
+				assert.Equal(t, uint64(1), req.VoluntaryExit.ValidatorIndex)
+			},
+		},
+	}
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signingRoot := bytesutil.PadTo([]byte(fmt.Sprintf("root %d", i)), 32)
+			tt.req.PublicKey = pubKey
+			tt.req.SigningRoot = signingRoot
+			sig, err := km.Sign(context.Background(), tt.req)
+			require.NoError(t, err)
+			assert.Equal(t, true, sig.Verify(signer.secretKey.PublicKey(), signingRoot))
+
+			signed := signer.requests[len(signer.requests)-1]
+			assert.Equal(t, tt.wantType, signed.Type)
+			assert.DeepEqual(t, signingRoot, []byte(signed.SigningRoot))
+			require.NotNil(t, signed.ForkInfo)
+			assert.DeepEqual(t, bytesutil.PadTo([]byte("genesis"), 32), []byte(signed.ForkInfo.GenesisValidatorsRoot))
+			assert.DeepEqual(t, params.BeaconConfig().GenesisForkVersion, []byte(signed.ForkInfo.Fork.CurrentVersion))
+			tt.check(t, signed)
+		})
+	}
+}
+
+func TestKeymanager_Sign_PlaintextResponse(t *testing.T) {
+	signer := newMockSigner(t)
+	signer.plaintext = true
+	km := setupKeymanager(t, context.Background(), signer, nil)
+	signingRoot := bytesutil.PadTo([]byte("root"), 32)
+	sig, err := km.Sign(context.Background(), &validatorpb.SignRequest{
+		PublicKey:   signer.secretKey.PublicKey().Marshal(),
+		SigningRoot: signingRoot,
+		Object:      &validatorpb.SignRequest_Epoch{Epoch: 1},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, true, sig.Verify(signer.secretKey.PublicKey(), signingRoot))
+}
+
+func TestKeymanager_Sign_Denied(t *testing.T) {
+	signer := newMockSigner(t)
+	signer.status = http.StatusPreconditionFailed
+	km := setupKeymanager(t, context.Background(), signer, &KeymanagerOpts{MaxRetries: 3})
+	_, err := km.Sign(context.Background(), &validatorpb.SignRequest{
+		PublicKey:   signer.secretKey.PublicKey().Marshal(),
+		SigningRoot: make([]byte, 32),
+		Object:      &validatorpb.SignRequest_Epoch{Epoch: 1},
+	})
+	assert.ErrorContains(t, ErrSigningDenied.Error(), err)
+	// Denied requests are not retried.
+	assert.Equal(t, 1, signer.signCalls)
+}
+
+func TestKeymanager_Sign_RetriesServerErrors(t *testing.T) {
+	signer := newMockSigner(t)
+	signer.failures = 2
+	km := setupKeymanager(t, context.Background(), signer, &KeymanagerOpts{MaxRetries: 2})
+	_, err := km.Sign(context.Background(), &validatorpb.SignRequest{
+		PublicKey:   signer.secretKey.PublicKey().Marshal(),
+		SigningRoot: make([]byte, 32),
+		Object:      &validatorpb.SignRequest_Epoch{Epoch: 1},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, signer.signCalls)
+
+	signer.failures = 2
+	km = setupKeymanager(t, context.Background(), signer, &KeymanagerOpts{MaxRetries: 1})
+	_, err = km.Sign(context.Background(), &validatorpb.SignRequest{
+		PublicKey:   signer.secretKey.PublicKey().Marshal(),
+		SigningRoot: make([]byte, 32),
+		Object:      &validatorpb.SignRequest_Epoch{Epoch: 1},
+	})
+	assert.ErrorContains(t, ErrSigningFailed.Error(), err)
+}
+
+func TestKeymanager_Sign_TimeoutFailsClosed(t *testing.T) {
+	signer := newMockSigner(t)
+	signer.delay = 200 * time.Millisecond
+	km := setupKeymanager(t, context.Background(), signer, &KeymanagerOpts{TimeoutMillis: 20})
+	sig, err := km.Sign(context.Background(), &validatorpb.SignRequest{
+		PublicKey:   signer.secretKey.PublicKey().Marshal(),
+		SigningRoot: make([]byte, 32),
+		Object:      &validatorpb.SignRequest_Epoch{Epoch: 1},
+	})
+	assert.ErrorContains(t, ErrSigningFailed.Error(), err)
+	assert.Equal(t, nil, sig)
+}
+
+func TestKeymanager_Sign_UnknownKey(t *testing.T) {
+	signer := newMockSigner(t)
+	km := setupKeymanager(t, context.Background(), signer, nil)
+	_, err := km.Sign(context.Background(), &validatorpb.SignRequest{
+		PublicKey:   make([]byte, 48),
+		SigningRoot: make([]byte, 32),
+		Object:      &validatorpb.SignRequest_Epoch{Epoch: 1},
+	})
+	assert.ErrorContains(t, "key not found", err)
+}
+
+func TestKeymanager_Sign_NoGenesisValidatorsRoot(t *testing.T) {
+	signer := newMockSigner(t)
+	km := setupKeymanager(t, context.Background(), signer, nil)
+	km.SetGenesisValidatorsRoot(nil)
+	_, err := km.Sign(context.Background(), &validatorpb.SignRequest{
+		PublicKey:   signer.secretKey.PublicKey().Marshal(),
+		SigningRoot: make([]byte, 32),
+		Object:      &validatorpb.SignRequest_Epoch{Epoch: 1},
+	})
+	assert.ErrorContains(t, ErrNoGenesisValidatorsRoot.Error(), err)
+	assert.Equal(t, 0, signer.signCalls)
+}
+
+func TestKeymanager_RefreshPublicKeys(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	signer := newMockSigner(t)
+	srv := httptest.NewServer(signer)
+	defer srv.Close()
+	km := &Keymanager{
+		opts:                &KeymanagerOpts{URL: srv.URL},
+		baseURL:             srv.URL,
+		httpClient:          http.DefaultClient,
+		timeout:             DefaultTimeout,
+		accountsChangedFeed: new(event.Feed),
+	}
+	keysChan := make(chan [][48]byte, 1)
+	sub := km.SubscribeAccountChanges(keysChan)
+	defer sub.Unsubscribe()
+	go km.refreshPublicKeys(ctx, 10*time.Millisecond)
+
+	select {
+	case keys := <-keysChan:
+		require.Equal(t, 1, len(keys))
+	case <-time.After(5 * time.Second):
+		t.Fatal("Did not receive public keys")
+	}
+
+	secretKey, err := bls.RandKey()
+	require.NoError(t, err)
+	signer.lock.Lock()
+	signer.pubKeys = append(signer.pubKeys, hexutil.Encode(secretKey.PublicKey().Marshal()))
+	signer.lock.Unlock()
+	select {
+	case keys := <-keysChan:
+		require.Equal(t, 2, len(keys))
+		assert.DeepEqual(t, secretKey.PublicKey().Marshal(), keys[1][:])
+	case <-time.After(5 * time.Second):
+		t.Fatal("Did not receive changed public keys")
+	}
+}
+
+func TestUnmarshalOptionsFile(t *testing.T) {
+	opts := &KeymanagerOpts{URL: "http://localhost:9000", TimeoutMillis: 500, MaxRetries: 2, RefreshIntervalSeconds: 30}
+	enc, err := MarshalOptionsFile(context.Background(), opts)
+	require.NoError(t, err)
+	got, err := UnmarshalOptionsFile(ioutil.NopCloser(strings.NewReader(string(enc))))
+	require.NoError(t, err)
+	assert.DeepEqual(t, opts, got)
+}
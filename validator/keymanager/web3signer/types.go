@@ -0,0 +1,333 @@
+package web3signer
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethpb "github.com/prysmaticlabs/ethereumapis/eth/v1alpha1"
+)
+
+// Signing request types of the remote signer API.
+const (
+	blockType             = "BLOCK"
+	attestationType       = "ATTESTATION"
+	aggregationSlotType   = "AGGREGATION_SLOT"
+	aggregateAndProofType = "AGGREGATE_AND_PROOF"
+	randaoRevealType      = "RANDAO_REVEAL"
+	voluntaryExitType     = "VOLUNTARY_EXIT"
+)
+
+// hexBytes is encoded as a 0x-prefixed hex string, as in the eth2 beacon node API.
+type hexBytes []byte
+
+// MarshalJSON encodes the bytes as a 0x-prefixed hex string.
+func (b hexBytes) MarshalJSON() ([]byte, error) {
+	return json.Marshal(hexutil.Encode(b))
+}
+
+// UnmarshalJSON decodes a 0x-prefixed hex string.
+func (b *hexBytes) UnmarshalJSON(enc []byte) error {
+	var s string
+	if err := json.Unmarshal(enc, &s); err != nil {
+		return err
+	}
+	dec, err := hexutil.Decode(s)
+	if err != nil {
+		return err
+	}
+	*b = dec
+	return nil
+}
+
+// signRequest is the body of a signing request. Exactly one of the objects is set, according to
+// the type of the request. The remote signer recomputes the signing root from the object and the
+// fork info before signing it.
+type signRequest struct {
+	Type              string             `json:"type"`
+	ForkInfo          *forkInfo          `json:"fork_info"`
+	SigningRoot       hexBytes           `json:"signingRoot"`
+	Block             *beaconBlock       `json:"block,omitempty"`
+	Attestation       *attestationData   `json:"attestation,omitempty"`
+	AggregationSlot   *aggregationSlot   `json:"aggregation_slot,omitempty"`
+	AggregateAndProof *aggregateAndProof `json:"aggregate_and_proof,omitempty"`
+	RandaoReveal      *randaoReveal      `json:"randao_reveal,omitempty"`
+	VoluntaryExit     *voluntaryExit     `json:"voluntary_exit,omitempty"`
+}
+
+// signResponse is the JSON body of a signing response.
+type signResponse struct {
+	Signature hexBytes `json:"signature"`
+}
+
+type forkInfo struct {
+	Fork                  *fork    `json:"fork"`
+	GenesisValidatorsRoot hexBytes `json:"genesis_validators_root"`
+}
+
+type fork struct {
+	PreviousVersion hexBytes `json:"previous_version"`
+	CurrentVersion  hexBytes `json:"current_version"`
+	Epoch           uint64   `json:"epoch,string"`
+}
+
+type aggregationSlot struct {
+	Slot uint64 `json:"slot,string"`
+}
+
+type randaoReveal struct {
+	Epoch uint64 `json:"epoch,string"`
+}
+
+type checkpoint struct {
+	Epoch uint64   `json:"epoch,string"`
+	Root  hexBytes `json:"root"`
+}
+
+type attestationData struct {
+	Slot            uint64      `json:"slot,string"`
+	Index           uint64      `json:"index,string"`
+	BeaconBlockRoot hexBytes    `json:"beacon_block_root"`
+	Source          *checkpoint `json:"source"`
+	Target          *checkpoint `json:"target"`
+}
+
+type attestation struct {
+	AggregationBits hexBytes         `json:"aggregation_bits"`
+	Data            *attestationData `json:"data"`
+	Signature       hexBytes         `json:"signature"`
+}
+
+type aggregateAndProof struct {
+	AggregatorIndex uint64       `json:"aggregator_index,string"`
+	Aggregate       *attestation `json:"aggregate"`
+	SelectionProof  hexBytes     `json:"selection_proof"`
+}
+
+type indexedAttestation struct {
+	AttestingIndices []string         `json:"attesting_indices"`
+	Data             *attestationData `json:"data"`
+	Signature        hexBytes         `json:"signature"`
+}
+
+type attesterSlashing struct {
+	Attestation1 *indexedAttestation `json:"attestation_1"`
+	Attestation2 *indexedAttestation `json:"attestation_2"`
+}
+
+type beaconBlockHeader struct {
+	Slot          uint64   `json:"slot,string"`
+	ProposerIndex uint64   `json:"proposer_index,string"`
+	ParentRoot    hexBytes `json:"parent_root"`
+	StateRoot     hexBytes `json:"state_root"`
+	BodyRoot      hexBytes `json:"body_root"`
+}
+
+type signedBeaconBlockHeader struct {
+	Message   *beaconBlockHeader `json:"message"`
+	Signature hexBytes           `json:"signature"`
+}
+
+type proposerSlashing struct {
+	SignedHeader1 *signedBeaconBlockHeader `json:"signed_header_1"`
+	SignedHeader2 *signedBeaconBlockHeader `json:"signed_header_2"`
+}
+
+type eth1Data struct {
+	DepositRoot  hexBytes `json:"deposit_root"`
+	DepositCount uint64   `json:"deposit_count,string"`
+	BlockHash    hexBytes `json:"block_hash"`
+}
+
+type depositData struct {
+	PublicKey             hexBytes `json:"pubkey"`
+	WithdrawalCredentials hexBytes `json:"withdrawal_credentials"`
+	Amount                uint64   `json:"amount,string"`
+	Signature             hexBytes `json:"signature"`
+}
+
+type deposit struct {
+	Proof []hexBytes   `json:"proof"`
+	Data  *depositData `json:"data"`
+}
+
+type voluntaryExit struct {
+	Epoch          uint64 `json:"epoch,string"`
+	ValidatorIndex uint64 `json:"validator_index,string"`
+}
+
+type signedVoluntaryExit struct {
+	Message   *voluntaryExit `json:"message"`
+	Signature hexBytes       `json:"signature"`
+}
+
+type beaconBlockBody struct {
+	RandaoReveal      hexBytes               `json:"randao_reveal"`
+	Eth1Data          *eth1Data              `json:"eth1_data"`
+	Graffiti          hexBytes               `json:"graffiti"`
+	ProposerSlashings []*proposerSlashing    `json:"proposer_slashings"`
+	AttesterSlashings []*attesterSlashing    `json:"attester_slashings"`
+	Attestations      []*attestation         `json:"attestations"`
+	Deposits          []*deposit             `json:"deposits"`
+	VoluntaryExits    []*signedVoluntaryExit `json:"voluntary_exits"`
+}
+
+type beaconBlock struct {
+	Slot          uint64           `json:"slot,string"`
+	ProposerIndex uint64           `json:"proposer_index,string"`
+	ParentRoot    hexBytes         `json:"parent_root"`
+	StateRoot     hexBytes         `json:"state_root"`
+	Body          *beaconBlockBody `json:"body"`
+}
+
+func checkpointFromProto(c *ethpb.Checkpoint) *checkpoint {
+	if c == nil {
+		return nil
+	}
+	return &checkpoint{Epoch: c.Epoch, Root: c.Root}
+}
+
+func attestationDataFromProto(d *ethpb.AttestationData) *attestationData {
+	if d == nil {
+		return nil
+	}
+	return &attestationData{
+		Slot:            d.Slot,
+		Index:           d.CommitteeIndex,
+		BeaconBlockRoot: d.BeaconBlockRoot,
+		Source:          checkpointFromProto(d.Source),
+		Target:          checkpointFromProto(d.Target),
+	}
+}
+
+func attestationFromProto(a *ethpb.Attestation) *attestation {
+	if a == nil {
+		return nil
+	}
+	return &attestation{
+		AggregationBits: hexBytes(a.AggregationBits),
+		Data:            attestationDataFromProto(a.Data),
+		Signature:       a.Signature,
+	}
+}
+
+func aggregateAndProofFromProto(a *ethpb.AggregateAttestationAndProof) *aggregateAndProof {
+	if a == nil {
+		return nil
+	}
+	return &aggregateAndProof{
+		AggregatorIndex: a.AggregatorIndex,
+		Aggregate:       attestationFromProto(a.Aggregate),
+		SelectionProof:  a.SelectionProof,
+	}
+}
+
+func indexedAttestationFromProto(a *ethpb.IndexedAttestation) *indexedAttestation {
+	if a == nil {
+		return nil
+	}
+	indices := make([]string, len(a.AttestingIndices))
+	for i, idx := range a.AttestingIndices {
+		indices[i] = strconv.FormatUint(idx, 10)
+	}
+	return &indexedAttestation{
+		AttestingIndices: indices,
+		Data:             attestationDataFromProto(a.Data),
+		Signature:        a.Signature,
+	}
+}
+
+func signedBeaconBlockHeaderFromProto(h *ethpb.SignedBeaconBlockHeader) *signedBeaconBlockHeader {
+	if h == nil || h.Header == nil {
+		return nil
+	}
+	return &signedBeaconBlockHeader{
+		Message: &beaconBlockHeader{
+			Slot:          h.Header.Slot,
+			ProposerIndex: h.Header.ProposerIndex,
+			ParentRoot:    h.Header.ParentRoot,
+			StateRoot:     h.Header.StateRoot,
+			BodyRoot:      h.Header.BodyRoot,
+		},
+		Signature: h.Signature,
+	}
+}
+
+func voluntaryExitFromProto(e *ethpb.VoluntaryExit) *voluntaryExit {
+	if e == nil {
+		return nil
+	}
+	return &voluntaryExit{Epoch: e.Epoch, ValidatorIndex: e.ValidatorIndex}
+}
+
+func beaconBlockBodyFromProto(b *ethpb.BeaconBlockBody) *beaconBlockBody {
+	if b == nil {
+		return nil
+	}
+	body := &beaconBlockBody{
+		RandaoReveal:      b.RandaoReveal,
+		Graffiti:          b.Graffiti,
+		ProposerSlashings: make([]*proposerSlashing, len(b.ProposerSlashings)),
+		AttesterSlashings: make([]*attesterSlashing, len(b.AttesterSlashings)),
+		Attestations:      make([]*attestation, len(b.Attestations)),
+		Deposits:          make([]*deposit, len(b.Deposits)),
+		VoluntaryExits:    make([]*signedVoluntaryExit, len(b.VoluntaryExits)),
+	}
+	if b.Eth1Data != nil {
+		body.Eth1Data = &eth1Data{
+			DepositRoot:  b.Eth1Data.DepositRoot,
+			DepositCount: b.Eth1Data.DepositCount,
+			BlockHash:    b.Eth1Data.BlockHash,
+		}
+	}
+	for i, s := range b.ProposerSlashings {
+		body.ProposerSlashings[i] = &proposerSlashing{
+			SignedHeader1: signedBeaconBlockHeaderFromProto(s.Header_1),
+			SignedHeader2: signedBeaconBlockHeaderFromProto(s.Header_2),
+		}
+	}
+	for i, s := range b.AttesterSlashings {
+		body.AttesterSlashings[i] = &attesterSlashing{
+			Attestation1: indexedAttestationFromProto(s.Attestation_1),
+			Attestation2: indexedAttestationFromProto(s.Attestation_2),
+		}
+	}
+	for i, a := range b.Attestations {
+		body.Attestations[i] = attestationFromProto(a)
+	}
+	for i, d := range b.Deposits {
+		proof := make([]hexBytes, len(d.Proof))
+		for j, p := range d.Proof {
+			proof[j] = p
+		}
+		body.Deposits[i] = &deposit{Proof: proof}
+		if d.Data != nil {
+			body.Deposits[i].Data = &depositData{
+				PublicKey:             d.Data.PublicKey,
+				WithdrawalCredentials: d.Data.WithdrawalCredentials,
+				Amount:                d.Data.Amount,
+				Signature:             d.Data.Signature,
+			}
+		}
+	}
+	for i, e := range b.VoluntaryExits {
+		body.VoluntaryExits[i] = &signedVoluntaryExit{
+			Message:   voluntaryExitFromProto(e.Exit),
+			Signature: e.Signature,
+		}
+	}
+	return body
+}
+
+func beaconBlockFromProto(b *ethpb.BeaconBlock) *beaconBlock {
+	if b == nil {
+		return nil
+	}
+	return &beaconBlock{
+		Slot:          b.Slot,
+		ProposerIndex: b.ProposerIndex,
+		ParentRoot:    b.ParentRoot,
+		StateRoot:     b.StateRoot,
+		Body:          beaconBlockBodyFromProto(b.Body),
+	}
+}
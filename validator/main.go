@@ -19,8 +19,11 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/tos"
 	"github.com/prysmaticlabs/prysm/shared/version"
 	"github.com/prysmaticlabs/prysm/validator/accounts"
+	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/flags"
 	"github.com/prysmaticlabs/prysm/validator/node"
+	"github.com/prysmaticlabs/prysm/validator/performance"
+	slashingprotection "github.com/prysmaticlabs/prysm/validator/slashing-protection"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
 	prefixed "github.com/x-cray/logrus-prefixed-formatter"
@@ -47,6 +50,12 @@ var appFlags = []cli.Flag{
 	flags.BeaconRPCGatewayProviderFlag,
 	flags.CertFlag,
 	flags.GraffitiFlag,
+	flags.ProposerConfigFileFlag,
+	flags.EnableDoppelgangerProtectionFlag,
+	flags.DoppelgangerEpochsFlag,
+	flags.BeaconNodeMaxSlotLagFlag,
+	flags.BeaconNodeMinPeersFlag,
+	flags.EnableBeaconNodeBroadcastFlag,
 	flags.DisablePenaltyRewardLogFlag,
 	flags.InteropStartIndex,
 	flags.InteropNumValidators,
@@ -106,6 +115,9 @@ func main() {
 	app.Commands = []*cli.Command{
 		accounts.WalletCommands,
 		accounts.AccountCommands,
+		db.Commands,
+		slashingprotection.Commands,
+		performance.Commands,
 	}
 
 	app.Flags = appFlags
@@ -30,6 +30,7 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/flags"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
+	proposerconfig "github.com/prysmaticlabs/prysm/validator/proposer-config"
 	"github.com/prysmaticlabs/prysm/validator/rpc"
 	"github.com/prysmaticlabs/prysm/validator/rpc/gateway"
 	slashing_protection "github.com/prysmaticlabs/prysm/validator/slashing-protection"
@@ -352,6 +353,21 @@ func (s *ValidatorClient) registerClientService(
 	maxCallRecvMsgSize := s.cliCtx.Int(cmd.GrpcMaxCallRecvMsgSizeFlag.Name)
 	grpcRetries := s.cliCtx.Uint(flags.GrpcRetriesFlag.Name)
 	grpcRetryDelay := s.cliCtx.Duration(flags.GrpcRetryDelayFlag.Name)
+	var doppelgangerEpochs uint64
+	if s.cliCtx.Bool(flags.EnableDoppelgangerProtectionFlag.Name) {
+		doppelgangerEpochs = s.cliCtx.Uint64(flags.DoppelgangerEpochsFlag.Name)
+		if doppelgangerEpochs == 0 {
+			return errors.New("doppelganger protection requires watching at least one epoch")
+		}
+	}
+	var proposerConfig *proposerconfig.Store
+	if path := s.cliCtx.String(flags.ProposerConfigFileFlag.Name); path != "" {
+		store, err := proposerconfig.NewStore(path, graffiti)
+		if err != nil {
+			return errors.Wrap(err, "could not load proposer config")
+		}
+		proposerConfig = store
+	}
 	var sp *slashing_protection.Service
 	var protector slashing_protection.Protector
 	if err := s.services.FetchService(&sp); err == nil {
@@ -373,6 +389,11 @@ func (s *ValidatorClient) registerClientService(
 		ValDB:                      s.db,
 		UseWeb:                     s.cliCtx.Bool(flags.EnableWebFlag.Name),
 		WalletInitializedFeed:      s.walletInitialized,
+		DoppelgangerEpochs:         doppelgangerEpochs,
+		BeaconNodeMaxSlotLag:       s.cliCtx.Uint64(flags.BeaconNodeMaxSlotLagFlag.Name),
+		BeaconNodeMinPeers:         s.cliCtx.Uint64(flags.BeaconNodeMinPeersFlag.Name),
+		BroadcastToBeaconNodes:     s.cliCtx.Bool(flags.EnableBeaconNodeBroadcastFlag.Name),
+		ProposerConfig:             proposerConfig,
 	})
 
 	if err != nil {
@@ -0,0 +1,147 @@
+package performance
+
+import (
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// Commands for summarizing the performance history recorded in the validator database.
+var Commands = &cli.Command{
+	Name:     "performance",
+	Category: "performance",
+	Usage: `prints daily summaries of the attestation and proposal performance of each key, ` +
+		`as recorded in the validator database by the running validator client`,
+	Flags: cmd.WrapFlags([]cli.Flag{
+		flags.WalletDirFlag,
+		cmd.DataDirFlag,
+		flags.PerformancePublicKeysFlag,
+		flags.PerformanceDaysFlag,
+	}),
+	Before: func(cliCtx *cli.Context) error {
+		return cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags)
+	},
+	Action: performanceCli,
+}
+
+func performanceCli(cliCtx *cli.Context) error {
+	days := cliCtx.Uint64(flags.PerformanceDaysFlag.Name)
+	if days == 0 {
+		return fmt.Errorf("--%s must be at least 1", flags.PerformanceDaysFlag.Name)
+	}
+	publicKeys, err := parsePublicKeys(cliCtx.String(flags.PerformancePublicKeysFlag.Name))
+	if err != nil {
+		return err
+	}
+	dataDir, err := performanceDataDir(cliCtx)
+	if err != nil {
+		return err
+	}
+	if !fileutil.FileExists(filepath.Join(dataDir, kv.ProtectionDbFileName)) {
+		return fmt.Errorf("no validator database found in %s", dataDir)
+	}
+	validatorDB, err := kv.NewKVStore(dataDir, nil)
+	if err != nil {
+		return errors.Wrapf(err, "could not open validator database in %s", dataDir)
+	}
+	defer func() {
+		if err := validatorDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close validator database")
+		}
+	}()
+
+	if len(publicKeys) == 0 {
+		publicKeys, err = validatorDB.ValidatorPerformancePublicKeys(cliCtx.Context)
+		if err != nil {
+			return errors.Wrap(err, "could not retrieve public keys")
+		}
+	}
+	if len(publicKeys) == 0 {
+		log.Info("No performance history found in the validator database")
+		return nil
+	}
+	epochsPerDay := EpochsPerDay()
+	for _, pubKey := range publicKeys {
+		records, err := validatorDB.ValidatorPerformanceForPubKey(cliCtx.Context, pubKey, 0, ^uint64(0))
+		if err != nil {
+			return errors.Wrapf(err, "could not retrieve performance history of %#x", pubKey)
+		}
+		fmt.Printf("\nPublic key %#x\n", pubKey)
+		if len(records) == 0 {
+			fmt.Println("No performance history recorded")
+			continue
+		}
+		lastDay := records[len(records)-1].Epoch / epochsPerDay
+		if lastDay >= days {
+			firstEpoch := (lastDay - days + 1) * epochsPerDay
+			for len(records) > 0 && records[0].Epoch < firstEpoch {
+				records = records[1:]
+			}
+		}
+		fmt.Printf(
+			"%-6s %-15s %7s %9s %9s %7s %7s %7s %9s %7s %16s\n",
+			"DAY", "EPOCHS", "RECORDS", "INCLUDED", "AVG DIST", "SOURCE", "TARGET", "HEAD", "PROPOSALS", "MISSED", "BALANCE (GWEI)",
+		)
+		for _, s := range SummarizeByDay(records) {
+			fmt.Printf(
+				"%-6d %-15s %7d %9d %9.2f %7d %7d %7d %9d %7d %+16d\n",
+				s.Day, fmt.Sprintf("%d-%d", s.FirstEpoch, s.LastEpoch), s.Epochs, s.Included,
+				s.AverageInclusionDistance(), s.CorrectSource, s.CorrectTarget, s.CorrectHead,
+				s.ProposalsAssigned, s.ProposalsMissed, s.BalanceChange,
+			)
+		}
+	}
+	return nil
+}
+
+// Returns the directory of the validator database keeping the performance history, which is
+// the accounts directory of the wallet unless a data directory is specified.
+func performanceDataDir(cliCtx *cli.Context) (string, error) {
+	if cliCtx.IsSet(cmd.DataDirFlag.Name) {
+		return fileutil.ExpandPath(cliCtx.String(cmd.DataDirFlag.Name))
+	}
+	walletDir, err := fileutil.ExpandPath(cliCtx.String(flags.WalletDirFlag.Name))
+	if err != nil {
+		return "", err
+	}
+	w, err := wallet.OpenWallet(cliCtx.Context, &wallet.Config{WalletDir: walletDir})
+	if err != nil {
+		return "", errors.Wrapf(
+			err, "could not open wallet, please specify the directory of the validator database with --%s",
+			cmd.DataDirFlag.Name,
+		)
+	}
+	return w.AccountsDir(), nil
+}
+
+// parsePublicKeys parses a comma-separated list of hex encoded public keys.
+func parsePublicKeys(input string) ([][48]byte, error) {
+	if input == "" {
+		return nil, nil
+	}
+	var publicKeys [][48]byte
+	for _, str := range strings.Split(input, ",") {
+		pkString := strings.TrimPrefix(strings.TrimSpace(str), "0x")
+		pubKeyBytes, err := hex.DecodeString(pkString)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode string %s as hex", pkString)
+		}
+		if len(pubKeyBytes) != 48 {
+			return nil, fmt.Errorf("public key %#x is not 48 bytes long", pubKeyBytes)
+		}
+		var pubKey [48]byte
+		copy(pubKey[:], pubKeyBytes)
+		publicKeys = append(publicKeys, pubKey)
+	}
+	return publicKeys, nil
+}
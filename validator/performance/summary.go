@@ -0,0 +1,76 @@
+package performance
+
+import (
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+)
+
+const secondsPerDay = 24 * 60 * 60
+
+// DaySummary is the performance of a validator key aggregated over the recorded epochs
+// of a day since genesis.
+type DaySummary struct {
+	Day                    uint64
+	FirstEpoch             uint64
+	LastEpoch              uint64
+	Epochs                 uint64
+	Included               uint64
+	TotalInclusionDistance uint64
+	CorrectSource          uint64
+	CorrectTarget          uint64
+	CorrectHead            uint64
+	ProposalsAssigned      uint64
+	ProposalsMissed        uint64
+	BalanceChange          int64
+}
+
+// AverageInclusionDistance returns the average inclusion distance of the included attestations.
+func (s *DaySummary) AverageInclusionDistance() float64 {
+	if s.Included == 0 {
+		return 0
+	}
+	return float64(s.TotalInclusionDistance) / float64(s.Included)
+}
+
+// EpochsPerDay returns the number of epochs in a day for the beacon chain config in use.
+func EpochsPerDay() uint64 {
+	cfg := params.BeaconConfig()
+	return secondsPerDay / (cfg.SecondsPerSlot * cfg.SlotsPerEpoch)
+}
+
+// SummarizeByDay aggregates performance records of a key, ordered by epoch, into
+// summaries of each day with recorded epochs.
+func SummarizeByDay(records []*kv.ValidatorPerformance) []*DaySummary {
+	epochsPerDay := EpochsPerDay()
+	summaries := make([]*DaySummary, 0)
+	var current *DaySummary
+	for _, record := range records {
+		day := record.Epoch / epochsPerDay
+		if current == nil || current.Day != day {
+			current = &DaySummary{
+				Day:        day,
+				FirstEpoch: record.Epoch,
+			}
+			summaries = append(summaries, current)
+		}
+		current.LastEpoch = record.Epoch
+		current.Epochs++
+		if record.Included() {
+			current.Included++
+			current.TotalInclusionDistance += record.InclusionDistance
+		}
+		if record.CorrectlyVotedSource {
+			current.CorrectSource++
+		}
+		if record.CorrectlyVotedTarget {
+			current.CorrectTarget++
+		}
+		if record.CorrectlyVotedHead {
+			current.CorrectHead++
+		}
+		current.ProposalsAssigned += record.ProposalsAssigned
+		current.ProposalsMissed += record.ProposalsMissed
+		current.BalanceChange += int64(record.BalanceAfter) - int64(record.BalanceBefore)
+	}
+	return summaries
+}
@@ -0,0 +1,66 @@
+package performance
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+)
+
+func TestSummarizeByDay(t *testing.T) {
+	epochsPerDay := EpochsPerDay()
+	records := []*kv.ValidatorPerformance{
+		{
+			Epoch:                1,
+			InclusionSlot:        40,
+			InclusionDistance:    1,
+			CorrectlyVotedSource: true,
+			CorrectlyVotedTarget: true,
+			CorrectlyVotedHead:   true,
+			BalanceBefore:        32000000000,
+			BalanceAfter:         32000010000,
+			ProposalsAssigned:    1,
+		},
+		{
+			Epoch:                2,
+			InclusionSlot:        75,
+			InclusionDistance:    4,
+			CorrectlyVotedSource: true,
+			BalanceBefore:        32000010000,
+			BalanceAfter:         32000015000,
+			ProposalsAssigned:    1,
+			ProposalsMissed:      1,
+		},
+		{
+			Epoch:         2*epochsPerDay + 3,
+			InclusionSlot: ^uint64(0),
+			BalanceBefore: 32000015000,
+			BalanceAfter:  32000005000,
+		},
+	}
+
+	summaries := SummarizeByDay(records)
+	require.Equal(t, 2, len(summaries))
+	assert.DeepEqual(t, &DaySummary{
+		Day:                    0,
+		FirstEpoch:             1,
+		LastEpoch:              2,
+		Epochs:                 2,
+		Included:               2,
+		TotalInclusionDistance: 5,
+		CorrectSource:          2,
+		CorrectTarget:          1,
+		CorrectHead:            1,
+		ProposalsAssigned:      2,
+		ProposalsMissed:        1,
+		BalanceChange:          15000,
+	}, summaries[0])
+	assert.Equal(t, 2.5, summaries[0].AverageInclusionDistance())
+
+	assert.Equal(t, uint64(2), summaries[1].Day)
+	assert.Equal(t, uint64(1), summaries[1].Epochs)
+	assert.Equal(t, uint64(0), summaries[1].Included)
+	assert.Equal(t, int64(-10000), summaries[1].BalanceChange)
+	assert.Equal(t, float64(0), summaries[1].AverageInclusionDistance())
+}
@@ -0,0 +1,173 @@
+// Package proposerconfig defines a per-key configuration file for the validator client,
+// specifying the graffiti of proposed blocks and which duties are performed for each
+// validating key, with a default section applied to keys without their own section.
+package proposerconfig
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/ghodss/yaml"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/version"
+)
+
+// Maximum length of the graffiti of a block in bytes.
+const graffitiLength = 32
+
+// Config is the content of a proposer config file. Options of the section of a public key
+// override the options of the default section.
+//
+// Example:
+//
+//	default:
+//	  graffiti: "prysm {{.Version}}"
+//	keys:
+//	  "0x8f2a...":
+//	    graffiti: "validator {{.Index}}"
+//	    propose: false
+type Config struct {
+	Default *Options            `json:"default,omitempty"`
+	Keys    map[string]*Options `json:"keys,omitempty"`
+}
+
+// Options configure a validating key. Unset options fall back to the default section,
+// and then to the values of KeyConfig.
+type Options struct {
+	// Graffiti is a text/template rendered with GraffitiData.
+	Graffiti *string `json:"graffiti,omitempty"`
+	// Enabled specifies whether the validator client performs any duty for the key.
+	Enabled *bool `json:"enabled,omitempty"`
+	// Attest specifies whether the validator client attests and aggregates with the key.
+	Attest *bool `json:"attest,omitempty"`
+	// Propose specifies whether the validator client proposes blocks with the key.
+	Propose *bool `json:"propose,omitempty"`
+}
+
+// KeyConfig is the effective configuration of a validating key.
+type KeyConfig struct {
+	Graffiti string
+	Enabled  bool
+	Attest   bool
+	Propose  bool
+}
+
+// GraffitiData is available to graffiti templates.
+type GraffitiData struct {
+	// Index of the validator.
+	Index uint64
+	// PublicKey of the validator as a shortened hex string.
+	PublicKey string
+	// Version of the validator client.
+	Version string
+}
+
+// Parse decodes and validates a proposer config in YAML or JSON format.
+func Parse(enc []byte) (*Config, error) {
+	cfg := &Config{}
+	if err := yaml.Unmarshal(enc, cfg); err != nil {
+		return nil, errors.Wrap(err, "could not decode proposer config")
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// Marshal encodes the proposer config in YAML format.
+func (c *Config) Marshal() ([]byte, error) {
+	return yaml.Marshal(c)
+}
+
+func (c *Config) validate() error {
+	if err := c.Default.validate(); err != nil {
+		return errors.Wrap(err, "invalid default section")
+	}
+	for key, opts := range c.Keys {
+		pubKey, err := hex.DecodeString(strings.TrimPrefix(key, "0x"))
+		if err != nil || len(pubKey) != 48 {
+			return fmt.Errorf("%s is not a valid public key", key)
+		}
+		if err := opts.validate(); err != nil {
+			return errors.Wrapf(err, "invalid section of public key %s", key)
+		}
+	}
+	return nil
+}
+
+func (o *Options) validate() error {
+	if o == nil || o.Graffiti == nil {
+		return nil
+	}
+	if _, err := template.New("graffiti").Parse(*o.Graffiti); err != nil {
+		return errors.Wrap(err, "invalid graffiti template")
+	}
+	return nil
+}
+
+// keyConfigs resolves the options of each public key and the default options.
+func (c *Config) keyConfigs(fallbackGraffiti string) (map[[48]byte]*KeyConfig, *KeyConfig) {
+	defaultConfig := &KeyConfig{
+		Graffiti: fallbackGraffiti,
+		Enabled:  true,
+		Attest:   true,
+		Propose:  true,
+	}
+	defaultConfig.apply(c.Default)
+	configs := make(map[[48]byte]*KeyConfig, len(c.Keys))
+	for key, opts := range c.Keys {
+		// Public keys were validated when the config was parsed.
+		pubKey, err := hex.DecodeString(strings.TrimPrefix(key, "0x"))
+		if err != nil {
+			continue
+		}
+		keyConfig := *defaultConfig
+		keyConfig.apply(opts)
+		configs[bytesutil.ToBytes48(pubKey)] = &keyConfig
+	}
+	return configs, defaultConfig
+}
+
+func (k *KeyConfig) apply(opts *Options) {
+	if opts == nil {
+		return
+	}
+	if opts.Graffiti != nil {
+		k.Graffiti = *opts.Graffiti
+	}
+	if opts.Enabled != nil {
+		k.Enabled = *opts.Enabled
+	}
+	if opts.Attest != nil {
+		k.Attest = *opts.Attest
+	}
+	if opts.Propose != nil {
+		k.Propose = *opts.Propose
+	}
+}
+
+// RenderGraffiti renders the graffiti template of the key for the validator with the given
+// index. Graffiti longer than 32 bytes is truncated.
+func (k *KeyConfig) RenderGraffiti(pubKey [48]byte, index uint64) ([]byte, error) {
+	tmpl, err := template.New("graffiti").Parse(k.Graffiti)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid graffiti template")
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, &GraffitiData{
+		Index:     index,
+		PublicKey: fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])),
+		Version:   version.SemanticVersion(),
+	}); err != nil {
+		return nil, errors.Wrap(err, "could not render graffiti template")
+	}
+	graffiti := buf.Bytes()
+	if len(graffiti) > graffitiLength {
+		graffiti = graffiti[:graffitiLength]
+	}
+	return graffiti, nil
+}
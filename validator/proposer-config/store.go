@@ -0,0 +1,160 @@
+package proposerconfig
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/asyncutil"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	log                         = logrus.WithField("prefix", "proposer-config")
+	debounceFileChangesInterval = time.Second
+)
+
+// Store holds the proposer config loaded from a file, reloading it whenever the file changes.
+type Store struct {
+	path             string
+	fallbackGraffiti string
+	lock             sync.RWMutex
+	config           *Config
+	keyConfigs       map[[48]byte]*KeyConfig
+	defaultConfig    *KeyConfig
+}
+
+// NewStore loads the proposer config file at the given path. The fallback graffiti is used
+// for keys without a graffiti in the config file.
+func NewStore(path, fallbackGraffiti string) (*Store, error) {
+	expanded, err := fileutil.ExpandPath(path)
+	if err != nil {
+		return nil, err
+	}
+	if !fileutil.FileExists(expanded) {
+		return nil, errors.Errorf("proposer config file %s does not exist", expanded)
+	}
+	s := &Store{
+		path:             expanded,
+		fallbackGraffiti: fallbackGraffiti,
+	}
+	if err := s.reload(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Path of the proposer config file.
+func (s *Store) Path() string {
+	return s.path
+}
+
+// Config returns the proposer config as currently loaded.
+func (s *Store) Config() *Config {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.config
+}
+
+// For returns the effective configuration of the given public key. A nil store
+// returns the configuration in effect without a proposer config file.
+func (s *Store) For(pubKey [48]byte) *KeyConfig {
+	if s == nil {
+		return &KeyConfig{Enabled: true, Attest: true, Propose: true}
+	}
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if keyConfig, ok := s.keyConfigs[pubKey]; ok {
+		return keyConfig
+	}
+	return s.defaultConfig
+}
+
+// Update validates the proposer config, writes it to the proposer config file and
+// applies it immediately.
+func (s *Store) Update(cfg *Config) error {
+	if err := cfg.validate(); err != nil {
+		return err
+	}
+	enc, err := cfg.Marshal()
+	if err != nil {
+		return errors.Wrap(err, "could not encode proposer config")
+	}
+	if err := fileutil.WriteFile(s.path, enc); err != nil {
+		return errors.Wrap(err, "could not write proposer config file")
+	}
+	s.set(cfg)
+	return nil
+}
+
+func (s *Store) reload() error {
+	enc, err := ioutil.ReadFile(s.path)
+	if err != nil {
+		return errors.Wrap(err, "could not read proposer config file")
+	}
+	cfg, err := Parse(enc)
+	if err != nil {
+		return err
+	}
+	s.set(cfg)
+	return nil
+}
+
+func (s *Store) set(cfg *Config) {
+	keyConfigs, defaultConfig := cfg.keyConfigs(s.fallbackGraffiti)
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.config = cfg
+	s.keyConfigs = keyConfigs
+	s.defaultConfig = defaultConfig
+}
+
+// Watch reloads the proposer config whenever the file changes until the context is canceled.
+// The directory of the file is watched rather than the file itself, as editors commonly replace
+// a file when saving it. Invalid configs are logged and the previous config stays in effect.
+func (s *Store) Watch(ctx context.Context) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		log.WithError(err).Error("Could not initialize file watcher")
+		return
+	}
+	defer func() {
+		if err := watcher.Close(); err != nil {
+			log.WithError(err).Error("Could not close file watcher")
+		}
+	}()
+	if err := watcher.Add(filepath.Dir(s.path)); err != nil {
+		log.WithError(err).Errorf("Could not add directory of %s to file watcher", s.path)
+		return
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	fileChangesChan := make(chan interface{}, 100)
+	defer close(fileChangesChan)
+
+	go asyncutil.Debounce(ctx, debounceFileChangesInterval, fileChangesChan, func(interface{}) {
+		if err := s.reload(); err != nil {
+			log.WithError(err).Error("Could not reload proposer config, keeping the previous one")
+			return
+		}
+		log.WithField("path", s.path).Info("Reloaded proposer config")
+	})
+	for {
+		select {
+		case event := <-watcher.Events:
+			if filepath.Clean(event.Name) != s.path || event.Op&(fsnotify.Write|fsnotify.Create) == 0 {
+				continue
+			}
+			fileChangesChan <- event
+		case err := <-watcher.Errors:
+			log.WithError(err).Errorf("Could not watch for file changes for: %s", s.path)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
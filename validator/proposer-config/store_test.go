@@ -0,0 +1,156 @@
+package proposerconfig
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+)
+
+var (
+	pubKey1 = [48]byte{1}
+	pubKey2 = [48]byte{2}
+)
+
+func writeConfig(t *testing.T, path, content string) {
+	require.NoError(t, fileutil.WriteFile(path, []byte(content)))
+}
+
+func TestParse(t *testing.T) {
+	yamlConfig := fmt.Sprintf(`
+default:
+  graffiti: "prysm"
+  propose: false
+keys:
+  "%#x":
+    graffiti: "validator {{.Index}}"
+    propose: true
+  "%#x":
+    enabled: false
+`, pubKey1, pubKey2)
+	cfg, err := Parse([]byte(yamlConfig))
+	require.NoError(t, err)
+	assert.Equal(t, "prysm", *cfg.Default.Graffiti)
+	assert.Equal(t, 2, len(cfg.Keys))
+
+	// JSON is a subset of YAML.
+	jsonConfig := fmt.Sprintf(`{"keys": {"%#x": {"attest": false}}}`, pubKey1)
+	cfg, err = Parse([]byte(jsonConfig))
+	require.NoError(t, err)
+	assert.Equal(t, false, *cfg.Keys[fmt.Sprintf("%#x", pubKey1)].Attest)
+
+	_, err = Parse([]byte(`keys: {"0x1234": {}}`))
+	assert.ErrorContains(t, "0x1234 is not a valid public key", err)
+	_, err = Parse([]byte(`default: {graffiti: "{{.Index"}`))
+	assert.ErrorContains(t, "invalid graffiti template", err)
+}
+
+func TestStore_For(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "proposer-config.yaml")
+	writeConfig(t, path, fmt.Sprintf(`
+default:
+  propose: false
+keys:
+  "%#x":
+    graffiti: "validator {{.Index}} {{.PublicKey}}"
+    propose: true
+`, pubKey1))
+	s, err := NewStore(path, "fallback")
+	require.NoError(t, err)
+
+	keyConfig := s.For(pubKey1)
+	assert.Equal(t, true, keyConfig.Propose)
+	assert.Equal(t, true, keyConfig.Attest)
+	graffiti, err := keyConfig.RenderGraffiti(pubKey1, 12)
+	require.NoError(t, err)
+	assert.Equal(t, "validator 12 0x010000000000", string(graffiti))
+
+	// Keys without a section use the default section, and the fallback graffiti.
+	keyConfig = s.For(pubKey2)
+	assert.Equal(t, false, keyConfig.Propose)
+	assert.Equal(t, true, keyConfig.Enabled)
+	graffiti, err = keyConfig.RenderGraffiti(pubKey2, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "fallback", string(graffiti))
+
+	// Without a store, all duties are performed.
+	var nilStore *Store
+	assert.DeepEqual(t, &KeyConfig{Enabled: true, Attest: true, Propose: true}, nilStore.For(pubKey1))
+}
+
+func TestKeyConfig_RenderGraffiti_Truncates(t *testing.T) {
+	keyConfig := &KeyConfig{Graffiti: "a very long graffiti exceeding the maximum length of {{.Index}}"}
+	graffiti, err := keyConfig.RenderGraffiti(pubKey1, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "a very long graffiti exceeding t", string(graffiti))
+}
+
+func TestStore_Update(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "proposer-config.yaml")
+	writeConfig(t, path, "{}")
+	s, err := NewStore(path, "")
+	require.NoError(t, err)
+
+	disabled := false
+	require.NoError(t, s.Update(&Config{Keys: map[string]*Options{
+		fmt.Sprintf("%#x", pubKey1): {Enabled: &disabled},
+	}}))
+	assert.Equal(t, false, s.For(pubKey1).Enabled)
+
+	// The update is persisted to the proposer config file.
+	enc, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	cfg, err := Parse(enc)
+	require.NoError(t, err)
+	assert.DeepEqual(t, s.Config(), cfg)
+
+	assert.ErrorContains(t, "not a valid public key", s.Update(&Config{Keys: map[string]*Options{"0x12": {}}}))
+}
+
+func TestStore_Watch(t *testing.T) {
+	hook := logTest.NewGlobal()
+	debounceFileChangesInterval = 10 * time.Millisecond
+	defer func() {
+		debounceFileChangesInterval = time.Second
+	}()
+	path := filepath.Join(t.TempDir(), "proposer-config.yaml")
+	writeConfig(t, path, "{}")
+	s, err := NewStore(path, "")
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.Watch(ctx)
+	time.Sleep(100 * time.Millisecond)
+
+	writeConfig(t, path, fmt.Sprintf(`keys: {"%#x": {attest: false}}`, pubKey1))
+	require.NoError(t, waitFor(func() bool { return !s.For(pubKey1).Attest }))
+
+	// Invalid configs are ignored.
+	writeConfig(t, path, `keys: {"0x12": {}}`)
+	require.NoError(t, waitFor(func() bool {
+		for _, entry := range hook.AllEntries() {
+			if entry.Message == "Could not reload proposer config, keeping the previous one" {
+				return true
+			}
+		}
+		return false
+	}))
+	assert.Equal(t, false, s.For(pubKey1).Attest)
+}
+
+func waitFor(cond func() bool) error {
+	for i := 0; i < 100; i++ {
+		if cond() {
+			return nil
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	return fmt.Errorf("condition not met")
+}
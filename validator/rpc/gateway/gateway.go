@@ -62,6 +62,9 @@ func (g *Gateway) Start() {
 		pb.RegisterWalletHandlerFromEndpoint,
 		pb.RegisterHealthHandlerFromEndpoint,
 		pb.RegisterAccountsHandlerFromEndpoint,
+		pb.RegisterSlashingProtectionHandlerFromEndpoint,
+		pb.RegisterProposerConfigHandlerFromEndpoint,
+		pb.RegisterPerformanceHandlerFromEndpoint,
 	}
 	for _, h := range handlers {
 		if err := h(ctx, gwmux, g.remoteAddr, opts); err != nil {
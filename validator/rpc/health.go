@@ -32,3 +32,50 @@ func (s *Server) GetBeaconNodeConnection(ctx context.Context, _ *ptypes.Empty) (
 		Syncing:                syncStatus,
 	}, nil
 }
+
+// GetDoppelgangerStatus retrieves the state of the doppelganger protection of the
+// validator client and whether a doppelganger was detected for any of its keys.
+func (s *Server) GetDoppelgangerStatus(_ context.Context, _ *ptypes.Empty) (*pb.DoppelgangerStatusResponse, error) {
+	if s.validatorService == nil {
+		return &pb.DoppelgangerStatusResponse{}, nil
+	}
+	report := s.validatorService.DoppelgangerStatus()
+	statuses := make([]*pb.DoppelgangerStatusResponse_KeyStatus, len(report.Statuses))
+	for i, st := range report.Statuses {
+		statuses[i] = &pb.DoppelgangerStatusResponse_KeyStatus{
+			PublicKey:            st.PublicKey[:],
+			DoppelgangerDetected: st.Detected,
+			DetectedEpoch:        st.DetectedEpoch,
+		}
+	}
+	return &pb.DoppelgangerStatusResponse{
+		Enabled:  report.Enabled,
+		Checking: report.Checking,
+		Statuses: statuses,
+	}, nil
+}
+
+// GetBeaconNodesHealth retrieves the health of each beacon node the validator client
+// is configured with and whether its requests are currently routed to it.
+func (s *Server) GetBeaconNodesHealth(_ context.Context, _ *ptypes.Empty) (*pb.BeaconNodesHealthResponse, error) {
+	if s.validatorService == nil {
+		return &pb.BeaconNodesHealthResponse{}, nil
+	}
+	healths := s.validatorService.BeaconNodesHealth()
+	nodes := make([]*pb.BeaconNodesHealthResponse_NodeHealth, len(healths))
+	for i, health := range healths {
+		nodes[i] = &pb.BeaconNodesHealthResponse_NodeHealth{
+			Endpoint:  health.Endpoint,
+			Active:    health.Active,
+			Healthy:   health.Healthy,
+			Connected: health.Connected,
+			Syncing:   health.Syncing,
+			HeadSlot:  health.HeadSlot,
+			SlotLag:   health.SlotLag,
+			PeerCount: health.PeerCount,
+			ErrorRate: health.ErrorRate,
+			LastError: health.LastError,
+		}
+	}
+	return &pb.BeaconNodesHealthResponse{Nodes: nodes}, nil
+}
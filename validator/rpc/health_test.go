@@ -55,3 +55,36 @@ func TestServer_GetBeaconNodeConnection(t *testing.T) {
 	}
 	require.DeepEqual(t, want, got)
 }
+
+func TestServer_GetDoppelgangerStatus(t *testing.T) {
+	ctx := context.Background()
+	vs, err := client.NewValidatorService(ctx, &client.Config{DoppelgangerEpochs: 2})
+	require.NoError(t, err)
+	s := &Server{validatorService: vs}
+	got, err := s.GetDoppelgangerStatus(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	require.DeepEqual(t, &pb.DoppelgangerStatusResponse{Enabled: true, Statuses: []*pb.DoppelgangerStatusResponse_KeyStatus{}}, got)
+
+	vs, err = client.NewValidatorService(ctx, &client.Config{})
+	require.NoError(t, err)
+	s = &Server{validatorService: vs}
+	got, err = s.GetDoppelgangerStatus(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	require.DeepEqual(t, &pb.DoppelgangerStatusResponse{Statuses: []*pb.DoppelgangerStatusResponse_KeyStatus{}}, got)
+}
+
+func TestServer_GetBeaconNodesHealth(t *testing.T) {
+	ctx := context.Background()
+	s := &Server{}
+	got, err := s.GetBeaconNodesHealth(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	require.DeepEqual(t, &pb.BeaconNodesHealthResponse{}, got)
+
+	// The validator service does not report any beacon node before it is started.
+	vs, err := client.NewValidatorService(ctx, &client.Config{Endpoint: "localhost:4000"})
+	require.NoError(t, err)
+	s.validatorService = vs
+	got, err = s.GetBeaconNodesHealth(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	require.DeepEqual(t, &pb.BeaconNodesHealthResponse{Nodes: []*pb.BeaconNodesHealthResponse_NodeHealth{}}, got)
+}
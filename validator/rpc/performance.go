@@ -0,0 +1,77 @@
+package rpc
+
+import (
+	"context"
+
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetPerformanceHistory returns the per-epoch performance history of the requested keys
+// recorded in the validator database, as a time series ordered by epoch.
+func (s *Server) GetPerformanceHistory(
+	ctx context.Context, req *pb.PerformanceHistoryRequest,
+) (*pb.PerformanceHistoryResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "validator.GetPerformanceHistory")
+	defer span.End()
+
+	if s.valDB == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator database not yet initialized")
+	}
+	endEpoch := req.EndEpoch
+	if endEpoch == 0 {
+		endEpoch = ^uint64(0)
+	}
+	if req.StartEpoch > endEpoch {
+		return nil, status.Errorf(
+			codes.InvalidArgument, "Start epoch %d is after end epoch %d", req.StartEpoch, endEpoch,
+		)
+	}
+	publicKeys := make([][48]byte, len(req.PublicKeys))
+	for i, pubKey := range req.PublicKeys {
+		if len(pubKey) != 48 {
+			return nil, status.Errorf(codes.InvalidArgument, "Public key %#x is not 48 bytes long", pubKey)
+		}
+		publicKeys[i] = bytesutil.ToBytes48(pubKey)
+	}
+	if len(publicKeys) == 0 {
+		var err error
+		publicKeys, err = s.valDB.ValidatorPerformancePublicKeys(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve public keys: %v", err)
+		}
+	}
+
+	keys := make([]*pb.PerformanceHistoryResponse_KeyHistory, len(publicKeys))
+	for i, pubKey := range publicKeys {
+		records, err := s.valDB.ValidatorPerformanceForPubKey(ctx, pubKey, req.StartEpoch, endEpoch)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve performance history of %#x: %v", pubKey, err)
+		}
+		epochs := make([]*pb.PerformanceHistoryResponse_EpochPerformance, len(records))
+		for j, record := range records {
+			epochs[j] = &pb.PerformanceHistoryResponse_EpochPerformance{
+				Epoch:                record.Epoch,
+				InclusionSlot:        record.InclusionSlot,
+				InclusionDistance:    record.InclusionDistance,
+				CorrectlyVotedSource: record.CorrectlyVotedSource,
+				CorrectlyVotedTarget: record.CorrectlyVotedTarget,
+				CorrectlyVotedHead:   record.CorrectlyVotedHead,
+				BalanceBefore:        record.BalanceBefore,
+				BalanceAfter:         record.BalanceAfter,
+				ProposalsAssigned:    record.ProposalsAssigned,
+				ProposalsMissed:      record.ProposalsMissed,
+			}
+		}
+		keys[i] = &pb.PerformanceHistoryResponse_KeyHistory{
+			PublicKey: bytesutil.SafeCopyBytes(pubKey[:]),
+			Epochs:    epochs,
+		}
+	}
+	return &pb.PerformanceHistoryResponse{
+		Keys: keys,
+	}, nil
+}
@@ -0,0 +1,53 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+)
+
+func TestServer_GetPerformanceHistory(t *testing.T) {
+	ctx := context.Background()
+	valDB := dbtest.SetupDB(t, [][48]byte{})
+	s := &Server{valDB: valDB}
+
+	require.NoError(t, valDB.SaveValidatorPerformance(ctx, []*kv.ValidatorPerformance{
+		{PublicKey: [48]byte{1}, Epoch: 1, InclusionSlot: 33, InclusionDistance: 1, CorrectlyVotedTarget: true},
+		{PublicKey: [48]byte{1}, Epoch: 2, InclusionSlot: ^uint64(0), ProposalsAssigned: 1, ProposalsMissed: 1},
+		{PublicKey: [48]byte{2}, Epoch: 2, BalanceBefore: 32000000000, BalanceAfter: 32000010000},
+	}))
+
+	// All keys are returned by default.
+	res, err := s.GetPerformanceHistory(ctx, &pb.PerformanceHistoryRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Keys))
+	require.Equal(t, 2, len(res.Keys[0].Epochs))
+	assert.Equal(t, uint64(1), res.Keys[0].Epochs[0].Epoch)
+	assert.Equal(t, uint64(1), res.Keys[0].Epochs[0].InclusionDistance)
+	assert.Equal(t, true, res.Keys[0].Epochs[0].CorrectlyVotedTarget)
+	assert.Equal(t, uint64(1), res.Keys[0].Epochs[1].ProposalsMissed)
+	assert.Equal(t, uint64(32000010000), res.Keys[1].Epochs[0].BalanceAfter)
+
+	// The history is restricted to the requested keys and epochs.
+	key := [48]byte{1}
+	res, err = s.GetPerformanceHistory(ctx, &pb.PerformanceHistoryRequest{
+		PublicKeys: [][]byte{key[:]},
+		StartEpoch: 2,
+		EndEpoch:   2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(res.Keys))
+	assert.DeepEqual(t, key[:], res.Keys[0].PublicKey)
+	require.Equal(t, 1, len(res.Keys[0].Epochs))
+	assert.Equal(t, uint64(2), res.Keys[0].Epochs[0].Epoch)
+
+	_, err = s.GetPerformanceHistory(ctx, &pb.PerformanceHistoryRequest{PublicKeys: [][]byte{{1}}})
+	assert.ErrorContains(t, "not 48 bytes long", err)
+	_, err = s.GetPerformanceHistory(ctx, &pb.PerformanceHistoryRequest{StartEpoch: 3, EndEpoch: 2})
+	assert.ErrorContains(t, "is after end epoch", err)
+}
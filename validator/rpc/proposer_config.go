@@ -0,0 +1,86 @@
+package rpc
+
+import (
+	"context"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	proposerconfig "github.com/prysmaticlabs/prysm/validator/proposer-config"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetProposerConfig returns the content of the proposer config file and the
+// resulting configuration of each validating key.
+func (s *Server) GetProposerConfig(ctx context.Context, _ *ptypes.Empty) (*pb.ProposerConfigResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "validator.GetProposerConfig")
+	defer span.End()
+
+	store, err := s.proposerConfig()
+	if err != nil {
+		return nil, err
+	}
+	return s.proposerConfigResponse(ctx, store)
+}
+
+// UpdateProposerConfig replaces the content of the proposer config file. The new
+// configuration applies to the validator client immediately.
+func (s *Server) UpdateProposerConfig(
+	ctx context.Context, req *pb.UpdateProposerConfigRequest,
+) (*pb.ProposerConfigResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "validator.UpdateProposerConfig")
+	defer span.End()
+
+	store, err := s.proposerConfig()
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := proposerconfig.Parse([]byte(req.Config))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid proposer config: %v", err)
+	}
+	if err := store.Update(cfg); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not update proposer config: %v", err)
+	}
+	return s.proposerConfigResponse(ctx, store)
+}
+
+func (s *Server) proposerConfig() (*proposerconfig.Store, error) {
+	if s.validatorService == nil || s.validatorService.ProposerConfig() == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator client was not started with a proposer config file")
+	}
+	return s.validatorService.ProposerConfig(), nil
+}
+
+func (s *Server) proposerConfigResponse(
+	ctx context.Context, store *proposerconfig.Store,
+) (*pb.ProposerConfigResponse, error) {
+	enc, err := store.Config().Marshal()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not encode proposer config: %v", err)
+	}
+	keys := make([]*pb.ProposerConfigResponse_KeyConfig, 0)
+	if s.keymanager != nil {
+		pubKeys, err := s.keymanager.FetchValidatingPublicKeys(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not retrieve public keys: %v", err)
+		}
+		for _, pubKey := range pubKeys {
+			keyConfig := store.For(pubKey)
+			keys = append(keys, &pb.ProposerConfigResponse_KeyConfig{
+				PublicKey: bytesutil.SafeCopyBytes(pubKey[:]),
+				Graffiti:  keyConfig.Graffiti,
+				Enabled:   keyConfig.Enabled,
+				Attest:    keyConfig.Attest,
+				Propose:   keyConfig.Propose,
+			})
+		}
+	}
+	return &pb.ProposerConfigResponse{
+		Path:   store.Path(),
+		Config: string(enc),
+		Keys:   keys,
+	}, nil
+}
@@ -0,0 +1,79 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	ptypes "github.com/gogo/protobuf/types"
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/accounts"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/client"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
+	proposerconfig "github.com/prysmaticlabs/prysm/validator/proposer-config"
+)
+
+func TestServer_ProposerConfig(t *testing.T) {
+	ctx := context.Background()
+	w, err := accounts.CreateWalletWithKeymanager(ctx, &accounts.CreateWalletConfig{
+		WalletCfg: &wallet.Config{
+			WalletDir:      setupWalletDir(t),
+			KeymanagerKind: keymanager.Derived,
+			WalletPassword: "29384283xasjasd32%%&*@*#*",
+		},
+		SkipMnemonicConfirm: true,
+	})
+	require.NoError(t, err)
+	km, err := w.InitializeKeymanager(ctx)
+	require.NoError(t, err)
+	dr, ok := km.(*derived.Keymanager)
+	require.Equal(t, true, ok)
+	require.NoError(t, dr.RecoverAccountsFromMnemonic(ctx, testMnemonic, "", 2))
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "proposer-config.yaml")
+	require.NoError(t, fileutil.WriteFile(path, []byte(`default: {graffiti: "prysm"}`)))
+	store, err := proposerconfig.NewStore(path, "")
+	require.NoError(t, err)
+	vs, err := client.NewValidatorService(ctx, &client.Config{ProposerConfig: store})
+	require.NoError(t, err)
+	s := &Server{validatorService: vs, keymanager: km}
+
+	resp, err := s.GetProposerConfig(ctx, &ptypes.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, path, resp.Path)
+	require.Equal(t, 2, len(resp.Keys))
+	assert.DeepEqual(t, &pb.ProposerConfigResponse_KeyConfig{
+		PublicKey: pubKeys[0][:],
+		Graffiti:  "prysm",
+		Enabled:   true,
+		Attest:    true,
+		Propose:   true,
+	}, resp.Keys[0])
+
+	resp, err = s.UpdateProposerConfig(ctx, &pb.UpdateProposerConfigRequest{
+		Config: fmt.Sprintf(`{"keys": {"%#x": {"propose": false}}}`, pubKeys[1]),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "", resp.Keys[0].Graffiti)
+	assert.Equal(t, true, resp.Keys[0].Propose)
+	assert.Equal(t, false, resp.Keys[1].Propose)
+	assert.Equal(t, false, store.For(pubKeys[1]).Propose)
+
+	_, err = s.UpdateProposerConfig(ctx, &pb.UpdateProposerConfigRequest{Config: `keys: {"0x12": {}}`})
+	assert.ErrorContains(t, "Invalid proposer config", err)
+
+	// The proposer config can only be edited if the validator client uses a proposer config file.
+	vs, err = client.NewValidatorService(ctx, &client.Config{})
+	require.NoError(t, err)
+	s = &Server{validatorService: vs, keymanager: km}
+	_, err = s.GetProposerConfig(ctx, &ptypes.Empty{})
+	assert.ErrorContains(t, "not started with a proposer config file", err)
+}
@@ -152,6 +152,9 @@ func (s *Server) Start() {
 	pb.RegisterWalletServer(s.grpcServer, s)
 	pb.RegisterHealthServer(s.grpcServer, s)
 	pb.RegisterAccountsServer(s.grpcServer, s)
+	pb.RegisterSlashingProtectionServer(s.grpcServer, s)
+	pb.RegisterProposerConfigServer(s.grpcServer, s)
+	pb.RegisterPerformanceServer(s.grpcServer, s)
 
 	go func() {
 		if s.listener != nil {
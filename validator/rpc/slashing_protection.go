@@ -0,0 +1,88 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	interchangeformat "github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ExportSlashingProtection exports the slashing protection history of the requested
+// keys from the validator database as an EIP-3076 slashing protection JSON file.
+func (s *Server) ExportSlashingProtection(
+	ctx context.Context, req *pb.ExportSlashingProtectionRequest,
+) (*pb.ExportSlashingProtectionResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "validator.ExportSlashingProtection")
+	defer span.End()
+
+	if s.valDB == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator database not yet initialized")
+	}
+	publicKeys := make([][48]byte, len(req.PublicKeys))
+	for i, pubKey := range req.PublicKeys {
+		if len(pubKey) != 48 {
+			return nil, status.Errorf(codes.InvalidArgument, "Public key %#x is not 48 bytes long", pubKey)
+		}
+		publicKeys[i] = bytesutil.ToBytes48(pubKey)
+	}
+	eipJSON, err := interchangeformat.ExportStandardProtectionJSONWithOptions(
+		ctx, s.valDB, &interchangeformat.ExportOptions{
+			PublicKeys: publicKeys,
+			Minimal:    req.Minimal,
+		},
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not export slashing protection data: %v", err)
+	}
+	encoded, err := json.MarshalIndent(eipJSON, "", "\t")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not encode slashing protection data: %v", err)
+	}
+	return &pb.ExportSlashingProtectionResponse{
+		File: string(encoded),
+	}, nil
+}
+
+// ImportSlashingProtection merges an EIP-3076 slashing protection JSON file into the
+// validator database and reports what was imported for each key.
+func (s *Server) ImportSlashingProtection(
+	ctx context.Context, req *pb.ImportSlashingProtectionRequest,
+) (*pb.ImportSlashingProtectionResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "validator.ImportSlashingProtection")
+	defer span.End()
+
+	if s.valDB == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator database not yet initialized")
+	}
+	if req.SlashingProtectionJson == "" {
+		return nil, status.Error(codes.InvalidArgument, "Empty slashing protection JSON specified")
+	}
+	report, err := interchangeformat.ImportStandardProtectionJSONWithOptions(
+		ctx, s.valDB, strings.NewReader(req.SlashingProtectionJson), &interchangeformat.ImportOptions{
+			DryRun: req.DryRun,
+		},
+	)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not import slashing protection data: %v", err)
+	}
+	keys := make([]*pb.ImportSlashingProtectionResponse_KeyReport, len(report.Keys))
+	for i, k := range report.Keys {
+		keys[i] = &pb.ImportSlashingProtectionResponse_KeyReport{
+			PublicKey:            k.PublicKey[:],
+			NewBlocks:            uint64(k.NewBlocks),
+			ExistingBlocks:       uint64(k.ExistingBlocks),
+			NewAttestations:      uint64(k.NewAttestations),
+			ExistingAttestations: uint64(k.ExistingAttestations),
+		}
+	}
+	return &pb.ImportSlashingProtectionResponse{
+		DryRun: report.DryRun,
+		Keys:   keys,
+	}, nil
+}
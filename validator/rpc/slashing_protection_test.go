@@ -0,0 +1,101 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	pb "github.com/prysmaticlabs/prysm/proto/validator/accounts/v2"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+	interchangeformat "github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format"
+)
+
+func TestServer_ImportExportSlashingProtection(t *testing.T) {
+	ctx := context.Background()
+	valDB := dbtest.SetupDB(t, [][48]byte{})
+	s := &Server{valDB: valDB}
+
+	secretKey, err := bls.RandKey()
+	require.NoError(t, err)
+	pubKey := secretKey.PublicKey().Marshal()
+	eipJSON := &interchangeformat.EIPSlashingProtectionFormat{}
+	eipJSON.Metadata.InterchangeFormatVersion = interchangeformat.INTERCHANGE_FORMAT_VERSION
+	eipJSON.Metadata.GenesisValidatorsRoot = fmt.Sprintf("%#x", [32]byte{1})
+	eipJSON.Data = []*interchangeformat.ProtectionData{
+		{
+			Pubkey: fmt.Sprintf("%#x", pubKey),
+			SignedBlocks: []*interchangeformat.SignedBlock{
+				{Slot: "2", SigningRoot: fmt.Sprintf("%#x", [32]byte{2})},
+				{Slot: "5", SigningRoot: fmt.Sprintf("%#x", [32]byte{5})},
+			},
+			SignedAttestations: []*interchangeformat.SignedAttestation{
+				{SourceEpoch: "1", TargetEpoch: "2", SigningRoot: fmt.Sprintf("%#x", [32]byte{3})},
+			},
+		},
+	}
+	encoded, err := json.Marshal(eipJSON)
+	require.NoError(t, err)
+
+	// A dry run reports the data without importing it.
+	res, err := s.ImportSlashingProtection(ctx, &pb.ImportSlashingProtectionRequest{
+		SlashingProtectionJson: string(encoded),
+		DryRun:                 true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, true, res.DryRun)
+	require.Equal(t, 1, len(res.Keys))
+	assert.DeepEqual(t, pubKey, res.Keys[0].PublicKey)
+	assert.Equal(t, uint64(2), res.Keys[0].NewBlocks)
+	assert.Equal(t, uint64(1), res.Keys[0].NewAttestations)
+	proposedKeys, err := valDB.ProposedPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(proposedKeys))
+
+	res, err = s.ImportSlashingProtection(ctx, &pb.ImportSlashingProtectionRequest{
+		SlashingProtectionJson: string(encoded),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, false, res.DryRun)
+	assert.Equal(t, uint64(2), res.Keys[0].NewBlocks)
+
+	// Importing the same file again finds all of its data in the database.
+	res, err = s.ImportSlashingProtection(ctx, &pb.ImportSlashingProtectionRequest{
+		SlashingProtectionJson: string(encoded),
+		DryRun:                 true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), res.Keys[0].NewBlocks)
+	assert.Equal(t, uint64(2), res.Keys[0].ExistingBlocks)
+	assert.Equal(t, uint64(0), res.Keys[0].NewAttestations)
+	assert.Equal(t, uint64(1), res.Keys[0].ExistingAttestations)
+
+	exported, err := s.ExportSlashingProtection(ctx, &pb.ExportSlashingProtectionRequest{
+		PublicKeys: [][]byte{pubKey},
+		Minimal:    true,
+	})
+	require.NoError(t, err)
+	minimalJSON := &interchangeformat.EIPSlashingProtectionFormat{}
+	require.NoError(t, json.Unmarshal([]byte(exported.File), minimalJSON))
+	require.Equal(t, 1, len(minimalJSON.Data))
+	assert.DeepEqual(t, []*interchangeformat.SignedBlock{{Slot: "5"}}, minimalJSON.Data[0].SignedBlocks)
+	assert.DeepEqual(t, []*interchangeformat.SignedAttestation{{SourceEpoch: "1", TargetEpoch: "2"}}, minimalJSON.Data[0].SignedAttestations)
+}
+
+func TestServer_SlashingProtection_InvalidRequests(t *testing.T) {
+	ctx := context.Background()
+	s := &Server{}
+	_, err := s.ExportSlashingProtection(ctx, &pb.ExportSlashingProtectionRequest{})
+	assert.ErrorContains(t, "Validator database not yet initialized", err)
+
+	s.valDB = dbtest.SetupDB(t, [][48]byte{})
+	_, err = s.ExportSlashingProtection(ctx, &pb.ExportSlashingProtectionRequest{PublicKeys: [][]byte{{1}}})
+	assert.ErrorContains(t, "is not 48 bytes long", err)
+	_, err = s.ImportSlashingProtection(ctx, &pb.ImportSlashingProtectionRequest{})
+	assert.ErrorContains(t, "Empty slashing protection JSON specified", err)
+	_, err = s.ImportSlashingProtection(ctx, &pb.ImportSlashingProtectionRequest{SlashingProtectionJson: "{"})
+	assert.ErrorContains(t, "Could not import slashing protection data", err)
+}
@@ -0,0 +1,216 @@
+package slashingprotection
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/validator/flags"
+	interchangeformat "github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format"
+	log "github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+)
+
+// ExportFileName is the name of the EIP-3076 slashing protection file written by the export command.
+const ExportFileName = "slashing_protection.json"
+
+// Commands for importing and exporting EIP-3076 slashing protection data while the validator is stopped.
+var Commands = &cli.Command{
+	Name:     "slashing-protection",
+	Category: "slashing-protection",
+	Usage:    "defines commands for importing and exporting EIP-3076 slashing protection data while the validator is stopped",
+	Subcommands: []*cli.Command{
+		{
+			Name: "export",
+			Usage: `exports the slashing protection history of the validator database to an EIP-3076 ` +
+				`slashing protection JSON file, either in full or in the minimal form`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				cmd.DataDirFlag,
+				flags.SlashingProtectionExportDirFlag,
+				flags.SlashingProtectionPublicKeysFlag,
+				flags.SlashingProtectionMinimalFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				return cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags)
+			},
+			Action: exportSlashingProtectionCli,
+		},
+		{
+			Name: "import",
+			Usage: `imports an EIP-3076 slashing protection JSON file into the validator database, ` +
+				`merging it with the existing slashing protection history`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.WalletDirFlag,
+				cmd.DataDirFlag,
+				flags.SlashingProtectionJSONFileFlag,
+				flags.SlashingProtectionDryRunFlag,
+			}),
+			Before: func(cliCtx *cli.Context) error {
+				return cmd.LoadFlagsFromConfig(cliCtx, cliCtx.Command.Flags)
+			},
+			Action: importSlashingProtectionCli,
+		},
+	},
+}
+
+func exportSlashingProtectionCli(cliCtx *cli.Context) error {
+	outputDir, err := fileutil.ExpandPath(cliCtx.String(flags.SlashingProtectionExportDirFlag.Name))
+	if err != nil {
+		return err
+	}
+	if outputDir == "" {
+		return fmt.Errorf("no output directory specified, please use the --%s flag", flags.SlashingProtectionExportDirFlag.Name)
+	}
+	publicKeys, err := parsePublicKeys(cliCtx.String(flags.SlashingProtectionPublicKeysFlag.Name))
+	if err != nil {
+		return err
+	}
+	dataDir, err := validatorDBDir(cliCtx)
+	if err != nil {
+		return err
+	}
+	if !fileutil.FileExists(filepath.Join(dataDir, kv.ProtectionDbFileName)) {
+		return fmt.Errorf("no validator database found in %s", dataDir)
+	}
+	validatorDB, err := kv.NewKVStore(dataDir, nil)
+	if err != nil {
+		return errors.Wrapf(err, "could not open validator database in %s", dataDir)
+	}
+	defer func() {
+		if err := validatorDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close validator database")
+		}
+	}()
+
+	eipJSON, err := interchangeformat.ExportStandardProtectionJSONWithOptions(
+		cliCtx.Context, validatorDB, &interchangeformat.ExportOptions{
+			PublicKeys: publicKeys,
+			Minimal:    cliCtx.Bool(flags.SlashingProtectionMinimalFlag.Name),
+		},
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not export slashing protection data")
+	}
+	for _, data := range eipJSON.Data {
+		if len(data.SignedBlocks) == 0 && len(data.SignedAttestations) == 0 {
+			log.WithField("publicKey", data.Pubkey).Warn("No slashing protection history found for public key")
+		}
+	}
+	encoded, err := json.MarshalIndent(eipJSON, "", "\t")
+	if err != nil {
+		return errors.Wrap(err, "could not encode slashing protection data")
+	}
+	if err := fileutil.MkdirAll(outputDir); err != nil {
+		return errors.Wrapf(err, "could not create directory %s", outputDir)
+	}
+	outputFile := filepath.Join(outputDir, ExportFileName)
+	if err := fileutil.WriteFile(outputFile, encoded); err != nil {
+		return errors.Wrapf(err, "could not write file %s", outputFile)
+	}
+	log.WithFields(log.Fields{
+		"file":       outputFile,
+		"publicKeys": len(eipJSON.Data),
+	}).Info("Exported slashing protection data")
+	return nil
+}
+
+func importSlashingProtectionCli(cliCtx *cli.Context) error {
+	jsonFile, err := fileutil.ExpandPath(cliCtx.String(flags.SlashingProtectionJSONFileFlag.Name))
+	if err != nil {
+		return err
+	}
+	if jsonFile == "" {
+		return fmt.Errorf("no slashing protection file specified, please use the --%s flag", flags.SlashingProtectionJSONFileFlag.Name)
+	}
+	enc, err := fileutil.ReadFileAsBytes(jsonFile)
+	if err != nil {
+		return errors.Wrapf(err, "could not read file %s", jsonFile)
+	}
+	dataDir, err := validatorDBDir(cliCtx)
+	if err != nil {
+		return err
+	}
+	validatorDB, err := kv.NewKVStore(dataDir, nil)
+	if err != nil {
+		return errors.Wrapf(err, "could not open validator database in %s", dataDir)
+	}
+	defer func() {
+		if err := validatorDB.Close(); err != nil {
+			log.WithError(err).Error("Could not close validator database")
+		}
+	}()
+
+	report, err := interchangeformat.ImportStandardProtectionJSONWithOptions(
+		cliCtx.Context, validatorDB, bytes.NewReader(enc), &interchangeformat.ImportOptions{
+			DryRun: cliCtx.Bool(flags.SlashingProtectionDryRunFlag.Name),
+		},
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not import slashing protection data")
+	}
+	for _, k := range report.Keys {
+		log.WithFields(log.Fields{
+			"publicKey":            fmt.Sprintf("%#x", k.PublicKey),
+			"newBlocks":            k.NewBlocks,
+			"existingBlocks":       k.ExistingBlocks,
+			"newAttestations":      k.NewAttestations,
+			"existingAttestations": k.ExistingAttestations,
+		}).Info("Slashing protection data")
+	}
+	if report.DryRun {
+		log.WithField("publicKeys", len(report.Keys)).Info("Dry run, no slashing protection data was imported")
+		return nil
+	}
+	log.WithField("publicKeys", len(report.Keys)).Info("Imported slashing protection data")
+	return nil
+}
+
+// validatorDBDir returns the directory holding the validator database, which is the data directory
+// if specified and the accounts directory of the wallet otherwise, as when running the validator.
+func validatorDBDir(cliCtx *cli.Context) (string, error) {
+	if dataDir := cliCtx.String(cmd.DataDirFlag.Name); dataDir != cmd.DefaultDataDir() {
+		return fileutil.ExpandPath(dataDir)
+	}
+	w, err := wallet.OpenWallet(cliCtx.Context, &wallet.Config{
+		WalletDir: cliCtx.String(flags.WalletDirFlag.Name),
+	})
+	if err != nil {
+		return "", errors.Wrapf(
+			err, "could not open wallet, please specify the directory of the validator database with --%s",
+			cmd.DataDirFlag.Name,
+		)
+	}
+	return w.AccountsDir(), nil
+}
+
+// parsePublicKeys parses a comma-separated list of hex encoded BLS public keys.
+func parsePublicKeys(input string) ([][48]byte, error) {
+	if input == "" {
+		return nil, nil
+	}
+	var publicKeys [][48]byte
+	for _, str := range strings.Split(input, ",") {
+		pkString := strings.TrimPrefix(strings.TrimSpace(str), "0x")
+		pubKeyBytes, err := hex.DecodeString(pkString)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode string %s as hex", pkString)
+		}
+		if _, err := bls.PublicKeyFromBytes(pubKeyBytes); err != nil {
+			return nil, errors.Wrapf(err, "%#x is not a valid BLS public key", pubKeyBytes)
+		}
+		var pubKey [48]byte
+		copy(pubKey[:], pubKeyBytes)
+		publicKeys = append(publicKeys, pubKey)
+	}
+	return publicKeys, nil
+}
@@ -1,15 +1,38 @@
 package interchangeformat
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"sort"
 
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/validator/db"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
 )
 
+// ExportOptions specifies which slashing protection data is exported and in which form.
+type ExportOptions struct {
+	// PublicKeys restricts the export to the given public keys. All public keys
+	// in the database are exported if empty.
+	PublicKeys [][48]byte
+	// Minimal exports only the highest signed slot and the highest signed source and target
+	// epochs of each public key, as in the minimal form of EIP-3076, instead of the full history.
+	Minimal bool
+}
+
 // ExportStandardProtectionJSON extracts all slashing protection data from a validator database
 // and packages it into an EIP-3076 compliant, standard
 func ExportStandardProtectionJSON(ctx context.Context, validatorDB db.Database) (*EIPSlashingProtectionFormat, error) {
+	return ExportStandardProtectionJSONWithOptions(ctx, validatorDB, &ExportOptions{})
+}
+
+// ExportStandardProtectionJSONWithOptions extracts the slashing protection data of the public keys
+// specified in the options from a validator database and packages it into an EIP-3076 compliant,
+// standard format.
+func ExportStandardProtectionJSONWithOptions(
+	ctx context.Context, validatorDB db.Database, opts *ExportOptions,
+) (*EIPSlashingProtectionFormat, error) {
 	interchangeJSON := &EIPSlashingProtectionFormat{}
 	genesisValidatorsRoot, err := validatorDB.GenesisValidatorsRoot(ctx)
 	if err != nil {
@@ -22,39 +45,88 @@ func ExportStandardProtectionJSON(ctx context.Context, validatorDB db.Database)
 	interchangeJSON.Metadata.GenesisValidatorsRoot = genesisRootHex
 	interchangeJSON.Metadata.InterchangeFormatVersion = INTERCHANGE_FORMAT_VERSION
 
-	// Extract the existing public keys in our database.
-	proposedPublicKeys, err := validatorDB.ProposedPublicKeys(ctx)
+	publicKeys, err := exportedPublicKeys(ctx, validatorDB, opts.PublicKeys)
+	if err != nil {
+		return nil, err
+	}
+	attestingHistoryByPubKey, err := validatorDB.AttestationHistoryForPubKeysV2(ctx, publicKeys)
 	if err != nil {
 		return nil, err
 	}
-	dataByPubKey := make(map[[48]byte]*ProtectionData)
 
-	// Extract the signed proposals by public keys.
-	for _, pubKey := range proposedPublicKeys {
+	// We keep the order of the public keys so that the exported file is deterministic.
+	dataList := make([]*ProtectionData, 0, len(publicKeys))
+	for _, pubKey := range publicKeys {
 		pubKeyHex, err := pubKeyToHexString(pubKey[:])
 		if err != nil {
 			return nil, err
 		}
-		signedBlocks, err := getSignedBlocksByPubKey(ctx, validatorDB, pubKey)
-		if err != nil {
-			return nil, err
+		var signedBlocks []*SignedBlock
+		var signedAtts []*SignedAttestation
+		if opts.Minimal {
+			signedBlocks, err = getHighestSignedBlockByPubKey(ctx, validatorDB, pubKey)
+			if err != nil {
+				return nil, err
+			}
+			signedAtts, err = getHighestSignedAttestationByPubKey(
+				ctx, validatorDB, pubKey, attestingHistoryByPubKey[pubKey],
+			)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			signedBlocks, err = getSignedBlocksByPubKey(ctx, validatorDB, pubKey)
+			if err != nil {
+				return nil, err
+			}
+			signedAtts, err = getSignedAttestationsByPubKey(ctx, attestingHistoryByPubKey[pubKey])
+			if err != nil {
+				return nil, err
+			}
 		}
-		dataByPubKey[pubKey] = &ProtectionData{
+		dataList = append(dataList, &ProtectionData{
 			Pubkey:             pubKeyHex,
 			SignedBlocks:       signedBlocks,
-			SignedAttestations: nil,
-		}
-	}
-
-	// Next we turn our map into a slice as expected by the EIP-3076 JSON standard.
-	dataList := make([]*ProtectionData, 0)
-	for _, item := range dataByPubKey {
-		dataList = append(dataList, item)
+			SignedAttestations: signedAtts,
+		})
 	}
 	interchangeJSON.Data = dataList
 	return interchangeJSON, nil
 }
 
+// exportedPublicKeys returns the requested public keys or, if none are requested,
+// all public keys in the database, sorted.
+func exportedPublicKeys(ctx context.Context, validatorDB db.Database, requested [][48]byte) ([][48]byte, error) {
+	seen := make(map[[48]byte]bool)
+	var publicKeys [][48]byte
+	addKeys := func(keys [][48]byte) {
+		for _, pubKey := range keys {
+			if !seen[pubKey] {
+				seen[pubKey] = true
+				publicKeys = append(publicKeys, pubKey)
+			}
+		}
+	}
+	if len(requested) > 0 {
+		addKeys(requested)
+		return publicKeys, nil
+	}
+	proposedPublicKeys, err := validatorDB.ProposedPublicKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	attestedPublicKeys, err := validatorDB.AttestedPublicKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	addKeys(proposedPublicKeys)
+	addKeys(attestedPublicKeys)
+	sort.Slice(publicKeys, func(i, j int) bool {
+		return bytes.Compare(publicKeys[i][:], publicKeys[j][:]) < 0
+	})
+	return publicKeys, nil
+}
+
 func getSignedBlocksByPubKey(ctx context.Context, validatorDB db.Database, pubKey [48]byte) ([]*SignedBlock, error) {
 	lowestSignedSlot, err := validatorDB.LowestSignedProposal(ctx, pubKey)
 	if err != nil {
@@ -86,3 +158,111 @@ func getSignedBlocksByPubKey(ctx context.Context, validatorDB db.Database, pubKe
 	}
 	return signedBlocks, nil
 }
+
+// The minimal form of the proposal history only contains the highest signed slot, without a signing root.
+func getHighestSignedBlockByPubKey(ctx context.Context, validatorDB db.Database, pubKey [48]byte) ([]*SignedBlock, error) {
+	highestSignedSlot, err := validatorDB.HighestSignedProposal(ctx, pubKey)
+	if err != nil {
+		return nil, err
+	}
+	_, exists, err := validatorDB.ProposalHistoryForSlot(ctx, pubKey, highestSignedSlot)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, nil
+	}
+	return []*SignedBlock{{Slot: fmt.Sprintf("%d", highestSignedSlot)}}, nil
+}
+
+func getSignedAttestationsByPubKey(ctx context.Context, history kv.EncHistoryData) ([]*SignedAttestation, error) {
+	signedAtts := make([]*SignedAttestation, 0)
+	err := forEachSignedAttestation(ctx, history, func(target uint64, hd *kv.HistoryData) error {
+		signingRootHex, err := rootToHexString(hd.SigningRoot)
+		if err != nil {
+			return err
+		}
+		signedAtts = append(signedAtts, &SignedAttestation{
+			SourceEpoch: fmt.Sprintf("%d", hd.Source),
+			TargetEpoch: fmt.Sprintf("%d", target),
+			SigningRoot: signingRootHex,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return signedAtts, nil
+}
+
+// The minimal form of the attesting history only contains a single attestation with the highest
+// signed source and target epochs, without a signing root.
+func getHighestSignedAttestationByPubKey(
+	ctx context.Context, validatorDB db.Database, pubKey [48]byte, history kv.EncHistoryData,
+) ([]*SignedAttestation, error) {
+	highestSource, err := validatorDB.HighestSignedSourceEpoch(ctx, pubKey)
+	if err != nil {
+		return nil, err
+	}
+	highestTarget, err := validatorDB.HighestSignedTargetEpoch(ctx, pubKey)
+	if err != nil {
+		return nil, err
+	}
+	exists := highestSource > 0 || highestTarget > 0
+	err = forEachSignedAttestation(ctx, history, func(target uint64, hd *kv.HistoryData) error {
+		exists = true
+		if hd.Source > highestSource {
+			highestSource = hd.Source
+		}
+		if target > highestTarget {
+			highestTarget = target
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, nil
+	}
+	return []*SignedAttestation{{
+		SourceEpoch: fmt.Sprintf("%d", highestSource),
+		TargetEpoch: fmt.Sprintf("%d", highestTarget),
+	}}, nil
+}
+
+// forEachSignedAttestation calls f for every signed attestation in the attesting history, in
+// ascending order of target epochs. Only the targets within one weak subjectivity period of the
+// latest written epoch are kept in the history.
+func forEachSignedAttestation(
+	ctx context.Context, history kv.EncHistoryData, f func(target uint64, hd *kv.HistoryData) error,
+) error {
+	if len(history) == 0 {
+		return nil
+	}
+	latestEpochWritten, err := history.GetLatestEpochWritten(ctx)
+	if err != nil {
+		return err
+	}
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	var lowestTarget uint64
+	if latestEpochWritten >= wsPeriod {
+		lowestTarget = latestEpochWritten - wsPeriod + 1
+	}
+	for target := lowestTarget; target <= latestEpochWritten; target++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		hd, err := history.GetTargetData(ctx, target)
+		if err != nil {
+			return err
+		}
+		if hd.IsEmpty() {
+			continue
+		}
+		if err := f(target, hd); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -1,7 +1,9 @@
 package interchangeformat
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -58,3 +60,55 @@ func Test_getSignedBlocksByPubKey(t *testing.T) {
 		assert.DeepEqual(t, blk, signedBlocks[i])
 	}
 }
+
+func mockProtectionData(t *testing.T, pubKey [48]byte) *ProtectionData {
+	return &ProtectionData{
+		Pubkey: fmt.Sprintf("%#x", pubKey),
+		SignedBlocks: []*SignedBlock{
+			{Slot: "2", SigningRoot: fmt.Sprintf("%#x", [32]byte{2})},
+			{Slot: "7", SigningRoot: fmt.Sprintf("%#x", [32]byte{7})},
+		},
+		SignedAttestations: []*SignedAttestation{
+			{SourceEpoch: "1", TargetEpoch: "2", SigningRoot: fmt.Sprintf("%#x", [32]byte{1})},
+			{SourceEpoch: "3", TargetEpoch: "4", SigningRoot: fmt.Sprintf("%#x", [32]byte{3})},
+		},
+	}
+}
+
+func TestExportStandardProtectionJSONWithOptions(t *testing.T) {
+	ctx := context.Background()
+	publicKeys := createRandomPubKeys(t, 3)
+	validatorDB := dbtest.SetupDB(t, publicKeys)
+
+	interchangeJSON := &EIPSlashingProtectionFormat{}
+	interchangeJSON.Metadata.GenesisValidatorsRoot = fmt.Sprintf("%#x", [32]byte{1})
+	interchangeJSON.Metadata.InterchangeFormatVersion = INTERCHANGE_FORMAT_VERSION
+	interchangeJSON.Data = []*ProtectionData{
+		mockProtectionData(t, publicKeys[0]),
+		mockProtectionData(t, publicKeys[1]),
+	}
+	blob, err := json.Marshal(interchangeJSON)
+	require.NoError(t, err)
+	require.NoError(t, ImportStandardProtectionJSON(ctx, validatorDB, bytes.NewBuffer(blob)))
+
+	// Only the requested keys are exported.
+	exported, err := ExportStandardProtectionJSONWithOptions(ctx, validatorDB, &ExportOptions{
+		PublicKeys: [][48]byte{publicKeys[1]},
+	})
+	require.NoError(t, err)
+	require.Equal(t, interchangeJSON.Metadata, exported.Metadata)
+	require.Equal(t, 1, len(exported.Data))
+	assert.DeepEqual(t, mockProtectionData(t, publicKeys[1]), exported.Data[0])
+
+	// The minimal format only contains the highest signed slot, source and target.
+	exported, err = ExportStandardProtectionJSONWithOptions(ctx, validatorDB, &ExportOptions{
+		PublicKeys: [][48]byte{publicKeys[0], publicKeys[1]},
+		Minimal:    true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(exported.Data))
+	for _, data := range exported.Data {
+		assert.DeepEqual(t, []*SignedBlock{{Slot: "7"}}, data.SignedBlocks)
+		assert.DeepEqual(t, []*SignedAttestation{{SourceEpoch: "3", TargetEpoch: "4"}}, data.SignedAttestations)
+	}
+}
@@ -7,68 +7,157 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"sort"
 
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/db/kv"
 )
 
+// ImportOptions specifies how slashing protection data is imported.
+type ImportOptions struct {
+	// DryRun only reports the changes the import would make, without writing to the database.
+	DryRun bool
+}
+
+// ImportReport describes the changes an import makes to the slashing protection database.
+type ImportReport struct {
+	DryRun bool
+	Keys   []*KeyImportReport
+}
+
+// KeyImportReport describes the changes an import makes to the slashing protection history of a
+// public key. Signed blocks and attestations which are already part of the history of the key are
+// counted as existing and are not overwritten.
+type KeyImportReport struct {
+	PublicKey            [48]byte
+	NewBlocks            int
+	ExistingBlocks       int
+	NewAttestations      int
+	ExistingAttestations int
+}
+
 // ImportStandardProtectionJSON takes in EIP-3076 compliant JSON file used for slashing protection
 // by eth2 validators and imports its data into Prysm's internal representation of slashing
 // protection in the validator client's database. For more information, see the EIP document here:
 // https://eips.ethereum.org/EIPS/eip-3076.
 func ImportStandardProtectionJSON(ctx context.Context, validatorDB db.Database, r io.Reader) error {
+	_, err := ImportStandardProtectionJSONWithOptions(ctx, validatorDB, r, &ImportOptions{})
+	return err
+}
+
+// ImportStandardProtectionJSONWithOptions imports an EIP-3076 compliant JSON file, in its complete
+// or its minimal form, into the validator client's database and reports the changes made to the
+// history of every public key in the file. Imported data is merged with the existing history of a
+// key, so that the highest signed slot and epochs of a key never decrease. With the dry run option,
+// the changes are only reported.
+func ImportStandardProtectionJSONWithOptions(
+	ctx context.Context, validatorDB db.Database, r io.Reader, opts *ImportOptions,
+) (*ImportReport, error) {
+	report := &ImportReport{DryRun: opts.DryRun}
 	encodedJSON, err := ioutil.ReadAll(r)
 	if err != nil {
-		return errors.Wrap(err, "could not read slashing protection JSON file")
+		return nil, errors.Wrap(err, "could not read slashing protection JSON file")
 	}
 	interchangeJSON := &EIPSlashingProtectionFormat{}
 	if err := json.Unmarshal(encodedJSON, interchangeJSON); err != nil {
-		return errors.Wrap(err, "could not unmarshal slashing protection JSON file")
+		return nil, errors.Wrap(err, "could not unmarshal slashing protection JSON file")
 	}
 	if interchangeJSON.Data == nil {
 		log.Warn("No slashing protection data to import")
-		return nil
+		return report, nil
 	}
 
 	// We validate the `Metadata` field of the slashing protection JSON file.
-	if err := validateMetadata(ctx, validatorDB, interchangeJSON); err != nil {
-		return errors.Wrap(err, "slashing protection JSON metadata was incorrect")
+	if opts.DryRun {
+		_, err = checkMetadata(ctx, validatorDB, interchangeJSON)
+	} else {
+		err = validateMetadata(ctx, validatorDB, interchangeJSON)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "slashing protection JSON metadata was incorrect")
 	}
 
 	// We need to handle duplicate public keys in the JSON file, with potentially
 	// different signing histories for both attestations and blocks.
 	signedBlocksByPubKey, err := parseUniqueSignedBlocksByPubKey(interchangeJSON.Data)
 	if err != nil {
-		return errors.Wrap(err, "could not parse unique entries for blocks by public key")
+		return nil, errors.Wrap(err, "could not parse unique entries for blocks by public key")
 	}
 	signedAttsByPubKey, err := parseUniqueSignedAttestationsByPubKey(interchangeJSON.Data)
 	if err != nil {
-		return errors.Wrap(err, "could not parse unique entries for attestations by public key")
+		return nil, errors.Wrap(err, "could not parse unique entries for attestations by public key")
+	}
+
+	reportByPubKey := make(map[[48]byte]*KeyImportReport)
+	keyReport := func(pubKey [48]byte) *KeyImportReport {
+		if _, ok := reportByPubKey[pubKey]; !ok {
+			reportByPubKey[pubKey] = &KeyImportReport{PublicKey: pubKey}
+			report.Keys = append(report.Keys, reportByPubKey[pubKey])
+		}
+		return reportByPubKey[pubKey]
 	}
 
-	attestingHistoryByPubKey := make(map[[48]byte]kv.EncHistoryData)
+	// Keys without a proposal history bucket have no proposals in the database yet.
+	proposedPubKeys, err := validatorDB.ProposedPublicKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve proposed public keys from database")
+	}
+	hasProposalHistory := make(map[[48]byte]bool, len(proposedPubKeys))
+	for _, pubKey := range proposedPubKeys {
+		hasProposalHistory[pubKey] = true
+	}
 	proposalHistoryByPubKey := make(map[[48]byte]kv.ProposalHistoryForPubkey)
 	for pubKey, signedBlocks := range signedBlocksByPubKey {
 		// Transform the processed signed blocks data from the JSON
 		// file into the internal Prysm representation of proposal history.
 		proposalHistory, err := transformSignedBlocks(ctx, signedBlocks)
 		if err != nil {
-			return errors.Wrapf(err, "could not parse signed blocks in JSON file for key %#x", pubKey)
+			return nil, errors.Wrapf(err, "could not parse signed blocks in JSON file for key %#x", pubKey)
+		}
+		// Proposals for slots which are already in the history of the key are kept as they are.
+		newProposals, err := filterNewProposals(
+			ctx, validatorDB, pubKey, proposalHistory.Proposals, hasProposalHistory[pubKey],
+		)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not check proposal history for key %#x", pubKey)
 		}
-		proposalHistoryByPubKey[pubKey] = *proposalHistory
+		r := keyReport(pubKey)
+		r.NewBlocks = len(newProposals)
+		r.ExistingBlocks = len(proposalHistory.Proposals) - len(newProposals)
+		proposalHistoryByPubKey[pubKey] = kv.ProposalHistoryForPubkey{Proposals: newProposals}
 	}
 
+	attestedPubKeys := make([][48]byte, 0, len(signedAttsByPubKey))
+	for pubKey := range signedAttsByPubKey {
+		attestedPubKeys = append(attestedPubKeys, pubKey)
+	}
+	attestingHistoryByPubKey, err := validatorDB.AttestationHistoryForPubKeysV2(ctx, attestedPubKeys)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve attesting history from database")
+	}
 	for pubKey, signedAtts := range signedAttsByPubKey {
-		// Transform the processed signed attestation data from the JSON
-		// file into the internal Prysm representation of attesting history.
-		attestingHistory, err := transformSignedAttestations(ctx, signedAtts)
+		// Merge the processed signed attestation data from the JSON file into
+		// the internal Prysm representation of the attesting history of the key.
+		attestingHistory, numNew, numExisting, err := mergeSignedAttestations(
+			ctx, attestingHistoryByPubKey[pubKey], signedAtts,
+		)
 		if err != nil {
-			return errors.Wrapf(err, "could not parse signed attestations in JSON file for key %#x", pubKey)
+			return nil, errors.Wrapf(err, "could not parse signed attestations in JSON file for key %#x", pubKey)
 		}
-		attestingHistoryByPubKey[pubKey] = *attestingHistory
+		r := keyReport(pubKey)
+		r.NewAttestations = numNew
+		r.ExistingAttestations = numExisting
+		attestingHistoryByPubKey[pubKey] = attestingHistory
+	}
+	sort.Slice(report.Keys, func(i, j int) bool {
+		return bytes.Compare(report.Keys[i].PublicKey[:], report.Keys[j].PublicKey[:]) < 0
+	})
+	if opts.DryRun {
+		return report, nil
 	}
 
 	// We save the histories to disk as atomic operations, ensuring that this only occurs
@@ -84,22 +173,40 @@ func ImportStandardProtectionJSON(ctx context.Context, validatorDB db.Database,
 				log.WithError(err).Debug("Could not increase progress bar")
 			}
 			if err = validatorDB.SaveProposalHistoryForSlot(ctx, pubKey, proposal.Slot, proposal.SigningRoot); err != nil {
-				return errors.Wrap(err, "could not save proposal history from imported JSON to database")
+				return nil, errors.Wrap(err, "could not save proposal history from imported JSON to database")
 			}
 		}
 	}
 	if err := validatorDB.SaveAttestationHistoryForPubKeysV2(ctx, attestingHistoryByPubKey); err != nil {
-		return errors.Wrap(err, "could not save attesting history from imported JSON to database")
+		return nil, errors.Wrap(err, "could not save attesting history from imported JSON to database")
 	}
 
-	return saveHighestSourceTargetToDB(ctx, validatorDB, signedAttsByPubKey)
+	if err := saveHighestSourceTargetToDB(ctx, validatorDB, signedAttsByPubKey); err != nil {
+		return nil, err
+	}
+	return report, nil
 }
 
 func validateMetadata(ctx context.Context, validatorDB db.Database, interchangeJSON *EIPSlashingProtectionFormat) error {
+	gvr, err := checkMetadata(ctx, validatorDB, interchangeJSON)
+	if err != nil {
+		return err
+	}
+	if gvr != nil {
+		if err = validatorDB.SaveGenesisValidatorsRoot(ctx, gvr); err != nil {
+			return errors.Wrap(err, "could not save genesis validator root to db")
+		}
+	}
+	return nil
+}
+
+// checkMetadata validates the metadata of the slashing protection JSON file against the database.
+// It returns the genesis validators root of the file if the database does not have one yet.
+func checkMetadata(ctx context.Context, validatorDB db.Database, interchangeJSON *EIPSlashingProtectionFormat) ([]byte, error) {
 	// We need to ensure the version in the metadata field matches the one we support.
 	version := interchangeJSON.Metadata.InterchangeFormatVersion
 	if version != INTERCHANGE_FORMAT_VERSION {
-		return fmt.Errorf(
+		return nil, fmt.Errorf(
 			"slashing protection JSON version '%s' is not supported, wanted '%s'",
 			version,
 			INTERCHANGE_FORMAT_VERSION,
@@ -110,23 +217,20 @@ func validateMetadata(ctx context.Context, validatorDB db.Database, interchangeJ
 	// the imported slashing protection JSON was created on a different chain.
 	gvr, err := rootFromHex(interchangeJSON.Metadata.GenesisValidatorsRoot)
 	if err != nil {
-		return fmt.Errorf("%#x is not a valid root: %v", interchangeJSON.Metadata.GenesisValidatorsRoot, err)
+		return nil, fmt.Errorf("%#x is not a valid root: %v", interchangeJSON.Metadata.GenesisValidatorsRoot, err)
 	}
 	dbGvr, err := validatorDB.GenesisValidatorsRoot(ctx)
 	if err != nil {
-		return errors.Wrap(err, "could not retrieve genesis validator root to db")
+		return nil, errors.Wrap(err, "could not retrieve genesis validator root to db")
 	}
 	if dbGvr == nil {
-		if err = validatorDB.SaveGenesisValidatorsRoot(ctx, gvr[:]); err != nil {
-			return errors.Wrap(err, "could not save genesis validator root to db")
-		}
-		return nil
+		return gvr[:], nil
 	}
 	if !bytes.Equal(dbGvr, gvr[:]) {
-		return errors.New("genesis validator root doesnt match the one that is stored in slashing protection db. " +
+		return nil, errors.New("genesis validator root doesnt match the one that is stored in slashing protection db. " +
 			"Please make sure you import the protection data that is relevant to the chain you are on")
 	}
-	return nil
+	return nil, nil
 }
 
 // We create a map of pubKey -> []*SignedBlock. Then, we keep a map of observed hashes of
@@ -214,43 +318,109 @@ func transformSignedBlocks(ctx context.Context, signedBlocks []*SignedBlock) (*k
 	}, nil
 }
 
-func transformSignedAttestations(ctx context.Context, atts []*SignedAttestation) (*kv.EncHistoryData, error) {
-	attestingHistory := kv.NewAttestationHistoryArray(0)
-	highestEpochWritten := uint64(0)
-	var err error
+// Proposals for slots which already have a proposal in the history of the key are filtered out,
+// as are duplicate proposals for the same slot in the JSON file.
+func filterNewProposals(
+	ctx context.Context, validatorDB db.Database, pubKey [48]byte, proposals []kv.Proposal, hasHistory bool,
+) ([]kv.Proposal, error) {
+	seenSlots := make(map[uint64]bool)
+	newProposals := make([]kv.Proposal, 0, len(proposals))
+	for _, proposal := range proposals {
+		if seenSlots[proposal.Slot] {
+			continue
+		}
+		seenSlots[proposal.Slot] = true
+		if !hasHistory {
+			newProposals = append(newProposals, proposal)
+			continue
+		}
+		_, exists, err := validatorDB.ProposalHistoryForSlot(ctx, pubKey, proposal.Slot)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			newProposals = append(newProposals, proposal)
+		}
+	}
+	return newProposals, nil
+}
+
+// We merge the signed attestations from the JSON file into the attesting history of a key. Targets
+// which already have an attestation in the history keep it, and the latest written epoch of the
+// history only ever increases. The number of new and already existing attestations is returned.
+func mergeSignedAttestations(
+	ctx context.Context, attestingHistory kv.EncHistoryData, atts []*SignedAttestation,
+) (kv.EncHistoryData, int, int, error) {
+	if len(attestingHistory) == 0 {
+		attestingHistory = kv.NewAttestationHistoryArray(0)
+	}
+	latestEpochWritten, err := attestingHistory.GetLatestEpochWritten(ctx)
+	if err != nil {
+		return nil, 0, 0, errors.Wrap(err, "could not get latest epoch written")
+	}
+	wsPeriod := params.BeaconConfig().WeakSubjectivityPeriod
+	highestEpochWritten := latestEpochWritten
+	var numNew, numExisting int
 	for _, attestation := range atts {
 		target, err := uint64FromString(attestation.TargetEpoch)
 		if err != nil {
-			return nil, fmt.Errorf("%d is not a valid epoch: %v", target, err)
-		}
-		// Keep track of the highest epoch written from the imported JSON.
-		if target > highestEpochWritten {
-			highestEpochWritten = target
+			return nil, 0, 0, fmt.Errorf("%d is not a valid epoch: %v", target, err)
 		}
 		source, err := uint64FromString(attestation.SourceEpoch)
 		if err != nil {
-			return nil, fmt.Errorf("%d is not a valid epoch: %v", source, err)
+			return nil, 0, 0, fmt.Errorf("%d is not a valid epoch: %v", source, err)
 		}
 		var signingRoot [32]byte
 		// Signing roots are optional in the standard JSON file.
 		if attestation.SigningRoot != "" {
 			signingRoot, err = rootFromHex(attestation.SigningRoot)
 			if err != nil {
-				return nil, fmt.Errorf("%#x is not a valid root: %v", signingRoot, err)
+				return nil, 0, 0, fmt.Errorf("%#x is not a valid root: %v", signingRoot, err)
 			}
 		}
-		attestingHistory, err = attestingHistory.SetTargetData(
-			ctx, target, &kv.HistoryData{Source: source, SigningRoot: signingRoot[:]},
-		)
+		incoming := &kv.HistoryData{Source: source, SigningRoot: signingRoot[:]}
+		// The history only keeps one weak subjectivity period of targets, older
+		// targets share their position with newer ones and are not imported.
+		if latestEpochWritten >= wsPeriod && target <= latestEpochWritten-wsPeriod {
+			continue
+		}
+		existing, err := attestingHistory.GetTargetData(ctx, target)
 		if err != nil {
-			return nil, errors.Wrap(err, "could not set target data for attesting history")
+			return nil, 0, 0, errors.Wrap(err, "could not get target data from attesting history")
+		}
+		if target <= latestEpochWritten && !existing.IsEmpty() {
+			if !incoming.IsEmpty() {
+				numExisting++
+			}
+			continue
+		}
+		// Targets skipped when extending the history are marked as not attested.
+		for i := highestEpochWritten + 1; i < target && i <= highestEpochWritten+wsPeriod; i++ {
+			attestingHistory, err = attestingHistory.SetTargetData(ctx, i, &kv.HistoryData{
+				Source:      params.BeaconConfig().FarFutureEpoch,
+				SigningRoot: make([]byte, 32),
+			})
+			if err != nil {
+				return nil, 0, 0, errors.Wrap(err, "could not set target data for attesting history")
+			}
+		}
+		attestingHistory, err = attestingHistory.SetTargetData(ctx, target, incoming)
+		if err != nil {
+			return nil, 0, 0, errors.Wrap(err, "could not set target data for attesting history")
+		}
+		if !incoming.IsEmpty() {
+			numNew++
+		}
+		// Keep track of the highest epoch written from the imported JSON.
+		if target > highestEpochWritten {
+			highestEpochWritten = target
 		}
 	}
 	attestingHistory, err = attestingHistory.SetLatestEpochWritten(ctx, highestEpochWritten)
 	if err != nil {
-		return nil, errors.Wrap(err, "could not set latest epoch written")
+		return nil, 0, 0, errors.Wrap(err, "could not set latest epoch written")
 	}
-	return &attestingHistory, nil
+	return attestingHistory, numNew, numExisting, nil
 }
 
 // This saves the highest source and target epoch from the individual validator to the DB.
@@ -267,6 +437,10 @@ func saveHighestSourceTargetToDB(ctx context.Context, validatorDB db.Database, s
 			if err != nil {
 				return fmt.Errorf("%d is not a valid target: %v", target, err)
 			}
+			// Entries with a far future source epoch mark targets without an attestation.
+			if source == params.BeaconConfig().FarFutureEpoch {
+				continue
+			}
 			se, ok := validatorHighestSourceEpoch[pubKey]
 			if !ok {
 				validatorHighestSourceEpoch[pubKey] = source
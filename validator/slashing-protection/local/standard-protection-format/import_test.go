@@ -864,3 +864,85 @@ func createRandomRoots(t *testing.T, numRoots int) [][32]byte {
 	}
 	return roots
 }
+
+func TestImportStandardProtectionJSONWithOptions_MergesHistory(t *testing.T) {
+	ctx := context.Background()
+	publicKeys := createRandomPubKeys(t, 1)
+	validatorDB := dbtest.SetupDB(t, publicKeys)
+	pubKeyHex := fmt.Sprintf("%#x", publicKeys[0])
+	gvr := fmt.Sprintf("%#x", [32]byte{1})
+	encode := func(data *ProtectionData) *bytes.Buffer {
+		interchangeJSON := &EIPSlashingProtectionFormat{Data: []*ProtectionData{data}}
+		interchangeJSON.Metadata.GenesisValidatorsRoot = gvr
+		interchangeJSON.Metadata.InterchangeFormatVersion = INTERCHANGE_FORMAT_VERSION
+		blob, err := json.Marshal(interchangeJSON)
+		require.NoError(t, err)
+		return bytes.NewBuffer(blob)
+	}
+
+	existingRoot := [32]byte{1}
+	report, err := ImportStandardProtectionJSONWithOptions(ctx, validatorDB, encode(&ProtectionData{
+		Pubkey:             pubKeyHex,
+		SignedBlocks:       []*SignedBlock{{Slot: "5", SigningRoot: fmt.Sprintf("%#x", existingRoot)}},
+		SignedAttestations: []*SignedAttestation{{SourceEpoch: "3", TargetEpoch: "10"}},
+	}), &ImportOptions{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(report.Keys))
+	assert.DeepEqual(t, &KeyImportReport{PublicKey: publicKeys[0], NewBlocks: 1, NewAttestations: 1}, report.Keys[0])
+
+	// A minimal file with lower epochs and a conflicting signing root for slot 5.
+	merged := &ProtectionData{
+		Pubkey: pubKeyHex,
+		SignedBlocks: []*SignedBlock{
+			{Slot: "5", SigningRoot: fmt.Sprintf("%#x", [32]byte{2})},
+			{Slot: "8"},
+		},
+		SignedAttestations: []*SignedAttestation{
+			{SourceEpoch: "2", TargetEpoch: "10"},
+			{SourceEpoch: "1", TargetEpoch: "4"},
+		},
+	}
+	wantReport := &KeyImportReport{
+		PublicKey:            publicKeys[0],
+		NewBlocks:            1,
+		ExistingBlocks:       1,
+		NewAttestations:      1,
+		ExistingAttestations: 1,
+	}
+
+	// A dry run only reports the changes.
+	report, err = ImportStandardProtectionJSONWithOptions(ctx, validatorDB, encode(merged), &ImportOptions{DryRun: true})
+	require.NoError(t, err)
+	assert.Equal(t, true, report.DryRun)
+	assert.DeepEqual(t, wantReport, report.Keys[0])
+	_, exists, err := validatorDB.ProposalHistoryForSlot(ctx, publicKeys[0], 8)
+	require.NoError(t, err)
+	assert.Equal(t, false, exists)
+
+	report, err = ImportStandardProtectionJSONWithOptions(ctx, validatorDB, encode(merged), &ImportOptions{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, wantReport, report.Keys[0])
+
+	signingRoot, exists, err := validatorDB.ProposalHistoryForSlot(ctx, publicKeys[0], 5)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, existingRoot, signingRoot)
+	_, exists, err = validatorDB.ProposalHistoryForSlot(ctx, publicKeys[0], 8)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+
+	histories, err := validatorDB.AttestationHistoryForPubKeysV2(ctx, publicKeys)
+	require.NoError(t, err)
+	latestEpochWritten, err := histories[publicKeys[0]].GetLatestEpochWritten(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), latestEpochWritten)
+	hd, err := histories[publicKeys[0]].GetTargetData(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), hd.Source)
+	hd, err = histories[publicKeys[0]].GetTargetData(ctx, 4)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), hd.Source)
+	highestSource, err := validatorDB.HighestSignedSourceEpoch(ctx, publicKeys[0])
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), highestSource)
+}
@@ -85,6 +85,12 @@ var appHelpFlagGroups = []flagGroup{
 			flags.EnableWebFlag,
 			flags.DisablePenaltyRewardLogFlag,
 			flags.GraffitiFlag,
+			flags.ProposerConfigFileFlag,
+			flags.EnableDoppelgangerProtectionFlag,
+			flags.DoppelgangerEpochsFlag,
+			flags.BeaconNodeMaxSlotLagFlag,
+			flags.BeaconNodeMinPeersFlag,
+			flags.EnableBeaconNodeBroadcastFlag,
 			flags.EnableRPCFlag,
 			flags.RPCHost,
 			flags.RPCPort,